@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+)
+
+// ExportCustomers runs the export-customers command, writing the export to
+// the -output file or to stdout
+func ExportCustomers(exportService servicers.CustomerExportServicer, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("export-customers", flag.ContinueOnError)
+	format := flags.String("format", models.CustomerExportNDJSON, "file format, ndjson or csv")
+	gzipped := flags.Bool("gzip", false, "gzip the file")
+	updatedSince := flags.String("updated-since", "",
+		"only customers changed or deleted after this RFC 3339 time, within the change feed retention")
+	output := flags.String("output", "", "file to write, defaults to stdout")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	options := models.CustomerExportOptions{Format: *format, Gzip: *gzipped}
+	if options.Format != models.CustomerExportNDJSON && options.Format != models.CustomerExportCSV {
+		return fmt.Errorf("invalid format %q, expected ndjson or csv", options.Format)
+	}
+	if *updatedSince != "" {
+		since, err := time.Parse(time.RFC3339, *updatedSince)
+		if err != nil {
+			return fmt.Errorf("invalid updated-since: %w", err)
+		}
+		options.UpdatedSince = &since
+	}
+
+	w := stdout
+	var file *os.File
+	if *output != "" {
+		var err error
+		if file, err = os.Create(*output); err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+
+	count, err := exportService.ExportCustomers(w, options)
+	if err != nil {
+		return fmt.Errorf("export failed after %d customers: %w", count, err)
+	}
+	if file != nil {
+		if err := file.Close(); err != nil {
+			return err
+		}
+	}
+	log.Printf("exported %d customers", count)
+	return nil
+}
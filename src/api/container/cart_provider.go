@@ -0,0 +1,31 @@
+package container
+
+import (
+	controllers "produtos-favoritos/src/api/controllers"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	services "produtos-favoritos/src/domain/services"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
+)
+
+func ProvideCartRepository(db *gorm.DB) querier.CartQuerier {
+	return repositories.NewCartRepository(db)
+}
+
+func ProvideCartService(customerRepository querier.CustomerQuerier,
+	cartRepository querier.CartQuerier,
+	wishlistRepository querier.WishlistQuerier,
+	productRepository querier.ProductQuerier,
+	productService servicers.ProductServicer,
+	policyService servicers.PolicyServicer) servicers.CartServicer {
+	return services.NewCartService(customerRepository, cartRepository, wishlistRepository, productRepository,
+		productService, policyService)
+}
+
+func ProvideCartController(service servicers.CartServicer,
+	currencyService servicers.CurrencyServicer) handlers.CartHandler {
+	return controllers.NewCartController(service, currencyService)
+}
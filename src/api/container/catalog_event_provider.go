@@ -0,0 +1,26 @@
+package container
+
+import (
+	controllers "produtos-favoritos/src/api/controllers"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	services "produtos-favoritos/src/domain/services"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
+)
+
+func ProvideCatalogEventRepository(db *gorm.DB) querier.CatalogEventQuerier {
+	return repositories.NewCatalogEventRepository(db)
+}
+
+func ProvideCatalogEventService(eventRepository querier.CatalogEventQuerier,
+	productRepository querier.ProductQuerier,
+	productService servicers.ProductServicer) servicers.CatalogEventServicer {
+	return services.NewCatalogEventService(eventRepository, productRepository, productService)
+}
+
+func ProvideCatalogWebhookController(service servicers.CatalogEventServicer) handlers.CatalogWebhookHandler {
+	return controllers.NewCatalogWebhookController(service)
+}
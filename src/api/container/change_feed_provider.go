@@ -0,0 +1,28 @@
+package container
+
+import (
+	controllers "produtos-favoritos/src/api/controllers"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	services "produtos-favoritos/src/domain/services"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
+)
+
+func ProvideChangeEventRepository(db *gorm.DB) querier.ChangeEventQuerier {
+	return repositories.NewChangeEventRepository(db)
+}
+
+func ProvideChangeFeedService(changeEventRepository querier.ChangeEventQuerier) servicers.ChangeFeedServicer {
+	return services.NewChangeFeedService(changeEventRepository)
+}
+
+func ProvideChangeFeedCleanupJob(changeEventRepository querier.ChangeEventQuerier) servicers.Job {
+	return services.NewChangeFeedCleanupJob(changeEventRepository)
+}
+
+func ProvideChangeFeedController(changeFeedService servicers.ChangeFeedServicer) handlers.ChangeFeedHandler {
+	return controllers.NewChangeFeedController(changeFeedService)
+}
@@ -16,18 +16,75 @@ func BuildContainer() *dig.Container {
 	container.Provide(ProvideGormDB)
 
 	// inject Repositories
+	container.Provide(ProvideLockRepository)
 	container.Provide(ProvideCustomerRepository)
+	container.Provide(ProvideProductRepository)
+	container.Provide(ProvideProductChangeRepository)
+	container.Provide(ProvideWishlistRepository)
+	container.Provide(ProvideWishlistCollaboratorRepository)
+	container.Provide(ProvideWishlistTrashRepository)
+	container.Provide(ProvideCatalogEventRepository)
+	container.Provide(ProvideExchangeRateRepository)
+	container.Provide(ProvideCartRepository)
+	container.Provide(ProvideRegistryRepository)
+	container.Provide(ProvideTagRepository)
+	container.Provide(ProvideReminderRepository)
+	container.Provide(ProvideWishlistRuleRepository)
+	container.Provide(ProvideFavoriteCategoryRepository)
+	container.Provide(ProvideProductViewRepository)
+	container.Provide(ProvideCustomerExportRepository)
+	container.Provide(ProvideImportJobRepository)
+	container.Provide(ProvideChangeEventRepository)
 
 	// inject Services
 	container.Provide(ProvideCustomerService)
 	container.Provide(ProvideFakeApiClient)
 	container.Provide(ProvideProductService)
+	container.Provide(ProvidePolicyService)
 	container.Provide(ProvideWishlistService)
+	container.Provide(ProvideWishlistCollaborationService)
+	container.Provide(ProvideWishlistTrashService)
+	container.Provide(ProvideCatalogEventService)
+	container.Provide(ProvideCurrencyService)
+	container.Provide(ProvideCartService)
+	container.Provide(ProvideRegistryService)
+	container.Provide(ProvideTagService)
+	container.Provide(ProvideReminderService)
+	container.Provide(ProvideFavoriteCategoryService)
+	container.Provide(ProvideRecentlyViewedService)
+	container.Provide(ProvideCustomerExportService)
+	container.Provide(ProvideCustomerImportService)
+	container.Provide(ProvideChangeFeedService)
+
+	// inject background Jobs
+	container.Provide(ProvideWishlistValidationJob, dig.Group("jobs"))
+	container.Provide(ProvideCatalogMirrorJob, dig.Group("jobs"))
+	container.Provide(ProvideRegistryExpiryJob, dig.Group("jobs"))
+	container.Provide(ProvideWishlistTrashCleanupJob, dig.Group("jobs"))
+	container.Provide(ProvideStaleItemReminderJob, dig.Group("jobs"))
+	container.Provide(ProvideRecentlyViewedFlushJob, dig.Group("jobs"))
+	container.Provide(ProvideCustomerImportJob, dig.Group("jobs"))
+	container.Provide(ProvideChangeFeedCleanupJob, dig.Group("jobs"))
+	container.Provide(ProvideScheduler)
 
 	// inject Controllers
 	container.Provide(ProvideCustomerController)
 	container.Provide(ProvideProductController)
 	container.Provide(ProvideWishlisController)
+	container.Provide(ProvideWishlistCollaborationController)
+	container.Provide(ProvideWishlistTrashController)
+	container.Provide(ProvideCatalogWebhookController)
+	container.Provide(ProvideCurrencyController)
+	container.Provide(ProvideCartController)
+	container.Provide(ProvideRegistryController)
+	container.Provide(ProvideTagController)
+	container.Provide(ProvideReminderController)
+	container.Provide(ProvideWishlistRuleController)
+	container.Provide(ProvideFavoriteCategoryController)
+	container.Provide(ProvideRecentlyViewedController)
+	container.Provide(ProvideCustomerExportController)
+	container.Provide(ProvideImportJobController)
+	container.Provide(ProvideChangeFeedController)
 
 	return container
 }
@@ -0,0 +1,24 @@
+package container
+
+import (
+	controllers "produtos-favoritos/src/api/controllers"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	services "produtos-favoritos/src/domain/services"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
+)
+
+func ProvideExchangeRateRepository(db *gorm.DB) querier.ExchangeRateQuerier {
+	return repositories.NewExchangeRateRepository(db)
+}
+
+func ProvideCurrencyService(rateRepository querier.ExchangeRateQuerier) servicers.CurrencyServicer {
+	return services.NewCurrencyService(rateRepository)
+}
+
+func ProvideCurrencyController(service servicers.CurrencyServicer) handlers.CurrencyHandler {
+	return controllers.NewCurrencyController(service)
+}
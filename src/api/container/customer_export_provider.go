@@ -0,0 +1,24 @@
+package container
+
+import (
+	controllers "produtos-favoritos/src/api/controllers"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	services "produtos-favoritos/src/domain/services"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
+)
+
+func ProvideCustomerExportRepository(db *gorm.DB) querier.CustomerExportQuerier {
+	return repositories.NewCustomerExportRepository(db)
+}
+
+func ProvideCustomerExportService(exportRepository querier.CustomerExportQuerier) servicers.CustomerExportServicer {
+	return services.NewCustomerExportService(exportRepository)
+}
+
+func ProvideCustomerExportController(exportService servicers.CustomerExportServicer) handlers.CustomerExportHandler {
+	return controllers.NewCustomerExportController(exportService)
+}
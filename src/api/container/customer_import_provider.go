@@ -0,0 +1,35 @@
+package container
+
+import (
+	controllers "produtos-favoritos/src/api/controllers"
+	"produtos-favoritos/src/api/forms"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	services "produtos-favoritos/src/domain/services"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
+)
+
+func ProvideImportJobRepository(db *gorm.DB) querier.ImportJobQuerier {
+	return repositories.NewImportJobRepository(db)
+}
+
+// ProvideCustomerImportService validates the imported rows with the rules
+// of the create customer request
+func ProvideCustomerImportService(importJobRepository querier.ImportJobQuerier,
+	customerRepository querier.CustomerQuerier,
+	customerService servicers.CustomerServicer,
+	wishlistService servicers.WishlistServicer) servicers.CustomerImportServicer {
+	return services.NewCustomerImportService(importJobRepository, customerRepository, customerService, wishlistService,
+		forms.ValidateCustomer)
+}
+
+func ProvideCustomerImportJob(customerImportService servicers.CustomerImportServicer) servicers.Job {
+	return services.NewCustomerImportJob(customerImportService)
+}
+
+func ProvideImportJobController(customerImportService servicers.CustomerImportServicer) handlers.ImportJobHandler {
+	return controllers.NewImportJobController(customerImportService)
+}
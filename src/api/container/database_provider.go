@@ -1,7 +1,9 @@
 package container
 
 import (
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
 	"produtos-favoritos/src/infrastructure/database"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
 
 	"gorm.io/gorm"
 )
@@ -10,3 +12,7 @@ func ProvideGormDB() *gorm.DB {
 	db := &database.Database{}
 	return db.GetInstance()
 }
+
+func ProvideLockRepository(db *gorm.DB) querier.LockQuerier {
+	return repositories.NewLockRepository(db)
+}
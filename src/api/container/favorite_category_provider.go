@@ -0,0 +1,26 @@
+package container
+
+import (
+	controllers "produtos-favoritos/src/api/controllers"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	services "produtos-favoritos/src/domain/services"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
+)
+
+func ProvideFavoriteCategoryRepository(db *gorm.DB) querier.FavoriteCategoryQuerier {
+	return repositories.NewFavoriteCategoryRepository(db)
+}
+
+func ProvideFavoriteCategoryService(customerRepository querier.CustomerQuerier,
+	favoriteCategoryRepository querier.FavoriteCategoryQuerier,
+	productService servicers.ProductServicer) servicers.FavoriteCategoryServicer {
+	return services.NewFavoriteCategoryService(customerRepository, favoriteCategoryRepository, productService)
+}
+
+func ProvideFavoriteCategoryController(service servicers.FavoriteCategoryServicer) handlers.FavoriteCategoryHandler {
+	return controllers.NewFavoriteCategoryController(service)
+}
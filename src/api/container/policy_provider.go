@@ -0,0 +1,30 @@
+package container
+
+import (
+	controllers "produtos-favoritos/src/api/controllers"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	services "produtos-favoritos/src/domain/services"
+	"produtos-favoritos/src/infrastructure/config"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
+)
+
+func ProvideWishlistRuleRepository(db *gorm.DB) querier.WishlistRuleQuerier {
+	return repositories.NewWishlistRuleRepository(db)
+}
+
+// ProvidePolicyService fails the startup when the rules file is invalid
+func ProvidePolicyService(ruleRepository querier.WishlistRuleQuerier) (servicers.PolicyServicer, error) {
+	configRules, err := services.LoadRulesFile(config.WISHLIST_RULES_FILE)
+	if err != nil {
+		return nil, err
+	}
+	return services.NewPolicyService(ruleRepository, configRules, config.WISHLIST_RULES_REFRESH_INTERVAL)
+}
+
+func ProvideWishlistRuleController(service servicers.PolicyServicer) handlers.WishlistRuleHandler {
+	return controllers.NewWishlistRuleController(service)
+}
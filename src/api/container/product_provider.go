@@ -4,18 +4,41 @@ import (
 	"net/http"
 	controllers "produtos-favoritos/src/api/controllers"
 	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
 	servicers "produtos-favoritos/src/domain/interfaces/services"
 	services "produtos-favoritos/src/domain/services"
+	"produtos-favoritos/src/infrastructure/config"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
 )
 
 func ProvideFakeApiClient() servicers.FakeProductApiClientServicer {
-	return services.NewFakeProductApiClientService(&http.Client{})
+	return services.NewFakeProductApiClientService(&http.Client{Timeout: config.PRODUCTS_API_TIMEOUT})
+}
+
+func ProvideProductRepository(db *gorm.DB) querier.ProductQuerier {
+	return repositories.NewProductRepository(db)
+}
+
+func ProvideProductChangeRepository(db *gorm.DB) querier.ProductChangeQuerier {
+	return repositories.NewProductChangeRepository(db)
+}
+
+func ProvideProductService(fakeApiClient servicers.FakeProductApiClientServicer,
+	productRepository querier.ProductQuerier,
+	changeRepository querier.ProductChangeQuerier) servicers.ProductServicer {
+	return services.NewProductService(fakeApiClient, productRepository, changeRepository)
 }
 
-func ProvideProductService(fakeApiClient servicers.FakeProductApiClientServicer) servicers.ProductServicer {
-	return services.NewProductService(fakeApiClient)
+func ProvideCatalogMirrorJob(lockRepository querier.LockQuerier,
+	productRepository querier.ProductQuerier,
+	productService servicers.ProductServicer) servicers.Job {
+	return services.NewCatalogMirrorJob(lockRepository, productRepository, productService)
 }
 
-func ProvideProductController(productService servicers.ProductServicer) handlers.ProductHandler {
-	return controllers.NewProductController(productService)
+func ProvideProductController(productService servicers.ProductServicer,
+	currencyService servicers.CurrencyServicer,
+	wishlistService servicers.WishlistServicer) handlers.ProductHandler {
+	return controllers.NewProductController(productService, currencyService, wishlistService)
 }
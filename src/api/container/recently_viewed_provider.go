@@ -0,0 +1,31 @@
+package container
+
+import (
+	controllers "produtos-favoritos/src/api/controllers"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	services "produtos-favoritos/src/domain/services"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
+)
+
+func ProvideProductViewRepository(db *gorm.DB) querier.ProductViewQuerier {
+	return repositories.NewProductViewRepository(db)
+}
+
+// ProvideRecentlyViewedService is a singleton, the controller and the flush
+// job share its buffer
+func ProvideRecentlyViewedService(customerRepository querier.CustomerQuerier,
+	productViewRepository querier.ProductViewQuerier) servicers.RecentlyViewedServicer {
+	return services.NewRecentlyViewedService(customerRepository, productViewRepository)
+}
+
+func ProvideRecentlyViewedFlushJob(service servicers.RecentlyViewedServicer) servicers.Job {
+	return services.NewRecentlyViewedFlushJob(service)
+}
+
+func ProvideRecentlyViewedController(service servicers.RecentlyViewedServicer) handlers.RecentlyViewedHandler {
+	return controllers.NewRecentlyViewedController(service)
+}
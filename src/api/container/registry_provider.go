@@ -0,0 +1,30 @@
+package container
+
+import (
+	controllers "produtos-favoritos/src/api/controllers"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	services "produtos-favoritos/src/domain/services"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
+)
+
+func ProvideRegistryRepository(db *gorm.DB) querier.RegistryQuerier {
+	return repositories.NewRegistryRepository(db)
+}
+
+func ProvideRegistryService(customerRepository querier.CustomerQuerier,
+	wishlistRepository querier.WishlistQuerier,
+	registryRepository querier.RegistryQuerier) servicers.RegistryServicer {
+	return services.NewRegistryService(customerRepository, wishlistRepository, registryRepository)
+}
+
+func ProvideRegistryExpiryJob(registryRepository querier.RegistryQuerier) servicers.Job {
+	return services.NewRegistryExpiryJob(registryRepository)
+}
+
+func ProvideRegistryController(service servicers.RegistryServicer) handlers.RegistryHandler {
+	return controllers.NewRegistryController(service)
+}
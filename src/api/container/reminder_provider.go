@@ -0,0 +1,29 @@
+package container
+
+import (
+	controllers "produtos-favoritos/src/api/controllers"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	services "produtos-favoritos/src/domain/services"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
+)
+
+func ProvideReminderRepository(db *gorm.DB) querier.ReminderQuerier {
+	return repositories.NewReminderRepository(db)
+}
+
+func ProvideReminderService(customerRepository querier.CustomerQuerier,
+	reminderRepository querier.ReminderQuerier) servicers.ReminderServicer {
+	return services.NewReminderService(customerRepository, reminderRepository)
+}
+
+func ProvideStaleItemReminderJob(reminderRepository querier.ReminderQuerier) servicers.Job {
+	return services.NewStaleItemReminderJob(reminderRepository)
+}
+
+func ProvideReminderController(service servicers.ReminderServicer) handlers.ReminderHandler {
+	return controllers.NewReminderController(service)
+}
@@ -0,0 +1,18 @@
+package container
+
+import (
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/infrastructure/scheduler"
+
+	"go.uber.org/dig"
+)
+
+type SchedulerParams struct {
+	dig.In
+
+	Jobs []servicers.Job `group:"jobs"`
+}
+
+func ProvideScheduler(params SchedulerParams) *scheduler.Scheduler {
+	return scheduler.NewScheduler(params.Jobs)
+}
@@ -0,0 +1,25 @@
+package container
+
+import (
+	controllers "produtos-favoritos/src/api/controllers"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	services "produtos-favoritos/src/domain/services"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
+)
+
+func ProvideTagRepository(db *gorm.DB) querier.TagQuerier {
+	return repositories.NewTagRepository(db)
+}
+
+func ProvideTagService(customerRepository querier.CustomerQuerier,
+	tagRepository querier.TagQuerier) servicers.TagServicer {
+	return services.NewTagService(customerRepository, tagRepository)
+}
+
+func ProvideTagController(service servicers.TagServicer) handlers.TagHandler {
+	return controllers.NewTagController(service)
+}
@@ -6,13 +6,69 @@ import (
 	querier "produtos-favoritos/src/domain/interfaces/repositories"
 	servicers "produtos-favoritos/src/domain/interfaces/services"
 	services "produtos-favoritos/src/domain/services"
+	repositories "produtos-favoritos/src/infrastructure/database/repositories"
+
+	"gorm.io/gorm"
 )
 
+func ProvideWishlistRepository(db *gorm.DB) querier.WishlistQuerier {
+	return repositories.NewWishlistRepository(db)
+}
+
 func ProvideWishlistService(customerRepository querier.CustomerQuerier,
-	productService servicers.ProductServicer) servicers.WishlistServicer {
-	return services.NewWishlistService(customerRepository, productService)
+	wishlistRepository querier.WishlistQuerier,
+	productRepository querier.ProductQuerier,
+	productService servicers.ProductServicer,
+	collaboratorRepository querier.WishlistCollaboratorQuerier,
+	tagRepository querier.TagQuerier,
+	trashRepository querier.WishlistTrashQuerier,
+	policyService servicers.PolicyServicer) servicers.WishlistServicer {
+	return services.NewWishlistService(customerRepository, wishlistRepository, productRepository,
+		productService, collaboratorRepository, tagRepository, trashRepository, policyService)
+}
+
+func ProvideWishlistValidationJob(customerRepository querier.CustomerQuerier,
+	wishlistRepository querier.WishlistQuerier,
+	productRepository querier.ProductQuerier,
+	productService servicers.ProductServicer,
+	policyService servicers.PolicyServicer) servicers.Job {
+	return services.NewWishlistValidationJob(customerRepository, wishlistRepository, productRepository,
+		productService, policyService)
+}
+
+func ProvideWishlisController(service servicers.WishlistServicer,
+	currencyService servicers.CurrencyServicer) handlers.WishlistHandler {
+	return controllers.NewWishlistController(service, currencyService)
+}
+
+func ProvideWishlistCollaboratorRepository(db *gorm.DB) querier.WishlistCollaboratorQuerier {
+	return repositories.NewWishlistCollaboratorRepository(db)
+}
+
+func ProvideWishlistCollaborationService(customerRepository querier.CustomerQuerier,
+	collaboratorRepository querier.WishlistCollaboratorQuerier) servicers.WishlistCollaborationServicer {
+	return services.NewWishlistCollaborationService(customerRepository, collaboratorRepository)
+}
+
+func ProvideWishlistCollaborationController(service servicers.WishlistCollaborationServicer) handlers.WishlistCollaborationHandler {
+	return controllers.NewWishlistCollaborationController(service)
+}
+
+func ProvideWishlistTrashRepository(db *gorm.DB) querier.WishlistTrashQuerier {
+	return repositories.NewWishlistTrashRepository(db)
+}
+
+func ProvideWishlistTrashService(customerRepository querier.CustomerQuerier,
+	trashRepository querier.WishlistTrashQuerier,
+	collaboratorRepository querier.WishlistCollaboratorQuerier,
+	policyService servicers.PolicyServicer) servicers.WishlistTrashServicer {
+	return services.NewWishlistTrashService(customerRepository, trashRepository, collaboratorRepository, policyService)
+}
+
+func ProvideWishlistTrashCleanupJob(trashRepository querier.WishlistTrashQuerier) servicers.Job {
+	return services.NewWishlistTrashCleanupJob(trashRepository)
 }
 
-func ProvideWishlisController(service servicers.WishlistServicer) handlers.WishlistHandler {
-	return controllers.NewWishlistController(service)
+func ProvideWishlistTrashController(service servicers.WishlistTrashServicer) handlers.WishlistTrashHandler {
+	return controllers.NewWishlistTrashController(service)
 }
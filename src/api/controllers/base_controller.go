@@ -9,6 +9,7 @@ import (
 	"produtos-favoritos/src/internals/exceptions"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type BaseController struct {
@@ -59,6 +60,43 @@ func (b *BaseController) respond(ctx *gin.Context, result interface{}) {
 	ctx.JSON(http.StatusOK, result)
 }
 
+// respondStale flags a response built from locally stored data because the
+// products api was unavailable (RFC 7234 warn-code 110).
+func (b *BaseController) respondStale(ctx *gin.Context) {
+	ctx.Header("Warning", `110 - "Response is Stale"`)
+}
+
+// requestedCurrency is the currency the client wants to see the prices in, from
+// the currency query parameter or the Accept-Currency header.
+func (b *BaseController) requestedCurrency(ctx *gin.Context) string {
+	if currency := ctx.Query("currency"); currency != "" {
+		return currency
+	}
+	return ctx.GetHeader("Accept-Currency")
+}
+
+// actingCustomer is the customer doing the request on a wishlist, from the
+// X-Customer-ID header, the customer in the path when it's missing. The api
+// key doesn't identify customers, so the header is what the services check
+// against the owner and collaborators.
+func (b *BaseController) actingCustomer(ctx *gin.Context) (string, bool) {
+	actorID := ctx.GetHeader("X-Customer-ID")
+	if actorID == "" {
+		actorID = ctx.Param("id")
+	}
+	if _, err := uuid.Parse(actorID); err != nil {
+		b.respondError(ctx, &exceptions.BadRequestError{Reason: "invalid acting customer ID"})
+		return "", false
+	}
+	return actorID, true
+}
+
+// partnerKey identifies the partner integration doing the request, used by
+// the wishlist rules
+func (b *BaseController) partnerKey(ctx *gin.Context) string {
+	return ctx.GetHeader("X-Partner-Key")
+}
+
 func (b *BaseController) respondSuccessNoContent(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
@@ -77,6 +115,17 @@ func (b *BaseController) respondError(ctx *gin.Context, err error) {
 		ctx.JSON(http.StatusUnauthorized, err.Error())
 	case *exceptions.NotFoundEntityError:
 		ctx.JSON(http.StatusNotFound, err.Error())
+	case *exceptions.ForbiddenError:
+		ctx.JSON(http.StatusForbidden, err.Error())
+	case *exceptions.GoneError:
+		ctx.JSON(http.StatusGone, err.Error())
+	case *exceptions.UnavailableError:
+		ctx.JSON(http.StatusServiceUnavailable, err.Error())
+	case *exceptions.PolicyViolationError:
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error":      err.Error(),
+			"violations": err.(*exceptions.PolicyViolationError).Violations,
+		})
 	default:
 		ctx.JSON(http.StatusInternalServerError, err.Error())
 	}
@@ -0,0 +1,188 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"produtos-favoritos/src/api/forms"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CartController struct {
+	BaseController
+	CartService     servicers.CartServicer
+	CurrencyService servicers.CurrencyServicer
+}
+
+func NewCartController(cartService servicers.CartServicer,
+	currencyService servicers.CurrencyServicer) handlers.CartHandler {
+	return &CartController{CartService: cartService, CurrencyService: currencyService}
+}
+
+// GetCart godoc
+// @Security     ApiKeyAuth
+// @Summary      Get Cart
+// @Description  Get the items in the customer cart with the unit price they had when added, the quantities and the total
+// @Tags         cart
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        currency  query  string  false  "Also show the product prices in this currency (or use the Accept-Currency header)"
+// @Success      200  {object}  models.Cart
+// @Router       /api/v1/customers/{id}/cart [get]
+func (cc *CartController) Get(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+
+	cart, err := cc.CartService.GetCart(customerID)
+	if err != nil {
+		cc.respondError(c, err)
+		return
+	}
+
+	products := make([]*models.Product, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		products = append(products, item.Product)
+	}
+	if !cc.convertPrices(c, cc.CurrencyService, products...) {
+		return
+	}
+	cc.respond(c, cart)
+}
+
+// AddCartItem godoc
+// @Security     ApiKeyAuth
+// @Summary      Add Product To Cart
+// @Description  Add units of a product to the cart with its current price, the quantity is added to the units already in the cart
+// @Tags         cart
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        item  body  forms.CartItemForm  true  "CartItemForm form"
+// @Success      200  {object}  models.Cart
+// @Router       /api/v1/customers/{id}/cart/items [post]
+func (cc *CartController) AddItem(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	var form forms.CartItemForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cart, err := cc.CartService.AddItem(customerID, form.ProductID, form.Quantity)
+	if err != nil {
+		cc.respondError(c, err)
+		return
+	}
+	cc.respond(c, cart)
+}
+
+// UpdateCartItem godoc
+// @Security     ApiKeyAuth
+// @Summary      Update Cart Item
+// @Description  Set the quantity of a product in the cart
+// @Tags         cart
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        product_id path string true "Product ID"
+// @Param        item  body  forms.UpdateCartItemForm  true  "UpdateCartItemForm form"
+// @Success      200  {object}  models.Cart
+// @Router       /api/v1/customers/{id}/cart/items/{product_id} [put]
+func (cc *CartController) UpdateItem(c *gin.Context) {
+	customerID, productID, ok := cc.cartItemParams(c)
+	if !ok {
+		return
+	}
+	var form forms.UpdateCartItemForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cart, err := cc.CartService.UpdateItem(customerID, productID, form.Quantity)
+	if err != nil {
+		cc.respondError(c, err)
+		return
+	}
+	cc.respond(c, cart)
+}
+
+// RemoveCartItem godoc
+// @Security     ApiKeyAuth
+// @Summary      Remove Product From Cart
+// @Description  Remove a product from the cart
+// @Tags         cart
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        product_id path string true "Product ID"
+// @Success      200
+// @Router       /api/v1/customers/{id}/cart/items/{product_id} [delete]
+func (cc *CartController) RemoveItem(c *gin.Context) {
+	customerID, productID, ok := cc.cartItemParams(c)
+	if !ok {
+		return
+	}
+
+	if err := cc.CartService.RemoveItem(customerID, productID); err != nil {
+		cc.respondError(c, err)
+		return
+	}
+	cc.respond(c, gin.H{"message": "Product removed from cart"})
+}
+
+// MoveFromWishlist godoc
+// @Security     ApiKeyAuth
+// @Summary      Move Wishlist To Cart
+// @Description  Move a wishlist item, or the whole wishlist when productId is omitted, to the cart in a single transaction.
+// @Description  Items pending validation or out of the catalog are skipped when moving everything.
+// @Description  Set keepInWishlist to copy the items instead of moving them, moved items go to the wishlist trash
+// @Tags         cart
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        move  body  forms.MoveToCartForm  true  "MoveToCartForm form"
+// @Success      200  {object}  models.MoveToCartResult
+// @Router       /api/v1/customers/{id}/cart/move-from-wishlist [post]
+func (cc *CartController) MoveFromWishlist(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	var form forms.MoveToCartForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := cc.CartService.MoveFromWishlist(customerID, form.ProductID, form.KeepInWishlist)
+	if err != nil {
+		cc.respondError(c, err)
+		return
+	}
+	cc.respond(c, result)
+}
+
+func (cc *CartController) cartItemParams(c *gin.Context) (string, int32, bool) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		cc.respondError(c, &exceptions.BadRequestError{Reason: "invalid customer ID"})
+		return "", 0, false
+	}
+	productID, err := strconv.ParseInt(c.Param("product_id"), 10, 32)
+	if err != nil {
+		cc.respondError(c, &exceptions.BadRequestError{Reason: "invalid product ID"})
+		return "", 0, false
+	}
+	return customerID, int32(productID), true
+}
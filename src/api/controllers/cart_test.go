@@ -0,0 +1,152 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/forms"
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+const cartCustomerID = "00000000-0000-0000-0000-000000000000"
+
+func setupCartTestRouter(t *testing.T) (*gin.Engine, *mocks.CartServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	cartService := new(mocks.CartServicer)
+	currencyService := mocks.NewCurrencyServicer(t)
+
+	routeHandlers := mockHandlers()
+	routeHandlers.Cart = NewCartController(cartService, currencyService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, cartService
+}
+
+func serveCartRequest(r *gin.Engine, method string, path string, body interface{}) *httptest.ResponseRecorder {
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req, _ := http.NewRequest(method, "/api/v1/customers/"+path, bytes.NewBuffer(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	return resp
+}
+
+func TestCartController_Get_Success(t *testing.T) {
+	r, mockService := setupCartTestRouter(t)
+
+	mockService.On("GetCart", cartCustomerID).Return(&models.Cart{
+		Items:     []models.CartItem{{ProductID: 1, Quantity: 2, UnitPrice: models.NewMoney(1000, "USD")}},
+		ItemCount: 2,
+		Total:     models.NewMoney(2000, "USD"),
+	}, nil)
+
+	resp := serveCartRequest(r, http.MethodGet, cartCustomerID+"/cart", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(t, float64(20), body["total"])
+	assert.Equal(t, float64(2), body["item_count"])
+	mockService.AssertExpectations(t)
+}
+
+func TestCartController_Get_InvalidCustomer(t *testing.T) {
+	r, mockService := setupCartTestRouter(t)
+
+	resp := serveCartRequest(r, http.MethodGet, "not-a-uuid/cart", nil)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "GetCart", mock.Anything)
+}
+
+func TestCartController_AddItem_Success(t *testing.T) {
+	r, mockService := setupCartTestRouter(t)
+
+	mockService.On("AddItem", cartCustomerID, int32(3), 2).Return(&models.Cart{}, nil)
+
+	resp := serveCartRequest(r, http.MethodPost, cartCustomerID+"/cart/items", forms.CartItemForm{ProductID: 3, Quantity: 2})
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCartController_AddItem_InvalidQuantity(t *testing.T) {
+	r, mockService := setupCartTestRouter(t)
+
+	resp := serveCartRequest(r, http.MethodPost, cartCustomerID+"/cart/items", forms.CartItemForm{ProductID: 3, Quantity: 100})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "AddItem", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCartController_UpdateItem_NotInCart(t *testing.T) {
+	r, mockService := setupCartTestRouter(t)
+
+	mockService.On("UpdateItem", cartCustomerID, int32(3), 5).
+		Return(nil, &exceptions.NotFoundEntityError{Reason: "product not in cart"})
+
+	resp := serveCartRequest(r, http.MethodPut, cartCustomerID+"/cart/items/3", forms.UpdateCartItemForm{Quantity: 5})
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCartController_RemoveItem_InvalidProduct(t *testing.T) {
+	r, mockService := setupCartTestRouter(t)
+
+	resp := serveCartRequest(r, http.MethodDelete, cartCustomerID+"/cart/items/abc", nil)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "RemoveItem", mock.Anything, mock.Anything)
+}
+
+func TestCartController_MoveFromWishlist_All(t *testing.T) {
+	r, mockService := setupCartTestRouter(t)
+
+	mockService.On("MoveFromWishlist", cartCustomerID, (*int32)(nil), true).
+		Return(&models.MoveToCartResult{Cart: &models.Cart{}, Moved: []int32{1}, Skipped: []int32{}}, nil)
+
+	resp := serveCartRequest(r, http.MethodPost, cartCustomerID+"/cart/move-from-wishlist",
+		forms.MoveToCartForm{KeepInWishlist: true})
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCartController_MoveFromWishlist_Single(t *testing.T) {
+	r, mockService := setupCartTestRouter(t)
+	productID := int32(4)
+
+	mockService.On("MoveFromWishlist", cartCustomerID, &productID, false).
+		Return(nil, &exceptions.BadRequestError{Reason: "product is pending validation"})
+
+	resp := serveCartRequest(r, http.MethodPost, cartCustomerID+"/cart/move-from-wishlist",
+		forms.MoveToCartForm{ProductID: &productID})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"net/http"
+
+	"produtos-favoritos/src/api/forms"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CatalogWebhookController struct {
+	BaseController
+	CatalogEventService servicers.CatalogEventServicer
+}
+
+func NewCatalogWebhookController(service servicers.CatalogEventServicer) handlers.CatalogWebhookHandler {
+	return &CatalogWebhookController{CatalogEventService: service}
+}
+
+// ReceiveCatalogEvent godoc
+// @Summary      Receive catalog change
+// @Description  Catalog change notification (product updated, deleted or price changed). Signed with X-Signature, the hex HMAC-SHA256 of the body. Events older than the last one applied to the product are logged without changing it
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        X-Signature  header  string  true  "HMAC-SHA256 of the body"
+// @Param        event  body      forms.CatalogEventForm  true  "CatalogEventForm form"
+// @Success      200
+// @Router       /webhooks/catalog [post]
+func (wc *CatalogWebhookController) Receive(c *gin.Context) {
+	var form forms.CatalogEventForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !form.Validate() {
+		c.JSON(http.StatusUnprocessableEntity, form.GetErrors())
+		return
+	}
+
+	processed, err := wc.CatalogEventService.Process(form.ToModel())
+	if err != nil {
+		wc.respondError(c, err)
+		return
+	}
+	if !processed {
+		wc.respond(c, gin.H{"message": "Event already processed"})
+		return
+	}
+
+	wc.respond(c, gin.H{"message": "Event processed"})
+}
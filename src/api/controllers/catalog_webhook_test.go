@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/middlewares"
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+const testWebhookSecret = "webhook-secret"
+
+func setupCatalogWebhookTestRouter(t *testing.T) (*gin.Engine, *mocks.CatalogEventServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	config.CATALOG_WEBHOOK_SECRET = testWebhookSecret
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	catalogEventService := mocks.NewCatalogEventServicer(t)
+	routeHandlers := mockHandlers()
+	routeHandlers.CatalogWebhook = NewCatalogWebhookController(catalogEventService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, catalogEventService
+}
+
+func signedCatalogRequest(body string, secret string) *http.Request {
+	req, _ := http.NewRequest(http.MethodPost, "/webhooks/catalog", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Signature", "sha256="+hex.EncodeToString(middlewares.Sign([]byte(body), secret)))
+	return req
+}
+
+func TestCatalogWebhookController_Receive_Processed(t *testing.T) {
+	r, mockService := setupCatalogWebhookTestRouter(t)
+
+	price := models.NewMoney(9990, "USD")
+	mockService.On("Process", &models.CatalogEvent{
+		ID:         "evt-1",
+		Type:       models.CatalogEventProductPriceChanged,
+		ProductID:  3,
+		Price:      &price,
+		OccurredAt: time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC),
+	}).Return(true, nil)
+
+	body := `{"event_id":"evt-1","type":"product.price_changed","product_id":3,"occurred_at":"2026-10-19T10:00:00Z","price":99.9}`
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, signedCatalogRequest(body, testWebhookSecret))
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), "Event processed")
+}
+
+func TestCatalogWebhookController_Receive_Duplicate(t *testing.T) {
+	r, mockService := setupCatalogWebhookTestRouter(t)
+
+	mockService.On("Process", mock.AnythingOfType("*models.CatalogEvent")).Return(false, nil)
+
+	body := `{"event_id":"evt-1","type":"product.deleted","product_id":3,"occurred_at":"2026-10-19T10:00:00Z"}`
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, signedCatalogRequest(body, testWebhookSecret))
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), "already processed")
+}
+
+func TestCatalogWebhookController_Receive_InvalidSignature(t *testing.T) {
+	r, _ := setupCatalogWebhookTestRouter(t)
+
+	body := `{"event_id":"evt-1","type":"product.deleted","product_id":3,"occurred_at":"2026-10-19T10:00:00Z"}`
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, signedCatalogRequest(body, "another-secret"))
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+}
+
+func TestCatalogWebhookController_Receive_MissingSignature(t *testing.T) {
+	r, _ := setupCatalogWebhookTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodPost, "/webhooks/catalog",
+		bytes.NewBufferString(`{"event_id":"evt-1","type":"product.deleted","product_id":3,"occurred_at":"2026-10-19T10:00:00Z"}`))
+	req.Header.Set("Content-Type", "application/json")
+	// The customer api key is not accepted in place of the signature
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+}
+
+func TestCatalogWebhookController_Receive_MissingOccurredAt(t *testing.T) {
+	r, _ := setupCatalogWebhookTestRouter(t)
+
+	body := `{"event_id":"evt-1","type":"product.deleted","product_id":3}`
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, signedCatalogRequest(body, testWebhookSecret))
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestCatalogWebhookController_Receive_UnknownEventType(t *testing.T) {
+	r, _ := setupCatalogWebhookTestRouter(t)
+
+	body := `{"event_id":"evt-1","type":"product.created","product_id":3,"occurred_at":"2026-10-19T10:00:00Z"}`
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, signedCatalogRequest(body, testWebhookSecret))
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestCatalogWebhookController_Receive_NegativePrice(t *testing.T) {
+	r, _ := setupCatalogWebhookTestRouter(t)
+
+	body := `{"event_id":"evt-1","type":"product.price_changed","product_id":3,"occurred_at":"2026-10-19T10:00:00Z","price":-1}`
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, signedCatalogRequest(body, testWebhookSecret))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+}
+
+func TestCatalogWebhookController_Receive_Error(t *testing.T) {
+	r, mockService := setupCatalogWebhookTestRouter(t)
+
+	mockService.On("Process", mock.AnythingOfType("*models.CatalogEvent")).Return(false, assert.AnError)
+
+	body := `{"event_id":"evt-1","type":"product.updated","product_id":3,"occurred_at":"2026-10-19T10:00:00Z"}`
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, signedCatalogRequest(body, testWebhookSecret))
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
+
+func TestCatalogWebhookController_Receive_BodyTooLarge(t *testing.T) {
+	r, _ := setupCatalogWebhookTestRouter(t)
+
+	body := `{"event_id":"evt-1","type":"product.deleted","product_id":3,"occurred_at":"2026-10-19T10:00:00Z","padding":"` +
+		strings.Repeat("x", 2<<20) + `"}`
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, signedCatalogRequest(body, testWebhookSecret))
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.Code)
+}
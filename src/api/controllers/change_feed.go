@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"net/http"
+
+	"produtos-favoritos/src/api/forms"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ChangeFeedController struct {
+	BaseController
+	ChangeFeedService servicers.ChangeFeedServicer
+}
+
+func NewChangeFeedController(changeFeedService servicers.ChangeFeedServicer) handlers.ChangeFeedHandler {
+	return &ChangeFeedController{ChangeFeedService: changeFeedService}
+}
+
+// ListChanges godoc
+// @Security     ApiKeyAuth
+// @Summary      List changes
+// @Description  Created, updated and deleted customers and wishlist items in the order they were committed. Start
+// @Description  without a cursor and send the next_cursor of each page in the following request. With wait the
+// @Description  request is held until there are changes or the seconds pass (up to CHANGE_FEED_MAX_WAIT).
+// @Description  The data of a change is the row after it, deletions have none. Changes are kept for
+// @Description  CHANGE_FEED_RETENTION, an older cursor gets 410 and the data has to be mirrored again
+// @Tags         changes
+// @Produce      json
+// @Param        cursor  query  string  false  "Position to continue from, the next_cursor of the previous page"
+// @Param        limit   query  int     false  "Page size (default 100, max 1000)"
+// @Param        wait    query  int     false  "Seconds to wait for changes when there are none"
+// @Success      200  {object}  models.ChangeFeedPage
+// @Router       /api/v1/changes [get]
+func (fc *ChangeFeedController) List(c *gin.Context) {
+	var form forms.ChangeFeedForm
+	if err := c.ShouldBindQuery(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := fc.ChangeFeedService.ListChanges(c.Request.Context(), form.Cursor, form.GetLimit(), form.GetWait())
+	if err != nil {
+		fc.respondError(c, err)
+		return
+	}
+	fc.respond(c, page)
+}
@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func setupChangeFeedTestRouter(t *testing.T) (*gin.Engine, *mocks.ChangeFeedServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	changeFeedService := new(mocks.ChangeFeedServicer)
+
+	routeHandlers := mockHandlers()
+	routeHandlers.ChangeFeed = NewChangeFeedController(changeFeedService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, changeFeedService
+}
+
+func changeFeedRequest(query string) *http.Request {
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/changes"+query, nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	return req
+}
+
+func TestChangeFeedController_List(t *testing.T) {
+	r, mockService := setupChangeFeedTestRouter(t)
+
+	mockService.On("ListChanges", mock.Anything, "10-3", 50, 20*time.Second).Return(&models.ChangeFeedPage{
+		Changes: []models.ChangeEvent{{Cursor: "11-4", Entity: models.ChangeEntityWishlistItem,
+			Action: models.ChangeDeleted}},
+		NextCursor: "11-4",
+	}, nil)
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, changeFeedRequest("?cursor=10-3&limit=50&wait=20"))
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var page map[string]any
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &page))
+	assert.Equal(t, "11-4", page["next_cursor"])
+	change := page["changes"].([]any)[0].(map[string]any)
+	assert.Equal(t, "deleted", change["action"])
+	assert.Nil(t, change["data"])
+	mockService.AssertExpectations(t)
+}
+
+func TestChangeFeedController_List_Defaults(t *testing.T) {
+	r, mockService := setupChangeFeedTestRouter(t)
+
+	mockService.On("ListChanges", mock.Anything, "", 100, time.Duration(0)).
+		Return(&models.ChangeFeedPage{Changes: []models.ChangeEvent{}}, nil)
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, changeFeedRequest(""))
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestChangeFeedController_List_InvalidLimit(t *testing.T) {
+	r, mockService := setupChangeFeedTestRouter(t)
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, changeFeedRequest("?limit=5000"))
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "ListChanges", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChangeFeedController_List_ExpiredCursor(t *testing.T) {
+	r, mockService := setupChangeFeedTestRouter(t)
+
+	mockService.On("ListChanges", mock.Anything, "1-1", 100, time.Duration(0)).
+		Return(nil, &exceptions.GoneError{Reason: "the cursor is older than the change feed retention"})
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, changeFeedRequest("?cursor=1-1"))
+
+	assert.Equal(t, http.StatusGone, resp.Code)
+}
@@ -0,0 +1,128 @@
+package controllers
+
+import (
+	"net/http"
+
+	"produtos-favoritos/src/api/forms"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CurrencyController struct {
+	BaseController
+	CurrencyService servicers.CurrencyServicer
+}
+
+func NewCurrencyController(currencyService servicers.CurrencyServicer) handlers.CurrencyHandler {
+	return &CurrencyController{CurrencyService: currencyService}
+}
+
+// ListRates godoc
+// @Security     ApiKeyAuth
+// @Summary      List exchange rates
+// @Description  Rates from the catalog currency (USD) to the other supported currencies
+// @Tags         currencies
+// @Produce      json
+// @Success      200  {array}  models.ExchangeRate
+// @Router       /api/v1/currencies/rates [get]
+func (cc *CurrencyController) ListRates(c *gin.Context) {
+	rates, err := cc.CurrencyService.ListRates()
+	if err != nil {
+		cc.respondError(c, err)
+		return
+	}
+	cc.respond(c, rates)
+}
+
+// SetRate godoc
+// @Security     ApiKeyAuth
+// @Security     AdminKeyAuth
+// @Summary      Set exchange rate
+// @Description  Create or replace the rate of a currency, in units of the currency per USD
+// @Tags         currencies
+// @Accept       json
+// @Produce      json
+// @Param        currency  path  string  true  "ISO 4217 currency code"
+// @Param        rate  body  forms.ExchangeRateForm  true  "ExchangeRateForm form"
+// @Success      200
+// @Router       /api/v1/admin/currencies/rates/{currency} [put]
+func (cc *CurrencyController) SetRate(c *gin.Context) {
+	var form forms.ExchangeRateForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := cc.CurrencyService.SetRate(form.ToModel(c.Param("currency"))); err != nil {
+		cc.respondError(c, err)
+		return
+	}
+	cc.respond(c, gin.H{"message": "Exchange rate saved"})
+}
+
+// DeleteRate godoc
+// @Security     ApiKeyAuth
+// @Security     AdminKeyAuth
+// @Summary      Delete exchange rate
+// @Description  Stop supporting a currency
+// @Tags         currencies
+// @Param        currency  path  string  true  "ISO 4217 currency code"
+// @Success      204
+// @Router       /api/v1/admin/currencies/rates/{currency} [delete]
+func (cc *CurrencyController) DeleteRate(c *gin.Context) {
+	if err := cc.CurrencyService.DeleteRate(c.Param("currency")); err != nil {
+		cc.respondError(c, err)
+		return
+	}
+	cc.respondSuccessNoContent(c)
+}
+
+// ImportRates godoc
+// @Security     ApiKeyAuth
+// @Security     AdminKeyAuth
+// @Summary      Import exchange rates
+// @Description  Upload a CSV with "currency,rate,rate_date" lines (rate_date as YYYY-MM-DD). Nothing is saved when a line is invalid
+// @Tags         currencies
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file  formData  file  true  "CSV file"
+// @Success      200
+// @Router       /api/v1/admin/currencies/rates/import [post]
+func (cc *CurrencyController) ImportRates(c *gin.Context) {
+	header, err := c.FormFile("file")
+	if err != nil {
+		cc.respondError(c, &exceptions.BadRequestError{Reason: "missing file"})
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		cc.respondError(c, err)
+		return
+	}
+	defer file.Close()
+
+	imported, err := cc.CurrencyService.ImportRates(file)
+	if err != nil {
+		cc.respondError(c, err)
+		return
+	}
+	cc.respond(c, gin.H{"imported": imported})
+}
+
+// convertPrices adds the converted prices when the client asked for a currency.
+// It returns false when the conversion failed and the error was already sent.
+func (b *BaseController) convertPrices(ctx *gin.Context, currencyService servicers.CurrencyServicer, products ...*models.Product) bool {
+	currency := b.requestedCurrency(ctx)
+	if currency == "" {
+		return true
+	}
+	if err := currencyService.ConvertProducts(currency, products...); err != nil {
+		b.respondError(ctx, err)
+		return false
+	}
+	return true
+}
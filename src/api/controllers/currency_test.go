@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+const testAdminKey = "admin-key"
+
+func setupCurrencyTestRouter(t *testing.T) (*gin.Engine, *mocks.CurrencyServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	config.ADMIN_API_KEY = testAdminKey
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	currencyService := mocks.NewCurrencyServicer(t)
+	routeHandlers := mockHandlers()
+	routeHandlers.Currency = NewCurrencyController(currencyService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, currencyService
+}
+
+func adminRequest(method string, url string, body *bytes.Buffer) *http.Request {
+	req, _ := http.NewRequest(method, url, body)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Admin-Key", testAdminKey)
+	return req
+}
+
+func TestCurrencyController_ListRates(t *testing.T) {
+	r, mockService := setupCurrencyTestRouter(t)
+
+	mockService.On("ListRates").Return([]models.ExchangeRate{{Currency: "BRL", Rate: 5.4321}}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/currencies/rates", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var rates []models.ExchangeRate
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &rates))
+	assert.Equal(t, "BRL", rates[0].Currency)
+}
+
+func TestCurrencyController_SetRate(t *testing.T) {
+	r, mockService := setupCurrencyTestRouter(t)
+
+	mockService.On("SetRate", &models.ExchangeRate{
+		Currency: "BRL",
+		Rate:     5.4321,
+		RateDate: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+	}).Return(nil)
+
+	body := bytes.NewBufferString(`{"rate": 5.4321, "rate_date": "2026-10-19"}`)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodPut, "/api/v1/admin/currencies/rates/BRL", body))
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestCurrencyController_SetRate_InvalidDate(t *testing.T) {
+	r, _ := setupCurrencyTestRouter(t)
+
+	body := bytes.NewBufferString(`{"rate": 5.4321, "rate_date": "19/10/2026"}`)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodPut, "/api/v1/admin/currencies/rates/BRL", body))
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestCurrencyController_AdminKeyRequired(t *testing.T) {
+	r, _ := setupCurrencyTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/admin/currencies/rates/BRL", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	req.Header.Set("X-Admin-Key", "wrong")
+	resp = httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestCurrencyController_DeleteRate_NotFound(t *testing.T) {
+	r, mockService := setupCurrencyTestRouter(t)
+
+	mockService.On("DeleteRate", "EUR").Return(&exceptions.NotFoundEntityError{Reason: "exchange rate not found"})
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodDelete, "/api/v1/admin/currencies/rates/EUR", &bytes.Buffer{}))
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func TestCurrencyController_ImportRates(t *testing.T) {
+	r, mockService := setupCurrencyTestRouter(t)
+
+	mockService.On("ImportRates", mock.Anything).Return(2, nil)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "rates.csv")
+	_, _ = part.Write([]byte("BRL,5.4321,2026-10-19\nEUR,0.92,2026-10-19\n"))
+	_ = writer.Close()
+
+	req := adminRequest(http.MethodPost, "/api/v1/admin/currencies/rates/import", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"imported": 2}`, resp.Body.String())
+}
+
+func TestCurrencyController_ImportRates_MissingFile(t *testing.T) {
+	r, _ := setupCurrencyTestRouter(t)
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodPost, "/api/v1/admin/currencies/rates/import", &bytes.Buffer{}))
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
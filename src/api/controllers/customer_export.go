@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"produtos-favoritos/src/api/forms"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CustomerExportController struct {
+	BaseController
+	ExportService servicers.CustomerExportServicer
+}
+
+func NewCustomerExportController(exportService servicers.CustomerExportServicer) handlers.CustomerExportHandler {
+	return &CustomerExportController{ExportService: exportService}
+}
+
+// ExportCustomers godoc
+// @Security     ApiKeyAuth
+// @Security     AdminKeyAuth
+// @Summary      Export customers
+// @Description  Stream every customer with the wishlist items. The ndjson export (default) has a customer per
+// @Description  line, the csv export a line per wishlist item with the columns customer_id, name, email,
+// @Description  customer_created_at, customer_updated_at, product_id, title, category, status, quantity,
+// @Description  added_price, added_at and deleted_at. With updated_since only the customers with a change in
+// @Description  the change feed after it are exported: the customer updated, or wishlist items added, edited or
+// @Description  removed. Customers deleted after it are exported with deleted_at and no name or email.
+// @Description  updated_since must be within the change feed retention, older ones get a 410 and need a full
+// @Description  export. An error after the first rows truncates the response.
+// @Tags         exports
+// @Produce      application/x-ndjson
+// @Produce      text/csv
+// @Produce      application/gzip
+// @Param        format         query  string  false  "File format"  Enums(ndjson, csv)
+// @Param        gzip           query  bool    false  "Gzip the file"
+// @Param        updated_since  query  string  false  "RFC 3339 time of the previous export"
+// @Success      200  {object}  models.ExportedCustomer
+// @Router       /api/v1/admin/exports/customers [get]
+func (ec *CustomerExportController) Export(c *gin.Context) {
+	var form forms.CustomerExportForm
+	if err := c.ShouldBindQuery(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	options := form.ToOptions()
+
+	filename := fmt.Sprintf("customers-%s.%s", time.Now().UTC().Format("20060102T150405Z"), options.Format)
+	contentType := "application/x-ndjson"
+	if options.Format == models.CustomerExportCSV {
+		contentType = "text/csv; charset=utf-8"
+	}
+	if options.Gzip {
+		filename += ".gz"
+		contentType = "application/gzip"
+	}
+	c.Header("Content-Type", contentType)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+	count, err := ec.ExportService.ExportCustomers(c.Writer, options)
+	if err != nil {
+		if !c.Writer.Written() {
+			c.Header("Content-Type", "")
+			c.Header("Content-Disposition", "")
+			ec.respondError(c, err)
+			return
+		}
+		// the status was sent with the first rows, the client sees a
+		// truncated file
+		log.Printf("customer export failed after %d customers: %v", count, err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func setupCustomerExportTestRouter(t *testing.T) (*gin.Engine, *mocks.CustomerExportServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	config.ADMIN_API_KEY = testAdminKey
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	exportService := new(mocks.CustomerExportServicer)
+
+	routeHandlers := mockHandlers()
+	routeHandlers.CustomerExport = NewCustomerExportController(exportService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, exportService
+}
+
+func TestCustomerExportController_Export_NDJSON(t *testing.T) {
+	r, mockService := setupCustomerExportTestRouter(t)
+
+	mockService.On("ExportCustomers", mock.Anything, models.CustomerExportOptions{Format: models.CustomerExportNDJSON}).
+		Run(func(args mock.Arguments) {
+			_, _ = args.Get(0).(io.Writer).Write([]byte("{\"id\":\"1\"}\n"))
+		}).Return(1, nil)
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodGet, "/api/v1/admin/exports/customers", bytes.NewBuffer(nil)))
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+	assert.Contains(t, resp.Header().Get("Content-Disposition"), ".ndjson")
+	assert.Equal(t, "{\"id\":\"1\"}\n", resp.Body.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestCustomerExportController_Export_GzipCSVUpdatedSince(t *testing.T) {
+	r, mockService := setupCustomerExportTestRouter(t)
+
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("ExportCustomers", mock.Anything, mock.MatchedBy(func(options models.CustomerExportOptions) bool {
+		return options.Format == models.CustomerExportCSV && options.Gzip &&
+			options.UpdatedSince != nil && options.UpdatedSince.Equal(since)
+	})).Return(0, nil)
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodGet,
+		"/api/v1/admin/exports/customers?format=csv&gzip=true&updated_since=2026-10-01T00:00:00Z", bytes.NewBuffer(nil)))
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "application/gzip", resp.Header().Get("Content-Type"))
+	assert.Contains(t, resp.Header().Get("Content-Disposition"), ".csv.gz")
+	mockService.AssertExpectations(t)
+}
+
+func TestCustomerExportController_Export_InvalidFormat(t *testing.T) {
+	r, mockService := setupCustomerExportTestRouter(t)
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodGet, "/api/v1/admin/exports/customers?format=xml", bytes.NewBuffer(nil)))
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "ExportCustomers", mock.Anything, mock.Anything)
+}
+
+func TestCustomerExportController_Export_FailsBeforeFirstRows(t *testing.T) {
+	r, mockService := setupCustomerExportTestRouter(t)
+
+	mockService.On("ExportCustomers", mock.Anything, mock.Anything).Return(0, errors.New("database is down"))
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodGet, "/api/v1/admin/exports/customers", bytes.NewBuffer(nil)))
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, resp.Header().Get("Content-Type"), "application/json")
+	assert.Empty(t, resp.Header().Get("Content-Disposition"))
+}
+
+func TestCustomerExportController_Export_RequiresAdminKey(t *testing.T) {
+	r, mockService := setupCustomerExportTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/exports/customers", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	mockService.AssertNotCalled(t, "ExportCustomers", mock.Anything, mock.Anything)
+}
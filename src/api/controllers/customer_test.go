@@ -35,10 +35,9 @@ func setupTestRouter(t *testing.T) (*gin.Engine, *mocks.CustomerServicer) {
 	mockCustomerService := mocks.NewCustomerServicer(t) // Adjust if your mock package name differs
 	mockCustomerController := NewCustomerController(mockCustomerService)
 
-	productHandler := new(mocks.ProductHandler)
-	wishlistHandler := new(mocks.WishlistHandler)
-	// Passing nil for productController and wishlistController for now, can add mocks if needed
-	router.SetupRouter(r, mockCustomerController, productHandler, wishlistHandler)
+	routeHandlers := mockHandlers()
+	routeHandlers.Customer = mockCustomerController
+	router.SetupRouter(r, routeHandlers)
 
 	return r, mockCustomerService
 }
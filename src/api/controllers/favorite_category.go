@@ -0,0 +1,152 @@
+package controllers
+
+import (
+	"net/http"
+
+	"produtos-favoritos/src/api/forms"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type FavoriteCategoryController struct {
+	BaseController
+	FavoriteCategoryService servicers.FavoriteCategoryServicer
+}
+
+func NewFavoriteCategoryController(favoriteCategoryService servicers.FavoriteCategoryServicer) handlers.FavoriteCategoryHandler {
+	return &FavoriteCategoryController{FavoriteCategoryService: favoriteCategoryService}
+}
+
+// ListFavoriteCategories godoc
+// @Security     ApiKeyAuth
+// @Summary      List Favorite Categories
+// @Description  Get the catalog categories the customer follows
+// @Tags         favorite-categories
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Success      200  {array}  models.FavoriteCategory
+// @Router       /api/v1/customers/{id}/favorite-categories [get]
+func (fc *FavoriteCategoryController) List(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+
+	categories, err := fc.FavoriteCategoryService.ListCategories(customerID)
+	if err != nil {
+		fc.respondError(c, err)
+		return
+	}
+	fc.respond(c, categories)
+}
+
+// FollowCategory godoc
+// @Security     ApiKeyAuth
+// @Summary      Follow Category
+// @Description  Add a catalog category to the customer favorites, following it again changes nothing
+// @Tags         favorite-categories
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        category  body  forms.FavoriteCategoryForm  true  "FavoriteCategoryForm form"
+// @Success      201  {object}  models.FavoriteCategory
+// @Router       /api/v1/customers/{id}/favorite-categories [post]
+func (fc *FavoriteCategoryController) Follow(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	var form forms.FavoriteCategoryForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	favorite, err := fc.FavoriteCategoryService.FollowCategory(customerID, form.Category)
+	if err != nil {
+		fc.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, favorite)
+}
+
+// UnfollowCategory godoc
+// @Security     ApiKeyAuth
+// @Summary      Unfollow Category
+// @Description  Remove a category from the customer favorites
+// @Tags         favorite-categories
+// @Param        id path string true "Customer ID"
+// @Param        category path string true "Category name"
+// @Success      204
+// @Router       /api/v1/customers/{id}/favorite-categories/{category} [delete]
+func (fc *FavoriteCategoryController) Unfollow(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+
+	if err := fc.FavoriteCategoryService.UnfollowCategory(customerID, c.Param("category")); err != nil {
+		fc.respondError(c, err)
+		return
+	}
+	fc.respondSuccessNoContent(c)
+}
+
+// ListNewInFavoriteCategories godoc
+// @Security     ApiKeyAuth
+// @Summary      New In Favorite Categories
+// @Description  Get the products added to the followed categories since the last acknowledged cursor, the listing doesn't move the checkpoint
+// @Tags         favorite-categories
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Success      200  {object}  models.NewInFavoriteCategories
+// @Router       /api/v1/customers/{id}/favorite-categories/new [get]
+func (fc *FavoriteCategoryController) ListNew(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+
+	news, err := fc.FavoriteCategoryService.ListNewProducts(customerID)
+	if err != nil {
+		fc.respondError(c, err)
+		return
+	}
+	fc.respond(c, news)
+}
+
+// AcknowledgeNewInFavoriteCategories godoc
+// @Security     ApiKeyAuth
+// @Summary      Acknowledge New In Favorite Categories
+// @Description  Move the checkpoint to the cursor of a listing of new products, an older cursor changes nothing
+// @Tags         favorite-categories
+// @Accept       json
+// @Param        id path string true "Customer ID"
+// @Param        ack  body  forms.NewInFavoriteCategoriesAckForm  true  "NewInFavoriteCategoriesAckForm form"
+// @Success      204
+// @Router       /api/v1/customers/{id}/favorite-categories/new/ack [post]
+func (fc *FavoriteCategoryController) AcknowledgeNew(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	var form forms.NewInFavoriteCategoriesAckForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := fc.FavoriteCategoryService.AcknowledgeNewProducts(customerID, *form.Cursor); err != nil {
+		fc.respondError(c, err)
+		return
+	}
+	fc.respondSuccessNoContent(c)
+}
@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+const favoriteCategoryCustomerID = "00000000-0000-0000-0000-000000000000"
+
+func setupFavoriteCategoryTestRouter(t *testing.T) (*gin.Engine, *mocks.FavoriteCategoryServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	favoriteCategoryService := new(mocks.FavoriteCategoryServicer)
+
+	routeHandlers := mockHandlers()
+	routeHandlers.FavoriteCategory = NewFavoriteCategoryController(favoriteCategoryService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, favoriteCategoryService
+}
+
+func TestFavoriteCategoryController_Follow_Success(t *testing.T) {
+	r, mockService := setupFavoriteCategoryTestRouter(t)
+
+	mockService.On("FollowCategory", favoriteCategoryCustomerID, "jewelery").
+		Return(&models.FavoriteCategory{Category: "jewelery"}, nil)
+
+	resp := serveCartRequest(r, http.MethodPost, favoriteCategoryCustomerID+"/favorite-categories",
+		map[string]string{"category": "jewelery"})
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestFavoriteCategoryController_Follow_UnknownCategory(t *testing.T) {
+	r, mockService := setupFavoriteCategoryTestRouter(t)
+
+	mockService.On("FollowCategory", favoriteCategoryCustomerID, "furniture").
+		Return(nil, &exceptions.InvalidEntityError{Reason: "unknown category"})
+
+	resp := serveCartRequest(r, http.MethodPost, favoriteCategoryCustomerID+"/favorite-categories",
+		map[string]string{"category": "furniture"})
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+}
+
+func TestFavoriteCategoryController_Follow_MissingCategory(t *testing.T) {
+	r, mockService := setupFavoriteCategoryTestRouter(t)
+
+	resp := serveCartRequest(r, http.MethodPost, favoriteCategoryCustomerID+"/favorite-categories",
+		map[string]string{})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "FollowCategory", mock.Anything, mock.Anything)
+}
+
+func TestFavoriteCategoryController_Unfollow_EscapedName(t *testing.T) {
+	r, mockService := setupFavoriteCategoryTestRouter(t)
+
+	mockService.On("UnfollowCategory", favoriteCategoryCustomerID, "men's clothing").Return(nil)
+
+	resp := serveCartRequest(r, http.MethodDelete, favoriteCategoryCustomerID+"/favorite-categories/men%27s%20clothing", nil)
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestFavoriteCategoryController_ListNew_Success(t *testing.T) {
+	r, mockService := setupFavoriteCategoryTestRouter(t)
+
+	mockService.On("ListNewProducts", favoriteCategoryCustomerID).
+		Return(&models.NewInFavoriteCategories{Items: []models.Product{{ID: 21, Category: "jewelery"}}}, nil)
+
+	resp := serveCartRequest(r, http.MethodGet, favoriteCategoryCustomerID+"/favorite-categories/new", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var body models.NewInFavoriteCategories
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(t, int32(21), body.Items[0].ID)
+	mockService.AssertExpectations(t)
+}
+
+func TestFavoriteCategoryController_AcknowledgeNew_Success(t *testing.T) {
+	r, mockService := setupFavoriteCategoryTestRouter(t)
+
+	cursor := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	mockService.On("AcknowledgeNewProducts", favoriteCategoryCustomerID, mock.MatchedBy(func(c time.Time) bool {
+		return c.Equal(cursor)
+	})).Return(nil)
+
+	resp := serveCartRequest(r, http.MethodPost, favoriteCategoryCustomerID+"/favorite-categories/new/ack",
+		map[string]string{"cursor": cursor.Format(time.RFC3339Nano)})
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestFavoriteCategoryController_AcknowledgeNew_MissingCursor(t *testing.T) {
+	r, mockService := setupFavoriteCategoryTestRouter(t)
+
+	resp := serveCartRequest(r, http.MethodPost, favoriteCategoryCustomerID+"/favorite-categories/new/ack",
+		map[string]string{})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "AcknowledgeNewProducts", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,30 @@
+package controllers
+
+import (
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+// mockHandlers returns the router handlers backed by mocks, each test
+// replaces the handler it exercises with the real controller.
+func mockHandlers() router.Handlers {
+	return router.Handlers{
+		Customer:         new(mocks.CustomerHandler),
+		Product:          new(mocks.ProductHandler),
+		Wishlist:         new(mocks.WishlistHandler),
+		CatalogWebhook:   new(mocks.CatalogWebhookHandler),
+		Currency:         new(mocks.CurrencyHandler),
+		Cart:             new(mocks.CartHandler),
+		Collaboration:    new(mocks.WishlistCollaborationHandler),
+		Registry:         new(mocks.RegistryHandler),
+		Tag:              new(mocks.TagHandler),
+		Trash:            new(mocks.WishlistTrashHandler),
+		Reminder:         new(mocks.ReminderHandler),
+		WishlistRule:     new(mocks.WishlistRuleHandler),
+		FavoriteCategory: new(mocks.FavoriteCategoryHandler),
+		RecentlyViewed:   new(mocks.RecentlyViewedHandler),
+		CustomerExport:   new(mocks.CustomerExportHandler),
+		ImportJob:        new(mocks.ImportJobHandler),
+		ChangeFeed:       new(mocks.ChangeFeedHandler),
+	}
+}
@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/internals/exceptions"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ImportJobController struct {
+	BaseController
+	CustomerImportService servicers.CustomerImportServicer
+}
+
+func NewImportJobController(customerImportService servicers.CustomerImportServicer) handlers.ImportJobHandler {
+	return &ImportJobController{CustomerImportService: customerImportService}
+}
+
+// ImportCustomers godoc
+// @Security     ApiKeyAuth
+// @Summary      Import customers
+// @Description  Upload a csv with the columns name, email and the optional product_ids (separated by "|"). The file is
+// @Description  processed in the background, every row is validated like a create customer request and its products
+// @Description  are wishlisted. Follow the job for the progress and the error report
+// @Tags         customers
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file  formData  file  true  "CSV file"
+// @Success      202  {object}  models.ImportJob
+// @Router       /api/v1/customers/import [post]
+func (jc *ImportJobController) ImportCustomers(c *gin.Context) {
+	header, err := c.FormFile("file")
+	if err != nil {
+		jc.respondError(c, &exceptions.BadRequestError{Reason: "missing file"})
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		jc.respondError(c, err)
+		return
+	}
+	defer file.Close()
+
+	job, err := jc.CustomerImportService.ImportCustomers(header.Filename, file)
+	if err != nil {
+		jc.respondError(c, err)
+		return
+	}
+	c.Header("Location", fmt.Sprintf("/api/v1/jobs/%s", job.ID))
+	c.JSON(http.StatusAccepted, job)
+}
+
+// GetJob godoc
+// @Security     ApiKeyAuth
+// @Summary      Get job
+// @Description  Status and progress of a background import, the error report is at /api/v1/jobs/{id}/errors
+// @Tags         jobs
+// @Produce      json
+// @Param        id   path      string  true  "Job ID"
+// @Success      200  {object}  models.ImportJob
+// @Router       /api/v1/jobs/{id} [get]
+func (jc *ImportJobController) Get(c *gin.Context) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid job ID"})
+		return
+	}
+
+	job, err := jc.CustomerImportService.GetJob(id)
+	if err != nil {
+		jc.respondError(c, err)
+		return
+	}
+	jc.respond(c, job)
+}
+
+// GetJobErrors godoc
+// @Security     ApiKeyAuth
+// @Summary      Download job error report
+// @Description  The rows that failed so far as csv, with the columns line, email, product_id and error. A row with
+// @Description  a product_id created the customer but could not wishlist the product
+// @Tags         jobs
+// @Produce      text/csv
+// @Param        id   path      string  true  "Job ID"
+// @Success      200  {array}   models.ImportJobError
+// @Router       /api/v1/jobs/{id}/errors [get]
+func (jc *ImportJobController) ErrorReport(c *gin.Context) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid job ID"})
+		return
+	}
+
+	jobErrors, err := jc.CustomerImportService.ListJobErrors(id)
+	if err != nil {
+		jc.respondError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="job-%s-errors.csv"`, id))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Status(http.StatusOK)
+	writer := csv.NewWriter(c.Writer)
+	_ = writer.Write([]string{"line", "email", "product_id", "error"})
+	for _, jobError := range jobErrors {
+		productID := ""
+		if jobError.ProductID != nil {
+			productID = strconv.Itoa(int(*jobError.ProductID))
+		}
+		_ = writer.Write([]string{strconv.Itoa(jobError.Line), jobError.Email, productID, jobError.Error})
+	}
+	writer.Flush()
+}
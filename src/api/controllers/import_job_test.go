@@ -0,0 +1,163 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func setupImportJobTestRouter(t *testing.T) (*gin.Engine, *mocks.CustomerImportServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	importService := new(mocks.CustomerImportServicer)
+
+	routeHandlers := mockHandlers()
+	routeHandlers.ImportJob = NewImportJobController(importService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, importService
+}
+
+func customerImportRequest(content string) *http.Request {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "partner.csv")
+	_, _ = part.Write([]byte(content))
+	_ = writer.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/customers/import", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	return req
+}
+
+func TestImportJobController_ImportCustomers_Accepted(t *testing.T) {
+	r, mockService := setupImportJobTestRouter(t)
+
+	job := &models.ImportJob{BaseModel: models.BaseModel{ID: uuid.New()}, Status: models.ImportJobPending, TotalRows: 1}
+	mockService.On("ImportCustomers", "partner.csv", mock.Anything).Return(job, nil)
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, customerImportRequest("name,email\nAna,ana@ig.com\n"))
+
+	assert.Equal(t, http.StatusAccepted, resp.Code)
+	assert.Equal(t, "/api/v1/jobs/"+job.ID.String(), resp.Header().Get("Location"))
+	var body models.ImportJob
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(t, job.ID, body.ID)
+	mockService.AssertExpectations(t)
+}
+
+func TestImportJobController_ImportCustomers_InvalidFile(t *testing.T) {
+	r, mockService := setupImportJobTestRouter(t)
+
+	mockService.On("ImportCustomers", mock.Anything, mock.Anything).
+		Return(nil, &exceptions.InvalidEntityError{Reason: "the csv header needs the name and email columns"})
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, customerImportRequest("phone\n123\n"))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+}
+
+func TestImportJobController_ImportCustomers_MissingFile(t *testing.T) {
+	r, mockService := setupImportJobTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/customers/import", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "ImportCustomers", mock.Anything, mock.Anything)
+}
+
+func TestImportJobController_Get(t *testing.T) {
+	r, mockService := setupImportJobTestRouter(t)
+
+	jobID := uuid.New()
+	mockService.On("GetJob", jobID.String()).Return(&models.ImportJob{BaseModel: models.BaseModel{ID: jobID},
+		Status: models.ImportJobRunning, TotalRows: 200, ProcessedRows: 50, Progress: 25}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/jobs/"+jobID.String(), nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var body map[string]any
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(t, float64(25), body["progress"])
+	assert.Equal(t, models.ImportJobRunning, body["status"])
+	assert.NotContains(t, body, "file")
+}
+
+func TestImportJobController_Get_NotFound(t *testing.T) {
+	r, mockService := setupImportJobTestRouter(t)
+
+	jobID := uuid.New().String()
+	mockService.On("GetJob", jobID).Return(nil, &exceptions.NotFoundEntityError{Reason: "job not found"})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/jobs/"+jobID, nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func TestImportJobController_Get_InvalidID(t *testing.T) {
+	r, mockService := setupImportJobTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/jobs/not-a-uuid", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "GetJob", mock.Anything)
+}
+
+func TestImportJobController_ErrorReport(t *testing.T) {
+	r, mockService := setupImportJobTestRouter(t)
+
+	jobID := uuid.New().String()
+	productID := int32(2)
+	mockService.On("ListJobErrors", jobID).Return([]models.ImportJobError{
+		{Line: 2, Email: "ana@ig.com", ProductID: &productID, Error: "product not found"},
+		{Line: 3, Email: "ana@ig.com", Error: "this email is already registered"},
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/jobs/"+jobID+"/errors", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", resp.Header().Get("Content-Type"))
+	assert.Equal(t, "line,email,product_id,error\n"+
+		"2,ana@ig.com,2,product not found\n"+
+		"3,ana@ig.com,,this email is already registered\n", resp.Body.String())
+}
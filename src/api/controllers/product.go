@@ -1,34 +1,212 @@
 package controllers
 
 import (
+	"net/http"
+	"strconv"
+
+	"produtos-favoritos/src/api/forms"
 	handlers "produtos-favoritos/src/domain/interfaces/controllers"
 	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type ProductController struct {
 	BaseController
-	ProductService servicers.ProductServicer
+	ProductService  servicers.ProductServicer
+	CurrencyService servicers.CurrencyServicer
+	WishlistService servicers.WishlistServicer
 }
 
-func NewProductController(productService servicers.ProductServicer) handlers.ProductHandler {
-	return &ProductController{ProductService: productService}
+func NewProductController(productService servicers.ProductServicer,
+	currencyService servicers.CurrencyServicer,
+	wishlistService servicers.WishlistServicer) handlers.ProductHandler {
+	return &ProductController{
+		ProductService:  productService,
+		CurrencyService: currencyService,
+		WishlistService: wishlistService,
+	}
 }
 
 // GetProducts godoc
 // @Security     ApiKeyAuth
 // @Summary      List products
-// @Description  Get all products
+// @Description  Browse the catalog with filters, sorting and pagination
 // @Tags         products
 // @Produce      json
-// @Success      200  {array}  models.Product
+// @Param        category   query  string  false  "Category name"
+// @Param        min_price  query  number  false  "Minimum price"
+// @Param        max_price  query  number  false  "Maximum price"
+// @Param        min_rating query  number  false  "Minimum rating (0 to 5)"
+// @Param        q          query  string  false  "Text to search in title and description"
+// @Param        sort       query  string  false  "Sort field"  Enums(price, title, rating)
+// @Param        order      query  string  false  "Sort order"  Enums(asc, desc)
+// @Param        limit      query  int     false  "Page size"
+// @Param        offset     query  int     false  "Page offset"
+// @Param        currency   query  string  false  "Also show the prices in this currency (or use the Accept-Currency header)"
+// @Param        customer_id query string  false  "Flag the products in the customer wishlist and list their collections"
+// @Success      200  {object}  models.ProductPage
 // @Router       /api/v1/products [get]
 func (pc *ProductController) List(c *gin.Context) {
-	customers, err := pc.ProductService.GetProducts()
+	var form forms.ProductFilterForm
+	if err := c.ShouldBindQuery(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !form.Validate() {
+		c.JSON(http.StatusUnprocessableEntity, form.GetErrors())
+		return
+	}
+
+	page, err := pc.ProductService.ListProducts(form.ToModel())
+	if err != nil {
+		pc.respondError(c, err)
+		return
+	}
+
+	products := make([]*models.Product, len(page.Items))
+	for i := range page.Items {
+		products[i] = &page.Items[i]
+	}
+	if !pc.convertPrices(c, pc.CurrencyService, products...) {
+		return
+	}
+	if !pc.markWishlisted(c, products...) {
+		return
+	}
+	pc.respond(c, page)
+}
+
+// GetProductById godoc
+// @Security     ApiKeyAuth
+// @Summary      Get Product by Id
+// @Description  Get a single product from the catalog
+// @Tags         products
+// @Produce      json
+// @Param        id path int true "Product ID"
+// @Param        currency  query  string  false  "Also show the price in this currency (or use the Accept-Currency header)"
+// @Param        customer_id query string false  "Flag whether the product is in the customer wishlist and list its collections"
+// @Success      200  {object}  models.Product
+// @Router       /api/v1/products/{id} [get]
+func (pc *ProductController) GetByID(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || productID < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	product, err := pc.ProductService.GetProductByID(int32(productID))
+	if err != nil {
+		pc.respondError(c, err)
+		return
+	}
+	if !pc.convertPrices(c, pc.CurrencyService, product) {
+		return
+	}
+	if !pc.markWishlisted(c, product) {
+		return
+	}
+	pc.respond(c, product)
+}
+
+// SearchProducts godoc
+// @Security     ApiKeyAuth
+// @Summary      Search products
+// @Description  Full-text search over title, description and category (english and portuguese) with typo tolerance.
+// @Description  Matched terms are wrapped in <mark></mark>, facets count the matches per category.
+// @Tags         products
+// @Produce      json
+// @Param        q         query  string  true   "Search terms"
+// @Param        category  query  string  false  "Restrict the results to a category"
+// @Param        limit     query  int     false  "Page size (default 20)"
+// @Param        offset    query  int     false  "Page offset"
+// @Param        currency  query  string  false  "Also show the prices in this currency (or use the Accept-Currency header)"
+// @Success      200  {object}  models.ProductSearchPage
+// @Router       /api/v1/products/search [get]
+func (pc *ProductController) Search(c *gin.Context) {
+	var form forms.ProductSearchForm
+	if err := c.ShouldBindQuery(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := pc.ProductService.SearchProducts(form.ToModel())
+	if err != nil {
+		pc.respondError(c, err)
+		return
+	}
+
+	products := make([]*models.Product, len(page.Items))
+	for i := range page.Items {
+		products[i] = &page.Items[i].Product
+	}
+	if !pc.convertPrices(c, pc.CurrencyService, products...) {
+		return
+	}
+	pc.respond(c, page)
+}
+
+// GetProductChanges godoc
+// @Security     ApiKeyAuth
+// @Summary      List catalog changes
+// @Description  Changes detected by the catalog mirror after the given time, oldest first.
+// @Description  Updates have one entry per changed field.
+// @Tags         products
+// @Produce      json
+// @Param        since   query  string  true   "RFC 3339 timestamp, only later changes are returned"
+// @Param        limit   query  int     false  "Page size (default 100)"
+// @Param        offset  query  int     false  "Page offset"
+// @Success      200  {object}  models.ProductChangePage
+// @Router       /api/v1/products/changes [get]
+func (pc *ProductController) Changes(c *gin.Context) {
+	var form forms.ProductChangesForm
+	if err := c.ShouldBindQuery(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := pc.ProductService.GetProductChanges(form.Since, form.GetLimit(), form.Offset)
+	if err != nil {
+		pc.respondError(c, err)
+		return
+	}
+	pc.respond(c, page)
+}
+
+// GetCategories godoc
+// @Security     ApiKeyAuth
+// @Summary      List categories
+// @Description  Get all catalog categories
+// @Tags         products
+// @Produce      json
+// @Success      200  {array}  string
+// @Router       /api/v1/products/categories [get]
+func (pc *ProductController) Categories(c *gin.Context) {
+	categories, err := pc.ProductService.GetCategories()
 	if err != nil {
 		pc.respondError(c, err)
 		return
 	}
-	pc.respond(c, customers)
+	pc.respond(c, categories)
+}
+
+// markWishlisted annotates the products for the customer_id query parameter,
+// when there is one. It returns false when it failed and the error was already sent.
+func (pc *ProductController) markWishlisted(c *gin.Context, products ...*models.Product) bool {
+	customerID := c.Query("customer_id")
+	if customerID == "" {
+		return true
+	}
+	if _, err := uuid.Parse(customerID); err != nil {
+		pc.respondError(c, &exceptions.BadRequestError{Reason: "invalid customer ID"})
+		return false
+	}
+	if err := pc.WishlistService.MarkWishlisted(customerID, products...); err != nil {
+		pc.respondError(c, err)
+		return false
+	}
+	return true
 }
@@ -7,19 +7,32 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"produtos-favoritos/src/api/router"
 	"produtos-favoritos/src/domain/models"
 	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
 	"produtos-favoritos/src/internals/mocks"
 )
 
 // Setup test router with mock product controller
 func setupProductTestRouter(t *testing.T) (*gin.Engine, *mocks.ProductServicer) {
+	r, mockProductService, _ := setupProductCurrencyTestRouter(t)
+	return r, mockProductService
+}
+
+func setupProductCurrencyTestRouter(t *testing.T) (*gin.Engine, *mocks.ProductServicer, *mocks.CurrencyServicer) {
+	r, mockProductService, mockCurrencyService, _ := setupProductWishlistTestRouter(t)
+	return r, mockProductService, mockCurrencyService
+}
+
+func setupProductWishlistTestRouter(t *testing.T) (*gin.Engine, *mocks.ProductServicer, *mocks.CurrencyServicer, *mocks.WishlistServicer) {
 	err := godotenv.Load("../../../.env")
 	if err != nil {
 		log.Fatal("Error loading .env file")
@@ -30,14 +43,15 @@ func setupProductTestRouter(t *testing.T) (*gin.Engine, *mocks.ProductServicer)
 	r := gin.New()
 
 	mockProductService := mocks.NewProductServicer(t) // Adjust if your mock package name differs
-	productController := NewProductController(mockProductService)
+	mockCurrencyService := mocks.NewCurrencyServicer(t)
+	mockWishlistService := mocks.NewWishlistServicer(t)
+	productController := NewProductController(mockProductService, mockCurrencyService, mockWishlistService)
 
-	customerHandler := new(mocks.CustomerHandler)
-	wishlistHandler := new(mocks.WishlistHandler)
-	// Passing nil for productController and wishlistController for now, can add mocks if needed
-	router.SetupRouter(r, customerHandler, productController, wishlistHandler)
+	routeHandlers := mockHandlers()
+	routeHandlers.Product = productController
+	router.SetupRouter(r, routeHandlers)
 
-	return r, mockProductService
+	return r, mockProductService, mockCurrencyService, mockWishlistService
 }
 
 // Sample mock data
@@ -51,7 +65,11 @@ var mockProducts = []models.Product{
 func TestProductController_List_Success(t *testing.T) {
 	r, mockService := setupProductTestRouter(t)
 
-	mockService.On("GetProducts").Return(mockProducts, nil)
+	total := len(mockProducts)
+	mockService.On("ListProducts", models.ProductFilter{}).Return(&models.ProductPage{
+		Items:      mockProducts,
+		Pagination: models.Pagination{Count: total, Total: &total},
+	}, nil)
 
 	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/", nil)
 	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
@@ -61,18 +79,71 @@ func TestProductController_List_Success(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, resp.Code)
 
-	var products []models.Product
-	err := json.Unmarshal(resp.Body.Bytes(), &products)
+	var page models.ProductPage
+	err := json.Unmarshal(resp.Body.Bytes(), &page)
 	assert.NoError(t, err)
-	assert.Len(t, products, 1)
-	assert.Equal(t, "Laptop", products[0].Title)
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, "Laptop", page.Items[0].Title)
+	assert.Equal(t, 1, *page.Pagination.Total)
+	mockService.AssertExpectations(t)
+}
+
+func TestProductController_List_WithFilters(t *testing.T) {
+	r, mockService := setupProductTestRouter(t)
+
+	minPrice := models.NewMoney(1000, "USD")
+	maxPrice := models.NewMoney(9990, "USD")
+	expectedFilter := models.ProductFilter{
+		Category: "electronics",
+		MinPrice: &minPrice,
+		MaxPrice: &maxPrice,
+		Query:    "ssd",
+		SortBy:   "price",
+		Order:    "desc",
+		Limit:    5,
+		Offset:   10,
+	}
+	mockService.On("ListProducts", expectedFilter).Return(&models.ProductPage{Items: []models.Product{}}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet,
+		"/api/v1/products/?category=electronics&min_price=10&max_price=99.9&q=ssd&sort=price&order=desc&limit=5&offset=10", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
 	mockService.AssertExpectations(t)
 }
 
+func TestProductController_List_InvalidSort(t *testing.T) {
+	r, _ := setupProductTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/?sort=color", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestProductController_List_InvalidPriceRange(t *testing.T) {
+	r, _ := setupProductTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/?min_price=50&max_price=10", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+}
+
 func TestProductController_List_Error(t *testing.T) {
 	r, mockService := setupProductTestRouter(t)
 
-	mockService.On("GetProducts").Return(nil, assert.AnError)
+	mockService.On("ListProducts", models.ProductFilter{}).Return(nil, assert.AnError)
 
 	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/", nil)
 	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
@@ -83,3 +154,267 @@ func TestProductController_List_Error(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 	mockService.AssertExpectations(t)
 }
+
+func TestProductController_GetByID_Success(t *testing.T) {
+	r, mockService := setupProductTestRouter(t)
+
+	mockService.On("GetProductByID", int32(7)).Return(&models.Product{ID: 7, Title: "Laptop"}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/7", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var product models.Product
+	err := json.Unmarshal(resp.Body.Bytes(), &product)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(7), product.ID)
+	mockService.AssertExpectations(t)
+}
+
+func TestProductController_GetByID_NotFound(t *testing.T) {
+	r, mockService := setupProductTestRouter(t)
+
+	mockService.On("GetProductByID", int32(999)).Return(nil, &exceptions.NotFoundEntityError{Reason: "product not found"})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/999", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestProductController_GetByID_InvalidID(t *testing.T) {
+	r, _ := setupProductTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/abc", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestProductController_Categories_Success(t *testing.T) {
+	r, mockService := setupProductTestRouter(t)
+
+	mockService.On("GetCategories").Return([]string{"electronics", "jewelery"}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/categories", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var categories []string
+	err := json.Unmarshal(resp.Body.Bytes(), &categories)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"electronics", "jewelery"}, categories)
+	mockService.AssertExpectations(t)
+}
+
+func TestProductController_Categories_Error(t *testing.T) {
+	r, mockService := setupProductTestRouter(t)
+
+	mockService.On("GetCategories").Return(nil, assert.AnError)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/categories", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestProductController_Search_Success(t *testing.T) {
+	r, mockService := setupProductTestRouter(t)
+
+	total := 1
+	mockService.On("SearchProducts", models.ProductSearch{Query: "laptop", Category: "electronics", Limit: 20}).
+		Return(&models.ProductSearchPage{
+			Items: []models.ProductSearchResult{
+				{Product: mockProducts[0], TitleHighlight: "<mark>Laptop</mark>"},
+			},
+			Facets:     []models.CategoryFacet{{Category: "electronics", Count: 1}},
+			Pagination: models.Pagination{Limit: 20, Count: 1, Total: &total},
+		}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/search?q=laptop&category=electronics", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var page models.ProductSearchPage
+	err := json.Unmarshal(resp.Body.Bytes(), &page)
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, "<mark>Laptop</mark>", page.Items[0].TitleHighlight)
+	assert.Equal(t, "electronics", page.Facets[0].Category)
+	mockService.AssertExpectations(t)
+}
+
+func TestProductController_Search_MissingQuery(t *testing.T) {
+	r, _ := setupProductTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/search", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestProductController_Changes_Success(t *testing.T) {
+	r, mockService := setupProductTestRouter(t)
+
+	since := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	mockService.On("GetProductChanges", since, 100, 0).Return(&models.ProductChangePage{
+		Items: []models.ProductChange{
+			{ID: 1, ProductID: 1, Type: models.ProductChangeUpdated, Field: "price", OldValue: "10.00", NewValue: "8.50"},
+		},
+		Pagination: models.Pagination{Limit: 100, Count: 1},
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/changes?since=2026-10-01T12:00:00Z", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var page models.ProductChangePage
+	err := json.Unmarshal(resp.Body.Bytes(), &page)
+	assert.NoError(t, err)
+	assert.Equal(t, "price", page.Items[0].Field)
+	mockService.AssertExpectations(t)
+}
+
+func TestProductController_Changes_InvalidSince(t *testing.T) {
+	r, _ := setupProductTestRouter(t)
+
+	for _, query := range []string{"", "?since=yesterday"} {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/changes"+query, nil)
+		req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+		resp := httptest.NewRecorder()
+
+		r.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	}
+}
+
+func TestProductController_GetByID_WithCurrency(t *testing.T) {
+	r, mockService, mockCurrency := setupProductCurrencyTestRouter(t)
+
+	product := &models.Product{ID: 1, Title: "Laptop", Price: models.NewMoney(10995, "USD")}
+	mockService.On("GetProductByID", int32(1)).Return(product, nil)
+	mockCurrency.On("ConvertProducts", "BRL", product).Run(func(args mock.Arguments) {
+		args.Get(1).(*models.Product).ConvertedPrice = &models.ConvertedPrice{
+			Price: models.NewMoney(59726, "BRL"), Currency: "BRL", Rate: 5.4321,
+		}
+	}).Return(nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/1", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	req.Header.Set("Accept-Currency", "BRL")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"price":109.95`)
+	assert.Contains(t, resp.Body.String(), `"converted_price":{"price":597.26,"currency":"BRL","rate":5.4321`)
+}
+
+func TestProductController_List_UnsupportedCurrency(t *testing.T) {
+	r, mockService, mockCurrency := setupProductCurrencyTestRouter(t)
+
+	mockService.On("ListProducts", models.ProductFilter{}).Return(&models.ProductPage{Items: mockProducts}, nil)
+	mockCurrency.On("ConvertProducts", "XYZ", mock.Anything).
+		Return(&exceptions.BadRequestError{Reason: "no exchange rate for XYZ"})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/?currency=XYZ", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestProductController_List_WithCustomer(t *testing.T) {
+	r, mockService, _, mockWishlistService := setupProductWishlistTestRouter(t)
+
+	customerID := "00000000-0000-0000-0000-000000000000"
+	mockService.On("ListProducts", models.ProductFilter{}).Return(&models.ProductPage{
+		Items: []models.Product{{ID: 1}, {ID: 2}},
+	}, nil)
+	mockWishlistService.On("MarkWishlisted", customerID, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			wishlisted, notWishlisted := true, false
+			args.Get(1).(*models.Product).Wishlisted = &wishlisted
+			args.Get(1).(*models.Product).Collections = []string{"gift"}
+			args.Get(2).(*models.Product).Wishlisted = &notWishlisted
+		}).Return(nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/?customer_id="+customerID, nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var page struct {
+		Items []map[string]interface{} `json:"items"`
+	}
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &page))
+	assert.Equal(t, true, page.Items[0]["wishlisted"])
+	assert.Equal(t, []interface{}{"gift"}, page.Items[0]["collections"])
+	assert.Equal(t, false, page.Items[1]["wishlisted"])
+}
+
+func TestProductController_GetByID_WithoutCustomer(t *testing.T) {
+	r, mockService := setupProductTestRouter(t)
+
+	mockService.On("GetProductByID", int32(7)).Return(&models.Product{ID: 7}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/7", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.NotContains(t, resp.Body.String(), "wishlisted")
+}
+
+func TestProductController_GetByID_InvalidCustomer(t *testing.T) {
+	r, mockService, _, mockWishlistService := setupProductWishlistTestRouter(t)
+
+	mockService.On("GetProductByID", int32(7)).Return(&models.Product{ID: 7}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/products/7?customer_id=abc", nil)
+	req.Header.Set("X-Api-Key", os.Getenv("API_KEY"))
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockWishlistService.AssertNotCalled(t, "MarkWishlisted", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"net/http"
+
+	"produtos-favoritos/src/api/forms"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RecentlyViewedController struct {
+	BaseController
+	RecentlyViewedService servicers.RecentlyViewedServicer
+}
+
+func NewRecentlyViewedController(recentlyViewedService servicers.RecentlyViewedServicer) handlers.RecentlyViewedHandler {
+	return &RecentlyViewedController{RecentlyViewedService: recentlyViewedService}
+}
+
+// RecordProductView godoc
+// @Security     ApiKeyAuth
+// @Summary      Record Product View
+// @Description  Record that the customer viewed a product. The view is written in the background and shows up in the history after a few seconds.
+// @Description  404 for an unknown customer, 503 when too many views are waiting to be written. Views of products missing from the catalog are dropped and counted in the admin metrics
+// @Tags         recently-viewed
+// @Accept       json
+// @Param        id path string true "Customer ID"
+// @Param        view  body  forms.ProductViewForm  true  "ProductViewForm form"
+// @Success      202
+// @Router       /api/v1/customers/{id}/recently-viewed [post]
+func (rc *RecentlyViewedController) RecordView(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	var form forms.ProductViewForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := rc.RecentlyViewedService.RecordView(customerID, form.ProductID, form.ViewedAt); err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+// ListRecentlyViewed godoc
+// @Security     ApiKeyAuth
+// @Summary      List Recently Viewed
+// @Description  Get the products the customer viewed, most recent first and once each
+// @Tags         recently-viewed
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        limit query int false "Max number of products, up to the configured history size"
+// @Success      200  {array}  models.ProductView
+// @Router       /api/v1/customers/{id}/recently-viewed [get]
+func (rc *RecentlyViewedController) List(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	var form forms.RecentlyViewedForm
+	if err := c.ShouldBindQuery(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	views, err := rc.RecentlyViewedService.ListRecentlyViewed(customerID, form.Limit)
+	if err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respond(c, views)
+}
@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"log"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+const recentlyViewedCustomerID = "00000000-0000-0000-0000-000000000000"
+
+func setupRecentlyViewedTestRouter(t *testing.T) (*gin.Engine, *mocks.RecentlyViewedServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	recentlyViewedService := new(mocks.RecentlyViewedServicer)
+
+	routeHandlers := mockHandlers()
+	routeHandlers.RecentlyViewed = NewRecentlyViewedController(recentlyViewedService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, recentlyViewedService
+}
+
+func TestRecentlyViewedController_RecordView_Accepted(t *testing.T) {
+	r, mockService := setupRecentlyViewedTestRouter(t)
+
+	mockService.On("RecordView", recentlyViewedCustomerID, int32(5), time.Time{}).Return(nil)
+
+	resp := serveCartRequest(r, http.MethodPost, recentlyViewedCustomerID+"/recently-viewed",
+		map[string]int{"product_id": 5})
+
+	assert.Equal(t, http.StatusAccepted, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestRecentlyViewedController_RecordView_BufferFull(t *testing.T) {
+	r, mockService := setupRecentlyViewedTestRouter(t)
+
+	mockService.On("RecordView", recentlyViewedCustomerID, int32(5), time.Time{}).
+		Return(&exceptions.UnavailableError{Reason: "too many views waiting to be recorded, retry later"})
+
+	resp := serveCartRequest(r, http.MethodPost, recentlyViewedCustomerID+"/recently-viewed",
+		map[string]int{"product_id": 5})
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+}
+
+func TestRecentlyViewedController_RecordView_MissingProduct(t *testing.T) {
+	r, mockService := setupRecentlyViewedTestRouter(t)
+
+	resp := serveCartRequest(r, http.MethodPost, recentlyViewedCustomerID+"/recently-viewed",
+		map[string]int{})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "RecordView", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRecentlyViewedController_List_Success(t *testing.T) {
+	r, mockService := setupRecentlyViewedTestRouter(t)
+
+	mockService.On("ListRecentlyViewed", recentlyViewedCustomerID, 10).
+		Return([]models.ProductView{{ProductID: 5, Product: &models.Product{ID: 5}}}, nil)
+
+	resp := serveCartRequest(r, http.MethodGet, recentlyViewedCustomerID+"/recently-viewed?limit=10", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
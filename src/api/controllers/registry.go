@@ -0,0 +1,226 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"produtos-favoritos/src/api/forms"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RegistryController struct {
+	BaseController
+	RegistryService servicers.RegistryServicer
+}
+
+func NewRegistryController(registryService servicers.RegistryServicer) handlers.RegistryHandler {
+	return &RegistryController{RegistryService: registryService}
+}
+
+// GetRegistry godoc
+// @Security     ApiKeyAuth
+// @Summary      Get Gift Registry
+// @Description  Get the customer gift registry with the token to share with the guests.
+// @Description  The reservations are left out so the owner doesn't know what was already bought
+// @Tags         registry
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Success      200  {object}  models.Registry
+// @Router       /api/v1/customers/{id}/registry [get]
+func (rc *RegistryController) Get(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+
+	registry, err := rc.RegistryService.GetOwnerRegistry(customerID)
+	if err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respond(c, registry)
+}
+
+// EnableRegistry godoc
+// @Security     ApiKeyAuth
+// @Summary      Enable Gift Registry
+// @Description  Turn the customer wishlist into a gift registry guests can reserve items from.
+// @Description  Enabling it again only changes the title, the token stays the same
+// @Tags         registry
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        registry  body  forms.RegistryForm  true  "RegistryForm form"
+// @Success      200  {object}  models.WishlistRegistry
+// @Router       /api/v1/customers/{id}/registry [put]
+func (rc *RegistryController) Enable(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	var form forms.RegistryForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	registry, err := rc.RegistryService.EnableRegistry(customerID, form.Title)
+	if err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respond(c, registry)
+}
+
+// DisableRegistry godoc
+// @Security     ApiKeyAuth
+// @Summary      Disable Gift Registry
+// @Description  Turn the gift registry off, the reservations made by the guests are dropped
+// @Tags         registry
+// @Param        id path string true "Customer ID"
+// @Success      204
+// @Router       /api/v1/customers/{id}/registry [delete]
+func (rc *RegistryController) Disable(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+
+	if err := rc.RegistryService.DisableRegistry(customerID); err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respondSuccessNoContent(c)
+}
+
+// SetRegistryItemQuantity godoc
+// @Security     ApiKeyAuth
+// @Summary      Set Registry Item Quantity
+// @Description  Set how many units of a wishlist item the customer wants to receive
+// @Tags         registry
+// @Param        id path string true "Customer ID"
+// @Param        product_id path string true "Product ID"
+// @Param        item  body  forms.RegistryItemForm  true  "RegistryItemForm form"
+// @Success      204
+// @Router       /api/v1/customers/{id}/registry/items/{product_id} [put]
+func (rc *RegistryController) SetItemQuantity(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	productID, err := strconv.ParseInt(c.Param("product_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+	var form forms.RegistryItemForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := rc.RegistryService.SetItemQuantity(customerID, int32(productID), form.Quantity); err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respondSuccessNoContent(c)
+}
+
+// GuestRegistry godoc
+// @Security     ApiKeyAuth
+// @Summary      Guest Gift Registry
+// @Description  Get the gift registry shared with the token, with how many units of each item are still available
+// @Tags         registry
+// @Produce      json
+// @Param        token path string true "Registry token"
+// @Success      200  {object}  models.Registry
+// @Router       /api/v1/registries/{token} [get]
+func (rc *RegistryController) GuestView(c *gin.Context) {
+	registry, err := rc.RegistryService.GetGuestRegistry(c.Param("token"))
+	if err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respond(c, registry)
+}
+
+// ReserveRegistryItem godoc
+// @Security     ApiKeyAuth
+// @Summary      Reserve Registry Item
+// @Description  Reserve units of a registry item so other guests don't buy them. The reservation expires unless
+// @Description  it is confirmed, keep its id to confirm or cancel it
+// @Tags         registry
+// @Produce      json
+// @Param        token path string true "Registry token"
+// @Param        reservation  body  forms.ReservationForm  true  "ReservationForm form"
+// @Success      201  {object}  models.RegistryReservation
+// @Router       /api/v1/registries/{token}/reservations [post]
+func (rc *RegistryController) Reserve(c *gin.Context) {
+	var form forms.ReservationForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reservation, err := rc.RegistryService.Reserve(c.Param("token"), form.ToRequest())
+	if err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, reservation)
+}
+
+// ConfirmReservation godoc
+// @Security     ApiKeyAuth
+// @Summary      Confirm Registry Reservation
+// @Description  Confirm a pending reservation so it doesn't expire
+// @Tags         registry
+// @Produce      json
+// @Param        token path string true "Registry token"
+// @Param        reservation_id path string true "Reservation ID"
+// @Success      200  {object}  models.RegistryReservation
+// @Router       /api/v1/registries/{token}/reservations/{reservation_id}/confirm [post]
+func (rc *RegistryController) ConfirmReservation(c *gin.Context) {
+	reservationID := c.Param("reservation_id")
+	if _, err := uuid.Parse(reservationID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid reservation ID"})
+		return
+	}
+
+	reservation, err := rc.RegistryService.ConfirmReservation(c.Param("token"), reservationID)
+	if err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respond(c, reservation)
+}
+
+// CancelReservation godoc
+// @Security     ApiKeyAuth
+// @Summary      Cancel Registry Reservation
+// @Description  Cancel a reservation so the units are available to other guests again
+// @Tags         registry
+// @Param        token path string true "Registry token"
+// @Param        reservation_id path string true "Reservation ID"
+// @Success      204
+// @Router       /api/v1/registries/{token}/reservations/{reservation_id} [delete]
+func (rc *RegistryController) CancelReservation(c *gin.Context) {
+	reservationID := c.Param("reservation_id")
+	if _, err := uuid.Parse(reservationID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid reservation ID"})
+		return
+	}
+
+	if err := rc.RegistryService.CancelReservation(c.Param("token"), reservationID); err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respondSuccessNoContent(c)
+}
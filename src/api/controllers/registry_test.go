@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/forms"
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+const registryCustomerID = "00000000-0000-0000-0000-000000000000"
+
+func setupRegistryTestRouter() (*gin.Engine, *mocks.RegistryServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	registryService := new(mocks.RegistryServicer)
+
+	routeHandlers := mockHandlers()
+	routeHandlers.Registry = NewRegistryController(registryService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, registryService
+}
+
+func serveRegistryRequest(r *gin.Engine, method string, path string, body interface{}) *httptest.ResponseRecorder {
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req, _ := http.NewRequest(method, "/api/v1/"+path, bytes.NewBuffer(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	return resp
+}
+
+func TestRegistryController_Enable(t *testing.T) {
+	r, mockService := setupRegistryTestRouter()
+
+	mockService.On("EnableRegistry", registryCustomerID, "Wedding").
+		Return(&models.WishlistRegistry{Token: "abc", Title: "Wedding"}, nil)
+
+	resp := serveRegistryRequest(r, http.MethodPut, "customers/"+registryCustomerID+"/registry",
+		forms.RegistryForm{Title: "Wedding"})
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestRegistryController_OwnerViewWithoutReservations(t *testing.T) {
+	r, mockService := setupRegistryTestRouter()
+
+	mockService.On("GetOwnerRegistry", registryCustomerID).Return(&models.Registry{
+		Token: "abc",
+		Items: []models.RegistryItem{{ProductID: 1, Quantity: 2}},
+	}, nil)
+
+	resp := serveRegistryRequest(r, http.MethodGet, "customers/"+registryCustomerID+"/registry", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.NotContains(t, resp.Body.String(), "reserved")
+	assert.NotContains(t, resp.Body.String(), "available")
+	mockService.AssertExpectations(t)
+}
+
+func TestRegistryController_GuestView(t *testing.T) {
+	r, mockService := setupRegistryTestRouter()
+
+	reserved, available := 2, 0
+	mockService.On("GetGuestRegistry", "abc").Return(&models.Registry{
+		Items: []models.RegistryItem{{ProductID: 1, Quantity: 2, Reserved: &reserved, Available: &available}},
+	}, nil)
+
+	resp := serveRegistryRequest(r, http.MethodGet, "registries/abc", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var body models.Registry
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(t, 0, *body.Items[0].Available)
+	mockService.AssertExpectations(t)
+}
+
+func TestRegistryController_Reserve(t *testing.T) {
+	r, mockService := setupRegistryTestRouter()
+
+	request := models.ReservationRequest{ProductID: 1, Quantity: 1, GuestName: "Ana"}
+	mockService.On("Reserve", "abc", request).
+		Return(&models.RegistryReservation{ProductID: 1, Quantity: 1, Status: models.ReservationPending}, nil)
+
+	resp := serveRegistryRequest(r, http.MethodPost, "registries/abc/reservations",
+		forms.ReservationForm{ProductID: 1, Quantity: 1, GuestName: "Ana"})
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestRegistryController_Reserve_MissingGuestName(t *testing.T) {
+	r, mockService := setupRegistryTestRouter()
+
+	resp := serveRegistryRequest(r, http.MethodPost, "registries/abc/reservations",
+		forms.ReservationForm{ProductID: 1, Quantity: 1})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func TestRegistryController_ConfirmReservation_Expired(t *testing.T) {
+	r, mockService := setupRegistryTestRouter()
+
+	reservationID := "22222222-2222-2222-2222-222222222222"
+	mockService.On("ConfirmReservation", "abc", reservationID).
+		Return(nil, &exceptions.NotFoundEntityError{Reason: "reservation not found or expired"})
+
+	resp := serveRegistryRequest(r, http.MethodPost, "registries/abc/reservations/"+reservationID+"/confirm", nil)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestRegistryController_CancelReservation(t *testing.T) {
+	r, mockService := setupRegistryTestRouter()
+
+	reservationID := "22222222-2222-2222-2222-222222222222"
+	mockService.On("CancelReservation", "abc", reservationID).Return(nil)
+
+	resp := serveRegistryRequest(r, http.MethodDelete, "registries/abc/reservations/"+reservationID, nil)
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"net/http"
+
+	"produtos-favoritos/src/api/forms"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReminderController struct {
+	BaseController
+	ReminderService servicers.ReminderServicer
+}
+
+func NewReminderController(reminderService servicers.ReminderServicer) handlers.ReminderHandler {
+	return &ReminderController{ReminderService: reminderService}
+}
+
+// ListReminders godoc
+// @Security     ApiKeyAuth
+// @Summary      List Reminders
+// @Description  Get the reminders sent to the customer about old wishlist items, newest first
+// @Tags         reminders
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Success      200  {array}  models.WishlistReminder
+// @Router       /api/v1/customers/{id}/reminders [get]
+func (rc *ReminderController) List(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+
+	reminders, err := rc.ReminderService.ListReminders(customerID)
+	if err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respond(c, reminders)
+}
+
+// GetReminderPreferences godoc
+// @Security     ApiKeyAuth
+// @Summary      Get Reminder Preferences
+// @Description  Get whether the customer gets reminders about old wishlist items
+// @Tags         reminders
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Success      200  {object}  models.ReminderPreference
+// @Router       /api/v1/customers/{id}/reminders/preferences [get]
+func (rc *ReminderController) GetPreferences(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+
+	preference, err := rc.ReminderService.GetPreference(customerID)
+	if err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respond(c, preference)
+}
+
+// UpdateReminderPreferences godoc
+// @Security     ApiKeyAuth
+// @Summary      Update Reminder Preferences
+// @Description  Opt the customer out of (or back into) the reminders about old wishlist items
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        preferences  body  forms.ReminderPreferenceForm  true  "ReminderPreferenceForm form"
+// @Success      200  {object}  models.ReminderPreference
+// @Router       /api/v1/customers/{id}/reminders/preferences [put]
+func (rc *ReminderController) UpdatePreferences(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	var form forms.ReminderPreferenceForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	preference, err := rc.ReminderService.UpdatePreference(customerID, *form.StaleItemsOptOut)
+	if err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respond(c, preference)
+}
+
+// ListPendingReminders godoc
+// @Security     ApiKeyAuth
+// @Security     AdminKeyAuth
+// @Summary      List pending reminders
+// @Description  Reminders waiting to be delivered by a notification channel, oldest first
+// @Tags         reminders
+// @Produce      json
+// @Param        limit  query  int  false  "Page size (default 100)"
+// @Success      200  {array}  models.WishlistReminder
+// @Router       /api/v1/admin/reminders/pending [get]
+func (rc *ReminderController) ListPending(c *gin.Context) {
+	var form forms.PendingRemindersForm
+	if err := c.ShouldBindQuery(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reminders, err := rc.ReminderService.ListPending(form.GetLimit())
+	if err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respond(c, reminders)
+}
+
+// MarkReminderDelivered godoc
+// @Security     ApiKeyAuth
+// @Security     AdminKeyAuth
+// @Summary      Mark reminder delivered
+// @Description  Called by the notification channel once the reminder reached the customer
+// @Tags         reminders
+// @Param        reminder_id  path  string  true  "Reminder ID"
+// @Success      204
+// @Router       /api/v1/admin/reminders/{reminder_id}/delivered [post]
+func (rc *ReminderController) MarkDelivered(c *gin.Context) {
+	reminderID := c.Param("reminder_id")
+	if _, err := uuid.Parse(reminderID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid reminder ID"})
+		return
+	}
+
+	if err := rc.ReminderService.MarkDelivered(reminderID); err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respondSuccessNoContent(c)
+}
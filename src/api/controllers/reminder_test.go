@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+const reminderCustomerID = "00000000-0000-0000-0000-000000000000"
+
+func setupReminderTestRouter(t *testing.T) (*gin.Engine, *mocks.ReminderServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	config.ADMIN_API_KEY = testAdminKey
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	reminderService := new(mocks.ReminderServicer)
+
+	routeHandlers := mockHandlers()
+	routeHandlers.Reminder = NewReminderController(reminderService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, reminderService
+}
+
+func TestReminderController_List_Success(t *testing.T) {
+	r, mockService := setupReminderTestRouter(t)
+
+	mockService.On("ListReminders", reminderCustomerID).
+		Return([]models.WishlistReminder{{ProductID: 2, Kind: models.ReminderStaleItem}}, nil)
+
+	resp := serveCartRequest(r, http.MethodGet, reminderCustomerID+"/reminders", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var body []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(t, "stale_item", body[0]["kind"])
+	mockService.AssertExpectations(t)
+}
+
+func TestReminderController_UpdatePreferences_OptOut(t *testing.T) {
+	r, mockService := setupReminderTestRouter(t)
+
+	mockService.On("UpdatePreference", reminderCustomerID, true).
+		Return(&models.ReminderPreference{StaleItemsOptOut: true}, nil)
+
+	resp := serveCartRequest(r, http.MethodPut, reminderCustomerID+"/reminders/preferences",
+		map[string]bool{"stale_items_opt_out": true})
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestReminderController_UpdatePreferences_MissingField(t *testing.T) {
+	r, mockService := setupReminderTestRouter(t)
+
+	resp := serveCartRequest(r, http.MethodPut, reminderCustomerID+"/reminders/preferences", map[string]bool{})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "UpdatePreference", mock.Anything, mock.Anything)
+}
+
+func TestReminderController_ListPending_RequiresAdminKey(t *testing.T) {
+	r, mockService := setupReminderTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/reminders/pending", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	mockService.AssertNotCalled(t, "ListPending", mock.Anything)
+}
+
+func TestReminderController_ListPending_DefaultLimit(t *testing.T) {
+	r, mockService := setupReminderTestRouter(t)
+
+	mockService.On("ListPending", 100).Return([]models.WishlistReminder{}, nil)
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodGet, "/api/v1/admin/reminders/pending", bytes.NewBuffer(nil)))
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestReminderController_MarkDelivered_NotPending(t *testing.T) {
+	r, mockService := setupReminderTestRouter(t)
+
+	reminderID := "11111111-1111-1111-1111-111111111111"
+	mockService.On("MarkDelivered", reminderID).
+		Return(&exceptions.NotFoundEntityError{Reason: "pending reminder not found"})
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodPost, "/api/v1/admin/reminders/"+reminderID+"/delivered", bytes.NewBuffer(nil)))
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"produtos-favoritos/src/api/forms"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/internals/exceptions"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TagController struct {
+	BaseController
+	TagService servicers.TagServicer
+}
+
+func NewTagController(tagService servicers.TagServicer) handlers.TagHandler {
+	return &TagController{TagService: tagService}
+}
+
+// ListTags godoc
+// @Security     ApiKeyAuth
+// @Summary      List Tags
+// @Description  Get the customer tags and how many wishlist items use each of them
+// @Tags         tags
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Success      200  {array}  models.TagUsage
+// @Router       /api/v1/customers/{id}/tags [get]
+func (tc *TagController) List(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+
+	tags, err := tc.TagService.ListTags(customerID)
+	if err != nil {
+		tc.respondError(c, err)
+		return
+	}
+	tc.respond(c, tags)
+}
+
+// DeleteTag godoc
+// @Security     ApiKeyAuth
+// @Summary      Delete Tag
+// @Description  Delete the customer tag and remove it from every wishlist item
+// @Tags         tags
+// @Param        id path string true "Customer ID"
+// @Param        tag path string true "Tag name"
+// @Success      204
+// @Router       /api/v1/customers/{id}/tags/{tag} [delete]
+func (tc *TagController) Delete(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+
+	if err := tc.TagService.DeleteTag(customerID, c.Param("tag")); err != nil {
+		tc.respondError(c, err)
+		return
+	}
+	tc.respondSuccessNoContent(c)
+}
+
+// AttachTags godoc
+// @Security     ApiKeyAuth
+// @Summary      Tag Wishlist Item
+// @Description  Put tags on a wishlist item, tags are created on first use and are case insensitive
+// @Tags         tags
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        product_id path string true "Product ID"
+// @Param        tags  body  forms.TagsForm  true  "TagsForm form"
+// @Success      200  {array}  string
+// @Router       /api/v1/customers/{id}/wishlist/{product_id}/tags [post]
+func (tc *TagController) Attach(c *gin.Context) {
+	customerID, productID, ok := tc.itemParams(c)
+	if !ok {
+		return
+	}
+	var form forms.TagsForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tags, err := tc.TagService.AttachTags(customerID, productID, form.Tags)
+	if err != nil {
+		tc.respondError(c, err)
+		return
+	}
+	tc.respond(c, tags)
+}
+
+// DetachTag godoc
+// @Security     ApiKeyAuth
+// @Summary      Untag Wishlist Item
+// @Description  Remove a tag from a wishlist item, the tag is kept for the other items
+// @Tags         tags
+// @Param        id path string true "Customer ID"
+// @Param        product_id path string true "Product ID"
+// @Param        tag path string true "Tag name"
+// @Success      204
+// @Router       /api/v1/customers/{id}/wishlist/{product_id}/tags/{tag} [delete]
+func (tc *TagController) Detach(c *gin.Context) {
+	customerID, productID, ok := tc.itemParams(c)
+	if !ok {
+		return
+	}
+
+	if err := tc.TagService.DetachTag(customerID, productID, c.Param("tag")); err != nil {
+		tc.respondError(c, err)
+		return
+	}
+	tc.respondSuccessNoContent(c)
+}
+
+func (tc *TagController) itemParams(c *gin.Context) (string, int32, bool) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		tc.respondError(c, &exceptions.BadRequestError{Reason: "invalid customer ID"})
+		return "", 0, false
+	}
+	productID, err := strconv.ParseInt(c.Param("product_id"), 10, 32)
+	if err != nil {
+		tc.respondError(c, &exceptions.BadRequestError{Reason: "invalid product ID"})
+		return "", 0, false
+	}
+	return customerID, int32(productID), true
+}
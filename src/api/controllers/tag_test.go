@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/forms"
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+const tagCustomerID = "00000000-0000-0000-0000-000000000000"
+
+func setupTagTestRouter(t *testing.T) (*gin.Engine, *mocks.TagServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	tagService := new(mocks.TagServicer)
+
+	routeHandlers := mockHandlers()
+	routeHandlers.Tag = NewTagController(tagService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, tagService
+}
+
+func TestTagController_List_Success(t *testing.T) {
+	r, mockService := setupTagTestRouter(t)
+
+	mockService.On("ListTags", tagCustomerID).Return([]models.TagUsage{{Name: "gift", ItemCount: 2}}, nil)
+
+	resp := serveCartRequest(r, http.MethodGet, tagCustomerID+"/tags", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var body []models.TagUsage
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(t, []models.TagUsage{{Name: "gift", ItemCount: 2}}, body)
+	mockService.AssertExpectations(t)
+}
+
+func TestTagController_Attach_Success(t *testing.T) {
+	r, mockService := setupTagTestRouter(t)
+
+	mockService.On("AttachTags", tagCustomerID, int32(3), []string{"gift"}).Return([]string{"gift"}, nil)
+
+	resp := serveCartRequest(r, http.MethodPost, tagCustomerID+"/wishlist/3/tags", forms.TagsForm{Tags: []string{"gift"}})
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestTagController_Attach_EmptyTags(t *testing.T) {
+	r, mockService := setupTagTestRouter(t)
+
+	resp := serveCartRequest(r, http.MethodPost, tagCustomerID+"/wishlist/3/tags", forms.TagsForm{Tags: []string{}})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "AttachTags", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTagController_Attach_InvalidProduct(t *testing.T) {
+	r, mockService := setupTagTestRouter(t)
+
+	resp := serveCartRequest(r, http.MethodPost, tagCustomerID+"/wishlist/abc/tags", forms.TagsForm{Tags: []string{"gift"}})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "AttachTags", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTagController_Detach_NotFound(t *testing.T) {
+	r, mockService := setupTagTestRouter(t)
+
+	mockService.On("DetachTag", tagCustomerID, int32(3), "gift").
+		Return(&exceptions.NotFoundEntityError{Reason: "tag not on wishlist item"})
+
+	resp := serveCartRequest(r, http.MethodDelete, tagCustomerID+"/wishlist/3/tags/gift", nil)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestTagController_Delete_Success(t *testing.T) {
+	r, mockService := setupTagTestRouter(t)
+
+	mockService.On("DeleteTag", tagCustomerID, "gift").Return(nil)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/customers/"+tagCustomerID+"/tags/gift", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	mockService.AssertExpectations(t)
+}
@@ -8,6 +8,7 @@ import (
 	"produtos-favoritos/src/api/forms"
 	handlers "produtos-favoritos/src/domain/interfaces/controllers"
 	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
 	"produtos-favoritos/src/internals/exceptions"
 
 	"github.com/gin-gonic/gin"
@@ -17,10 +18,12 @@ import (
 type WishlistController struct {
 	BaseController
 	WishlistService servicers.WishlistServicer
+	CurrencyService servicers.CurrencyServicer
 }
 
-func NewWishlistController(wishlistService servicers.WishlistServicer) handlers.WishlistHandler {
-	return &WishlistController{WishlistService: wishlistService}
+func NewWishlistController(wishlistService servicers.WishlistServicer,
+	currencyService servicers.CurrencyServicer) handlers.WishlistHandler {
+	return &WishlistController{WishlistService: wishlistService, CurrencyService: currencyService}
 }
 
 // WishlistProduct godoc
@@ -29,23 +32,42 @@ func NewWishlistController(wishlistService servicers.WishlistServicer) handlers.
 // @Description  Given a customer and a product add the product to the customer wishlist
 // @Tags         wishlist
 // @Produce      json
+// @Description  When the products api is unavailable the product is queued as pending validation (202)
+// @Description  Editors of a shared wishlist can add products with the X-Customer-ID header
 // @Success      200
+// @Success      202
 // @Param        id path string true "Customer ID"
+// @Description  Products breaking a wishlist rule are refused (422) with the broken rules
+// @Param        X-Customer-ID  header  string  false  "Acting customer, the owner or a collaborator, defaults to the owner"
+// @Param        X-Partner-Key  header  string  false  "Partner integration doing the request, checked by the wishlist rules"
 // @Param        wishlist  body      forms.WishlistForm  true  "WishlistForm form"
 // @Router       /api/v1/customers/{id}/wishlist [post]
 func (wc *WishlistController) WishlistProduct(c *gin.Context) {
 	customerID := c.Param("id")
 	if _, err := uuid.Parse(customerID); err != nil {
-		wc.respondError(c, &exceptions.BadRequestError{})
+		wc.respondError(c, &exceptions.BadRequestError{Reason: "invalid customer ID"})
+		return
+	}
+	actorID, ok := wc.actingCustomer(c)
+	if !ok {
+		return
 	}
 	var form forms.WishlistForm
 	if err := c.ShouldBindJSON(&form); err != nil {
-		wc.respondError(c, &exceptions.BadRequestError{})
+		wc.respondError(c, &exceptions.BadRequestError{Reason: err.Error()})
+		return
 	}
 
-	err := wc.WishlistService.WishlistProduct(form.ProductID, customerID)
+	item, err := wc.WishlistService.WishlistProduct(form.ProductID, customerID, actorID, wc.partnerKey(c))
 	if err != nil {
 		wc.respondError(c, err)
+		return
+	}
+
+	if item.Status == models.WishlistItemPending {
+		wc.respondStale(c)
+		c.JSON(http.StatusAccepted, gin.H{"message": "Product queued for validation", "status": item.Status})
+		return
 	}
 
 	wc.respond(c, gin.H{"message": "Product added to wishlist"})
@@ -55,11 +77,14 @@ func (wc *WishlistController) WishlistProduct(c *gin.Context) {
 // @Security     ApiKeyAuth
 // @Summary      Remove Product From Wishlist
 // @Description  Given a customer and a product remove the product from the wishlist
+// @Description  Editors of a shared wishlist can remove products with the X-Customer-ID header
 // @Tags         wishlist
 // @Produce      json
 // @Success      200
 // @Param        id path string true "Customer ID"
 // @Param        product_id path string true "Product ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, the owner or a collaborator, defaults to the owner"
+// @Param        X-Partner-Key  header  string  false  "Partner integration doing the request, checked by the wishlist rules"
 // @Router       /api/v1/customers/{id}/wishlist/{product_id} [delete]
 func (wc *WishlistController) RemoveFromWishlist(c *gin.Context) {
 	customerID := c.Param("id")
@@ -67,6 +92,10 @@ func (wc *WishlistController) RemoveFromWishlist(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
 		return
 	}
+	actorID, ok := wc.actingCustomer(c)
+	if !ok {
+		return
+	}
 	productIDParam := c.Param("product_id")
 
 	productID, err := strconv.Atoi(productIDParam)
@@ -75,16 +104,227 @@ func (wc *WishlistController) RemoveFromWishlist(c *gin.Context) {
 		return
 	}
 
-	err = wc.WishlistService.RemoveProductFromWishlist(customerID, int32(productID))
+	err = wc.WishlistService.RemoveProductFromWishlist(customerID, int32(productID), actorID, wc.partnerKey(c))
 	if err != nil {
 		var notFoundErr *exceptions.NotFoundEntityError
 		if errors.As(err, &notFoundErr) {
 			c.JSON(http.StatusNotFound, gin.H{"error": notFoundErr.Error()})
 			return
 		}
+		var forbiddenErr *exceptions.ForbiddenError
+		if errors.As(err, &forbiddenErr) {
+			c.JSON(http.StatusForbidden, gin.H{"error": forbiddenErr.Error()})
+			return
+		}
+		var policyErr *exceptions.PolicyViolationError
+		if errors.As(err, &policyErr) {
+			wc.respondError(c, policyErr)
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{"message": "Product removed from wishlist"})
 }
+
+// ListWishlist godoc
+// @Security     ApiKeyAuth
+// @Summary      List Wishlist
+// @Description  Get the items in the customer wishlist, flagged as stale when the products api is unavailable
+// @Description  Collaborators of a shared wishlist can see it with the X-Customer-ID header
+// @Tags         wishlist
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, the owner or a collaborator, defaults to the owner"
+// @Param        currency  query  string  false  "Also show the prices in this currency (or use the Accept-Currency header)"
+// @Param        tags  query  string  false  "Only the items with these comma separated tags"
+// @Param        match  query  string  false  "Match any (default) or all of the tags"  Enums(any, all)
+// @Success      200  {object}  models.Wishlist
+// @Router       /api/v1/customers/{id}/wishlist [get]
+func (wc *WishlistController) List(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	actorID, ok := wc.actingCustomer(c)
+	if !ok {
+		return
+	}
+	var filter forms.WishlistFilterForm
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	wishlist, err := wc.WishlistService.GetWishlist(customerID, actorID, filter.GetFilter())
+	if err != nil {
+		wc.respondError(c, err)
+		return
+	}
+
+	products := make([]*models.Product, 0, len(wishlist.Items))
+	for _, item := range wishlist.Items {
+		products = append(products, item.Product)
+	}
+	if !wc.convertPrices(c, wc.CurrencyService, products...) {
+		return
+	}
+	if wishlist.Stale {
+		wc.respondStale(c)
+	}
+	wc.respond(c, wishlist)
+}
+
+// WishlistSummary godoc
+// @Security     ApiKeyAuth
+// @Summary      Wishlist Summary
+// @Description  Get the wishlist value now and when the items were added, by category, with the cheapest
+// @Description  and most expensive items. Flagged as stale when some products could not be fetched
+// @Tags         wishlist
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, the owner or a collaborator, defaults to the owner"
+// @Success      200  {object}  models.WishlistSummary
+// @Router       /api/v1/customers/{id}/wishlist/summary [get]
+func (wc *WishlistController) Summary(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	actorID, ok := wc.actingCustomer(c)
+	if !ok {
+		return
+	}
+
+	summary, err := wc.WishlistService.GetWishlistSummary(customerID, actorID)
+	if err != nil {
+		wc.respondError(c, err)
+		return
+	}
+	if summary.Stale {
+		wc.respondStale(c)
+	}
+	wc.respond(c, summary)
+}
+
+// CompareWishlistProducts godoc
+// @Security     ApiKeyAuth
+// @Summary      Compare Wishlist Products
+// @Description  Compare 2 to 5 wishlisted products side by side: price, rating, category and description length,
+// @Description  with the best value of each attribute and the differences between the products
+// @Tags         wishlist
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, the owner or a collaborator, defaults to the owner"
+// @Param        product_ids  query  string  true  "Comma separated product ids, like 1,2,3"
+// @Success      200  {object}  models.ProductComparison
+// @Router       /api/v1/customers/{id}/wishlist/compare [get]
+func (wc *WishlistController) Compare(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	actorID, ok := wc.actingCustomer(c)
+	if !ok {
+		return
+	}
+	var form forms.CompareProductsForm
+	if err := c.ShouldBindQuery(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !form.Validate() {
+		c.JSON(http.StatusUnprocessableEntity, form.GetErrors())
+		return
+	}
+
+	comparison, err := wc.WishlistService.CompareProducts(customerID, actorID, form.GetProductIDs())
+	if err != nil {
+		wc.respondError(c, err)
+		return
+	}
+	if comparison.Stale {
+		wc.respondStale(c)
+	}
+	wc.respond(c, comparison)
+}
+
+// ReorderWishlist godoc
+// @Security     ApiKeyAuth
+// @Summary      Reorder Wishlist
+// @Description  Set the order of the whole wishlist, the product ids must list every item exactly once
+// @Tags         wishlist
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, the owner or a collaborator, defaults to the owner"
+// @Param        order  body  forms.WishlistOrderForm  true  "WishlistOrderForm form"
+// @Success      200  {object}  models.Wishlist
+// @Router       /api/v1/customers/{id}/wishlist/order [put]
+func (wc *WishlistController) Reorder(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	actorID, ok := wc.actingCustomer(c)
+	if !ok {
+		return
+	}
+	var form forms.WishlistOrderForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	wishlist, err := wc.WishlistService.ReorderItems(customerID, form.ProductIDs, actorID)
+	if err != nil {
+		wc.respondError(c, err)
+		return
+	}
+	wc.respond(c, wishlist)
+}
+
+// MoveWishlistItem godoc
+// @Security     ApiKeyAuth
+// @Summary      Move Wishlist Item
+// @Description  Move an item to a position of the wishlist, 0 is the top and positions past the end move it to the bottom
+// @Tags         wishlist
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        product_id path string true "Product ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, the owner or a collaborator, defaults to the owner"
+// @Param        move  body  forms.MoveWishlistItemForm  true  "MoveWishlistItemForm form"
+// @Success      200  {object}  models.Wishlist
+// @Router       /api/v1/customers/{id}/wishlist/{product_id}/position [put]
+func (wc *WishlistController) MoveItem(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	actorID, ok := wc.actingCustomer(c)
+	if !ok {
+		return
+	}
+	productID, err := strconv.ParseInt(c.Param("product_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+	var form forms.MoveWishlistItemForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	wishlist, err := wc.WishlistService.MoveItem(customerID, int32(productID), *form.Position, actorID)
+	if err != nil {
+		wc.respondError(c, err)
+		return
+	}
+	wc.respond(c, wishlist)
+}
@@ -0,0 +1,200 @@
+package controllers
+
+import (
+	"net/http"
+
+	"produtos-favoritos/src/api/forms"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/internals/exceptions"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WishlistCollaborationController struct {
+	BaseController
+	CollaborationService servicers.WishlistCollaborationServicer
+}
+
+func NewWishlistCollaborationController(collaborationService servicers.WishlistCollaborationServicer) handlers.WishlistCollaborationHandler {
+	return &WishlistCollaborationController{CollaborationService: collaborationService}
+}
+
+// InviteCollaborator godoc
+// @Security     ApiKeyAuth
+// @Summary      Invite To Wishlist
+// @Description  Share the customer wishlist with another customer, found by id or email, as a viewer or an editor.
+// @Description  The invited customer has to accept the invitation, inviting again changes the role
+// @Tags         wishlist-sharing
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, defaults to the customer in the path"
+// @Param        invite  body  forms.InviteCollaboratorForm  true  "InviteCollaboratorForm form"
+// @Success      200  {object}  models.WishlistCollaborator
+// @Router       /api/v1/customers/{id}/wishlist/collaborators [post]
+func (wc *WishlistCollaborationController) Invite(c *gin.Context) {
+	ownerID := c.Param("id")
+	if _, err := uuid.Parse(ownerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	actorID, ok := wc.actingCustomer(c)
+	if !ok {
+		return
+	}
+	var form forms.InviteCollaboratorForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !form.Validate() {
+		c.JSON(http.StatusUnprocessableEntity, form.GetErrors())
+		return
+	}
+
+	collaborator, err := wc.CollaborationService.Invite(ownerID, actorID, form.ToInvite())
+	if err != nil {
+		wc.respondError(c, err)
+		return
+	}
+	wc.respond(c, collaborator)
+}
+
+// ListCollaborators godoc
+// @Security     ApiKeyAuth
+// @Summary      List Wishlist Collaborators
+// @Description  Get the customers the wishlist is shared with and the state of their invitations
+// @Tags         wishlist-sharing
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Success      200  {array}  models.WishlistCollaborator
+// @Router       /api/v1/customers/{id}/wishlist/collaborators [get]
+func (wc *WishlistCollaborationController) ListCollaborators(c *gin.Context) {
+	ownerID := c.Param("id")
+	if _, err := uuid.Parse(ownerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+
+	collaborators, err := wc.CollaborationService.ListCollaborators(ownerID)
+	if err != nil {
+		wc.respondError(c, err)
+		return
+	}
+	wc.respond(c, collaborators)
+}
+
+// RemoveCollaborator godoc
+// @Security     ApiKeyAuth
+// @Summary      Remove Wishlist Collaborator
+// @Description  Stop sharing the wishlist with a customer
+// @Tags         wishlist-sharing
+// @Param        id path string true "Customer ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, defaults to the customer in the path"
+// @Param        collaborator_id path string true "Collaborator customer ID"
+// @Success      204
+// @Router       /api/v1/customers/{id}/wishlist/collaborators/{collaborator_id} [delete]
+func (wc *WishlistCollaborationController) RemoveCollaborator(c *gin.Context) {
+	ownerID, collaboratorID, ok := wc.customerPair(c, "collaborator_id")
+	if !ok {
+		return
+	}
+	actorID, ok := wc.actingCustomer(c)
+	if !ok {
+		return
+	}
+
+	if err := wc.CollaborationService.RemoveCollaborator(ownerID, actorID, collaboratorID); err != nil {
+		wc.respondError(c, err)
+		return
+	}
+	wc.respondSuccessNoContent(c)
+}
+
+// ListInvitations godoc
+// @Security     ApiKeyAuth
+// @Summary      List Wishlist Invitations
+// @Description  Get the wishlists other customers shared with the customer
+// @Tags         wishlist-sharing
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Success      200  {array}  models.WishlistCollaborator
+// @Router       /api/v1/customers/{id}/wishlist-invitations [get]
+func (wc *WishlistCollaborationController) ListInvitations(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+
+	invitations, err := wc.CollaborationService.ListInvitations(customerID)
+	if err != nil {
+		wc.respondError(c, err)
+		return
+	}
+	wc.respond(c, invitations)
+}
+
+// AcceptInvitation godoc
+// @Security     ApiKeyAuth
+// @Summary      Accept Wishlist Invitation
+// @Description  Accept a pending invitation to the wishlist of another customer
+// @Tags         wishlist-sharing
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, defaults to the customer in the path"
+// @Param        owner_id path string true "Wishlist owner customer ID"
+// @Success      200  {object}  models.WishlistCollaborator
+// @Router       /api/v1/customers/{id}/wishlist-invitations/{owner_id}/accept [post]
+func (wc *WishlistCollaborationController) AcceptInvitation(c *gin.Context) {
+	wc.respondInvitation(c, true)
+}
+
+// DeclineInvitation godoc
+// @Security     ApiKeyAuth
+// @Summary      Decline Wishlist Invitation
+// @Description  Decline a pending invitation to the wishlist of another customer
+// @Tags         wishlist-sharing
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, defaults to the customer in the path"
+// @Param        owner_id path string true "Wishlist owner customer ID"
+// @Success      200  {object}  models.WishlistCollaborator
+// @Router       /api/v1/customers/{id}/wishlist-invitations/{owner_id}/decline [post]
+func (wc *WishlistCollaborationController) DeclineInvitation(c *gin.Context) {
+	wc.respondInvitation(c, false)
+}
+
+func (wc *WishlistCollaborationController) respondInvitation(c *gin.Context, accept bool) {
+	customerID, ownerID, ok := wc.customerPair(c, "owner_id")
+	if !ok {
+		return
+	}
+	actorID, ok := wc.actingCustomer(c)
+	if !ok {
+		return
+	}
+
+	collaborator, err := wc.CollaborationService.RespondInvitation(customerID, actorID, ownerID, accept)
+	if err != nil {
+		wc.respondError(c, err)
+		return
+	}
+	wc.respond(c, collaborator)
+}
+
+// customerPair validates the customer id and the other customer id in the path
+func (wc *WishlistCollaborationController) customerPair(c *gin.Context, param string) (string, string, bool) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		wc.respondError(c, &exceptions.BadRequestError{Reason: "invalid customer ID"})
+		return "", "", false
+	}
+	otherID := c.Param(param)
+	if _, err := uuid.Parse(otherID); err != nil {
+		wc.respondError(c, &exceptions.BadRequestError{Reason: "invalid " + param})
+		return "", "", false
+	}
+	return customerID, otherID, true
+}
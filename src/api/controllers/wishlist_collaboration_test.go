@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/forms"
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+const (
+	sharingOwnerID    = "00000000-0000-0000-0000-000000000000"
+	sharingCustomerID = "11111111-1111-1111-1111-111111111111"
+)
+
+func setupCollaborationTestRouter() (*gin.Engine, *mocks.WishlistCollaborationServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	collaborationService := new(mocks.WishlistCollaborationServicer)
+
+	routeHandlers := mockHandlers()
+	routeHandlers.Collaboration = NewWishlistCollaborationController(collaborationService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, collaborationService
+}
+
+func serveCollaborationRequest(r *gin.Engine, method string, path string, body interface{}) *httptest.ResponseRecorder {
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req, _ := http.NewRequest(method, "/api/v1/customers/"+path, bytes.NewBuffer(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	return resp
+}
+
+func TestCollaborationController_Invite_Success(t *testing.T) {
+	r, mockService := setupCollaborationTestRouter()
+
+	invite := models.WishlistInvite{Email: "partner@test.com", Role: models.CollaboratorEditor}
+	mockService.On("Invite", sharingOwnerID, sharingOwnerID, invite).
+		Return(&models.WishlistCollaborator{Role: models.CollaboratorEditor, Status: models.InvitationPending}, nil)
+
+	resp := serveCollaborationRequest(r, http.MethodPost, sharingOwnerID+"/wishlist/collaborators",
+		forms.InviteCollaboratorForm{Email: "partner@test.com", Role: models.CollaboratorEditor})
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCollaborationController_Invite_IDAndEmail(t *testing.T) {
+	r, mockService := setupCollaborationTestRouter()
+
+	resp := serveCollaborationRequest(r, http.MethodPost, sharingOwnerID+"/wishlist/collaborators",
+		forms.InviteCollaboratorForm{CustomerID: sharingCustomerID, Email: "partner@test.com", Role: models.CollaboratorViewer})
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	mockService.AssertNotCalled(t, "Invite", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCollaborationController_Invite_InvalidRole(t *testing.T) {
+	r, mockService := setupCollaborationTestRouter()
+
+	resp := serveCollaborationRequest(r, http.MethodPost, sharingOwnerID+"/wishlist/collaborators",
+		forms.InviteCollaboratorForm{CustomerID: sharingCustomerID, Role: "owner"})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "Invite", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCollaborationController_RemoveCollaborator(t *testing.T) {
+	r, mockService := setupCollaborationTestRouter()
+
+	mockService.On("RemoveCollaborator", sharingOwnerID, sharingOwnerID, sharingCustomerID).Return(nil)
+
+	resp := serveCollaborationRequest(r, http.MethodDelete, sharingOwnerID+"/wishlist/collaborators/"+sharingCustomerID, nil)
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCollaborationController_ListInvitations(t *testing.T) {
+	r, mockService := setupCollaborationTestRouter()
+
+	mockService.On("ListInvitations", sharingCustomerID).
+		Return([]models.WishlistCollaborator{{Status: models.InvitationPending}}, nil)
+
+	resp := serveCollaborationRequest(r, http.MethodGet, sharingCustomerID+"/wishlist-invitations", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCollaborationController_AcceptInvitation(t *testing.T) {
+	r, mockService := setupCollaborationTestRouter()
+
+	mockService.On("RespondInvitation", sharingCustomerID, sharingCustomerID, sharingOwnerID, true).
+		Return(&models.WishlistCollaborator{Status: models.InvitationAccepted}, nil)
+
+	resp := serveCollaborationRequest(r, http.MethodPost,
+		sharingCustomerID+"/wishlist-invitations/"+sharingOwnerID+"/accept", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCollaborationController_DeclineInvitation_NotFound(t *testing.T) {
+	r, mockService := setupCollaborationTestRouter()
+
+	mockService.On("RespondInvitation", sharingCustomerID, sharingCustomerID, sharingOwnerID, false).
+		Return(nil, &exceptions.NotFoundEntityError{Reason: "invitation not found"})
+
+	resp := serveCollaborationRequest(r, http.MethodPost,
+		sharingCustomerID+"/wishlist-invitations/"+sharingOwnerID+"/decline", nil)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"net/http"
+
+	"produtos-favoritos/src/api/forms"
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WishlistRuleController struct {
+	BaseController
+	PolicyService servicers.PolicyServicer
+}
+
+func NewWishlistRuleController(policyService servicers.PolicyServicer) handlers.WishlistRuleHandler {
+	return &WishlistRuleController{PolicyService: policyService}
+}
+
+// ListWishlistRules godoc
+// @Security     ApiKeyAuth
+// @Security     AdminKeyAuth
+// @Summary      List wishlist rules
+// @Description  Rules checked before adding or removing wishlist items, from the rules file and the database
+// @Tags         wishlist-rules
+// @Produce      json
+// @Success      200  {array}  models.WishlistRule
+// @Router       /api/v1/admin/wishlist-rules [get]
+func (rc *WishlistRuleController) List(c *gin.Context) {
+	rules, err := rc.PolicyService.ListRules()
+	if err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respond(c, rules)
+}
+
+// SaveWishlistRule godoc
+// @Security     ApiKeyAuth
+// @Security     AdminKeyAuth
+// @Summary      Save wishlist rule
+// @Description  Create or replace a rule. The expression is a CEL condition that must hold, over the variables
+// @Description  action, actor.is_owner, request.partner_key, customer.id, customer.name, customer.email,
+// @Description  customer.wishlist_count, product.id, product.title, product.category, product.price,
+// @Description  product.rating and product.discontinued. For example: customer.wishlist_count < 100 || action == "remove"
+// @Tags         wishlist-rules
+// @Accept       json
+// @Produce      json
+// @Param        name  path  string  true  "Rule name"
+// @Param        rule  body  forms.WishlistRuleForm  true  "WishlistRuleForm form"
+// @Success      200  {object}  models.WishlistRule
+// @Router       /api/v1/admin/wishlist-rules/{name} [put]
+func (rc *WishlistRuleController) Save(c *gin.Context) {
+	var form forms.WishlistRuleForm
+	if err := c.ShouldBindJSON(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rule, err := rc.PolicyService.SaveRule(form.ToModel(c.Param("name")))
+	if err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respond(c, rule)
+}
+
+// DeleteWishlistRule godoc
+// @Security     ApiKeyAuth
+// @Security     AdminKeyAuth
+// @Summary      Delete wishlist rule
+// @Description  Rules of the rules file can't be deleted
+// @Tags         wishlist-rules
+// @Param        name  path  string  true  "Rule name"
+// @Success      204
+// @Router       /api/v1/admin/wishlist-rules/{name} [delete]
+func (rc *WishlistRuleController) Delete(c *gin.Context) {
+	if err := rc.PolicyService.DeleteRule(c.Param("name")); err != nil {
+		rc.respondError(c, err)
+		return
+	}
+	rc.respondSuccessNoContent(c)
+}
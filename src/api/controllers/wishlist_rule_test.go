@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func setupWishlistRuleTestRouter(t *testing.T) (*gin.Engine, *mocks.PolicyServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	config.ADMIN_API_KEY = testAdminKey
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	policyService := new(mocks.PolicyServicer)
+
+	routeHandlers := mockHandlers()
+	routeHandlers.WishlistRule = NewWishlistRuleController(policyService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, policyService
+}
+
+func TestWishlistRuleController_Save_Success(t *testing.T) {
+	r, mockService := setupWishlistRuleTestRouter(t)
+
+	mockService.On("SaveRule", &models.WishlistRule{
+		Name:       "max-items",
+		Actions:    []string{"add"},
+		Expression: "customer.wishlist_count < 100",
+		Enabled:    true,
+	}).Return(&models.WishlistRule{Name: "max-items"}, nil)
+
+	body, _ := json.Marshal(map[string]any{"actions": []string{"add"}, "expression": "customer.wishlist_count < 100"})
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodPut, "/api/v1/admin/wishlist-rules/max-items", bytes.NewBuffer(body)))
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistRuleController_Save_UnknownAction(t *testing.T) {
+	r, mockService := setupWishlistRuleTestRouter(t)
+
+	body, _ := json.Marshal(map[string]any{"actions": []string{"rename"}, "expression": "true"})
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodPut, "/api/v1/admin/wishlist-rules/max-items", bytes.NewBuffer(body)))
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "SaveRule", mock.Anything)
+}
+
+func TestWishlistRuleController_Save_InvalidExpression(t *testing.T) {
+	r, mockService := setupWishlistRuleTestRouter(t)
+
+	mockService.On("SaveRule", mock.Anything).
+		Return(nil, &exceptions.InvalidEntityError{Reason: `invalid expression: unknown variable "x"`})
+
+	body, _ := json.Marshal(map[string]any{"expression": "x > 1"})
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodPut, "/api/v1/admin/wishlist-rules/bad", bytes.NewBuffer(body)))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+}
+
+func TestWishlistRuleController_Delete_NotFound(t *testing.T) {
+	r, mockService := setupWishlistRuleTestRouter(t)
+
+	mockService.On("DeleteRule", "missing").Return(&exceptions.NotFoundEntityError{Reason: "rule not found"})
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, adminRequest(http.MethodDelete, "/api/v1/admin/wishlist-rules/missing", bytes.NewBuffer(nil)))
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
@@ -5,23 +5,32 @@ import (
 	"encoding/json"
 	"errors"
 	"log"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"produtos-favoritos/src/api/forms"
 	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
 	"produtos-favoritos/src/infrastructure/config"
 	"produtos-favoritos/src/internals/exceptions"
 	"produtos-favoritos/src/internals/mocks"
 )
 
 func setupWishlistTestRouter(t *testing.T) (*gin.Engine, *mocks.WishlistServicer) {
+	r, wishlistService, _ := setupWishlistCurrencyTestRouter(t)
+	return r, wishlistService
+}
+
+func setupWishlistCurrencyTestRouter(t *testing.T) (*gin.Engine, *mocks.WishlistServicer, *mocks.CurrencyServicer) {
 	err := godotenv.Load("../../../.env")
 	if err != nil {
 		log.Fatal("Error loading .env file")
@@ -31,14 +40,14 @@ func setupWishlistTestRouter(t *testing.T) (*gin.Engine, *mocks.WishlistServicer
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 	wishlistService := new(mocks.WishlistServicer)
-	wishlistController := NewWishlistController(wishlistService)
+	currencyService := mocks.NewCurrencyServicer(t)
+	wishlistController := NewWishlistController(wishlistService, currencyService)
 
-	customerHandler := new(mocks.CustomerHandler)
-	productHandler := new(mocks.ProductHandler)
-	// Passing nil for productController and wishlistController for now, can add mocks if needed
-	router.SetupRouter(r, customerHandler, productHandler, wishlistController)
+	routeHandlers := mockHandlers()
+	routeHandlers.Wishlist = wishlistController
+	router.SetupRouter(r, routeHandlers)
 
-	return r, wishlistService
+	return r, wishlistService, currencyService
 }
 
 // ----------------------
@@ -51,12 +60,14 @@ func TestWishlistController_WishlistProduct_Success(t *testing.T) {
 	form := forms.WishlistForm{ProductID: 123}
 	body, _ := json.Marshal(form)
 
-	mockService.On("WishlistProduct", int32(123), "00000000-0000-0000-0000-000000000000").Return(nil)
+	mockService.On("WishlistProduct", int32(123), "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000", "").
+		Return(&models.WishlistItem{ProductID: 123, Status: models.WishlistItemConfirmed}, nil)
 
 	req, _ := http.NewRequest(http.MethodPost, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist",
 		bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
 	resp := httptest.NewRecorder()
 
 	r.ServeHTTP(resp, req)
@@ -71,13 +82,14 @@ func TestWishlistController_WishlistProduct_AlreadyWishlisted(t *testing.T) {
 	form := forms.WishlistForm{ProductID: 123}
 	body, _ := json.Marshal(form)
 
-	mockService.On("WishlistProduct", int32(123), "00000000-0000-0000-0000-000000000000").
-		Return(&exceptions.AlreadyWishlistedErr{Reason: "Already in wishlist"})
+	mockService.On("WishlistProduct", int32(123), "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000", "").
+		Return(nil, &exceptions.AlreadyWishlistedErr{Reason: "Already in wishlist"})
 
 	req, _ := http.NewRequest(http.MethodPost, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist",
 		bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
 	resp := httptest.NewRecorder()
 
 	r.ServeHTTP(resp, req)
@@ -92,13 +104,14 @@ func TestWishlistController_WishlistProduct_NotFound(t *testing.T) {
 	form := forms.WishlistForm{ProductID: 123}
 	body, _ := json.Marshal(form)
 
-	mockService.On("WishlistProduct", int32(123), "00000000-0000-0000-0000-000000000000").
-		Return(&exceptions.NotFoundEntityError{Reason: "Not found"})
+	mockService.On("WishlistProduct", int32(123), "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000", "").
+		Return(nil, &exceptions.NotFoundEntityError{Reason: "Not found"})
 
 	req, _ := http.NewRequest(http.MethodPost, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist",
 		bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
 	resp := httptest.NewRecorder()
 
 	r.ServeHTTP(resp, req)
@@ -110,19 +123,42 @@ func TestWishlistController_WishlistProduct_NotFound(t *testing.T) {
 func TestWishlistController_WishlistProduct_BadRequest(t *testing.T) {
 	r, mockService := setupWishlistTestRouter(t)
 
-	mockService.On("WishlistProduct", int32(0), "00000000-0000-0000-0000-000000000000").Return(errors.New("Failed"))
-
 	// Invalid JSON
 	req, _ := http.NewRequest(http.MethodPost, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist",
 		bytes.NewBuffer([]byte(`invalid`)))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
 
 	resp := httptest.NewRecorder()
 
 	r.ServeHTTP(resp, req)
 
 	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "WishlistProduct")
+}
+
+func TestWishlistController_WishlistProduct_Pending(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	form := forms.WishlistForm{ProductID: 123}
+	body, _ := json.Marshal(form)
+
+	mockService.On("WishlistProduct", int32(123), "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000", "").
+		Return(&models.WishlistItem{ProductID: 123, Status: models.WishlistItemPending}, nil)
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist",
+		bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusAccepted, resp.Code)
+	assert.NotEmpty(t, resp.Header().Get("Warning"))
+	mockService.AssertExpectations(t)
 }
 
 func TestWishlistController_WishlistProduct_InternalServerError(t *testing.T) {
@@ -131,13 +167,14 @@ func TestWishlistController_WishlistProduct_InternalServerError(t *testing.T) {
 	form := forms.WishlistForm{ProductID: 123}
 	body, _ := json.Marshal(form)
 
-	mockService.On("WishlistProduct", int32(123), "00000000-0000-0000-0000-000000000000").
-		Return(errors.New("something went wrong"))
+	mockService.On("WishlistProduct", int32(123), "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000", "").
+		Return(nil, errors.New("something went wrong"))
 
 	req, _ := http.NewRequest(http.MethodPost, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist",
 		bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
 	resp := httptest.NewRecorder()
 
 	r.ServeHTTP(resp, req)
@@ -153,10 +190,11 @@ func TestWishlistController_WishlistProduct_InternalServerError(t *testing.T) {
 func TestWishlistController_RemoveFromWishlist_Success(t *testing.T) {
 	r, mockService := setupWishlistTestRouter(t)
 
-	mockService.On("RemoveProductFromWishlist", "00000000-0000-0000-0000-000000000000", int32(123)).Return(nil)
+	mockService.On("RemoveProductFromWishlist", "00000000-0000-0000-0000-000000000000", int32(123), "00000000-0000-0000-0000-000000000000", "").Return(nil)
 
 	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/123", nil)
 	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
 	resp := httptest.NewRecorder()
 
 	r.ServeHTTP(resp, req)
@@ -169,10 +207,11 @@ func TestWishlistController_RemoveFromWishlist_NotFound(t *testing.T) {
 	r, mockService := setupWishlistTestRouter(t)
 
 	mockService.On("RemoveProductFromWishlist", "00000000-0000-0000-0000-000000000000",
-		int32(123)).Return(&exceptions.NotFoundEntityError{Reason: "not found"})
+		int32(123), "00000000-0000-0000-0000-000000000000", "").Return(&exceptions.NotFoundEntityError{Reason: "not found"})
 
 	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/123", nil)
 	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
 	resp := httptest.NewRecorder()
 
 	r.ServeHTTP(resp, req)
@@ -186,6 +225,7 @@ func TestWishlistController_RemoveFromWishlist_InvalidID(t *testing.T) {
 
 	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/customers/100000000-0000-0000-0000-000000000000/wishlist/abc", nil)
 	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
 	resp := httptest.NewRecorder()
 
 	r.ServeHTTP(resp, req)
@@ -196,11 +236,12 @@ func TestWishlistController_RemoveFromWishlist_InvalidID(t *testing.T) {
 func TestWishlistController_RemoveFromWishlist_InternalServerError(t *testing.T) {
 	r, mockService := setupWishlistTestRouter(t)
 
-	mockService.On("RemoveProductFromWishlist", "00000000-0000-0000-0000-000000000000", int32(123)).
+	mockService.On("RemoveProductFromWishlist", "00000000-0000-0000-0000-000000000000", int32(123), "00000000-0000-0000-0000-000000000000", "").
 		Return(errors.New("db down"))
 
 	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/123", nil)
 	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
 	resp := httptest.NewRecorder()
 
 	r.ServeHTTP(resp, req)
@@ -208,3 +249,475 @@ func TestWishlistController_RemoveFromWishlist_InternalServerError(t *testing.T)
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 	mockService.AssertExpectations(t)
 }
+
+func TestWishlistController_RemoveFromWishlist_Forbidden(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	mockService.On("RemoveProductFromWishlist", "00000000-0000-0000-0000-000000000000", int32(123),
+		"11111111-1111-1111-1111-111111111111", "").
+		Return(&exceptions.ForbiddenError{Reason: "only editors can change this wishlist"})
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/123", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "11111111-1111-1111-1111-111111111111")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistController_WishlistProduct_InvalidActingCustomer(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	body, _ := json.Marshal(forms.WishlistForm{ProductID: 123})
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist",
+		bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "someone")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "WishlistProduct")
+}
+
+func TestWishlistController_RemoveFromWishlist_ActingCustomerDefaultsToOwner(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	mockService.On("RemoveProductFromWishlist", "00000000-0000-0000-0000-000000000000", int32(123),
+		"00000000-0000-0000-0000-000000000000", "").Return(nil)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/123", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistController_RemoveFromWishlist_InvalidActingCustomer(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/123", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "not-a-customer")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "RemoveProductFromWishlist")
+}
+
+// ----------------------
+// List / Summary Tests
+// ----------------------
+
+func TestWishlistController_List_Success(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	wishlist := &models.Wishlist{Items: []models.WishlistItem{{
+		ProductID: 1,
+		Status:    models.WishlistItemConfirmed,
+		Product:   &models.Product{ID: 1, Title: "Backpack", Rating: models.Rating{Rate: 3.9, Count: 120}},
+	}}}
+	mockService.On("GetWishlist", "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000",
+		models.WishlistFilter{}).Return(wishlist, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var body models.Wishlist
+	err := json.Unmarshal(resp.Body.Bytes(), &body)
+	assert.NoError(t, err)
+	assert.Len(t, body.Items, 1)
+	assert.False(t, body.Stale)
+	assert.Equal(t, float32(3.9), body.Items[0].Product.Rating.Rate)
+	assert.Empty(t, resp.Header().Get("Warning"))
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistController_List_WithCurrency(t *testing.T) {
+	r, mockService, mockCurrency := setupWishlistCurrencyTestRouter(t)
+
+	product := &models.Product{ID: 1, Price: models.NewMoney(1000, "USD")}
+	wishlist := &models.Wishlist{Items: []models.WishlistItem{{ProductID: 1, Product: product}}}
+	mockService.On("GetWishlist", "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000",
+		models.WishlistFilter{}).Return(wishlist, nil)
+	mockCurrency.On("ConvertProducts", "BRL", product).Return(nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist?currency=BRL", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistController_List_Stale(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	wishlist := &models.Wishlist{Stale: true, Items: []models.WishlistItem{{ProductID: 1}}}
+	mockService.On("GetWishlist", "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000",
+		models.WishlistFilter{}).Return(wishlist, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `110 - "Response is Stale"`, resp.Header().Get("Warning"))
+
+	var body models.Wishlist
+	err := json.Unmarshal(resp.Body.Bytes(), &body)
+	assert.NoError(t, err)
+	assert.True(t, body.Stale)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistController_List_NotFound(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	mockService.On("GetWishlist", "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000",
+		models.WishlistFilter{}).
+		Return(nil, &exceptions.NotFoundEntityError{Reason: "customer not found"})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistController_Summary_Success(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	summary := &models.WishlistSummary{ItemCount: 2, RatedCount: 2, AverageRating: 4.25}
+	mockService.On("GetWishlistSummary", "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000").Return(summary, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/summary", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var body models.WishlistSummary
+	err := json.Unmarshal(resp.Body.Bytes(), &body)
+	assert.NoError(t, err)
+	assert.Equal(t, float32(4.25), body.AverageRating)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistController_Summary_Stale(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	summary := &models.WishlistSummary{
+		ItemCount:    1,
+		CurrentTotal: models.NewMoney(10995, "USD"),
+		AddedTotal:   models.NewMoney(12000, "USD"),
+		Difference:   models.NewMoney(-1005, "USD"),
+		Stale:        true,
+	}
+	mockService.On("GetWishlistSummary", "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000").Return(summary, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/summary", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `110 - "Response is Stale"`, resp.Header().Get("Warning"))
+	assert.Contains(t, resp.Body.String(), `"difference":-10.05`)
+}
+
+func TestWishlistController_Summary_InvalidID(t *testing.T) {
+	r, _ := setupWishlistTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/customers/not-a-uuid/wishlist/summary", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestWishlistController_Compare_Success(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	comparison := &models.ProductComparison{
+		Products: []models.ComparedProduct{{ProductID: 1}, {ProductID: 3}},
+		Attributes: []models.ComparisonAttribute{
+			{Name: models.ComparisonPrice, Values: []any{models.NewMoney(1000, "USD"), models.NewMoney(999, "USD")}, Best: []int32{3}},
+		},
+	}
+	mockService.On("CompareProducts", "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000", []int32{1, 3}).Return(comparison, nil)
+
+	req, _ := http.NewRequest(http.MethodGet,
+		"/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/compare?product_ids=1,%203", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"values":[10.00,9.99]`)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistController_Compare_InvalidSelection(t *testing.T) {
+	r, _ := setupWishlistTestRouter(t)
+
+	for _, query := range []string{"product_ids=1", "product_ids=1,2,3,4,5,6", "product_ids=1,1", "product_ids=1,abc"} {
+		req, _ := http.NewRequest(http.MethodGet,
+			"/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/compare?"+query, nil)
+		req.Header.Set("X-Api-Key", config.API_KEY)
+		req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+		resp := httptest.NewRecorder()
+
+		r.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, query)
+	}
+}
+
+func TestWishlistController_Compare_MissingProducts(t *testing.T) {
+	r, _ := setupWishlistTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/compare", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+// ----------------------
+// Order Tests
+// ----------------------
+
+func TestWishlistController_Reorder_Success(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	mockService.On("ReorderItems", "00000000-0000-0000-0000-000000000000", []int32{3, 1, 2},
+		"00000000-0000-0000-0000-000000000000").Return(&models.Wishlist{}, nil)
+
+	body, _ := json.Marshal(forms.WishlistOrderForm{ProductIDs: []int32{3, 1, 2}})
+	req, _ := http.NewRequest(http.MethodPut, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/order",
+		bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistController_Reorder_Incomplete(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	mockService.On("ReorderItems", "00000000-0000-0000-0000-000000000000", []int32{1},
+		"00000000-0000-0000-0000-000000000000").
+		Return(nil, &exceptions.InvalidEntityError{Reason: "the order must list every wishlist item exactly once"})
+
+	body, _ := json.Marshal(forms.WishlistOrderForm{ProductIDs: []int32{1}})
+	req, _ := http.NewRequest(http.MethodPut, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/order",
+		bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistController_MoveItem_Success(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	mockService.On("MoveItem", "00000000-0000-0000-0000-000000000000", int32(3), 0,
+		"00000000-0000-0000-0000-000000000000").Return(&models.Wishlist{}, nil)
+
+	req, _ := http.NewRequest(http.MethodPut, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/3/position",
+		bytes.NewBufferString(`{"position": 0}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistController_MoveItem_MissingPosition(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodPut, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/3/position",
+		bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "MoveItem")
+}
+
+func TestWishlistController_List_FilterByTags(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	filter := models.WishlistFilter{Tags: []string{"gift", "kitchen"}, MatchAll: true}
+	mockService.On("GetWishlist", "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000", filter).
+		Return(&models.Wishlist{Items: []models.WishlistItem{}}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist?tags=Gift,%20kitchen,gift%20&match=all", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistController_WishlistProduct_PolicyViolation(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	body, _ := json.Marshal(forms.WishlistForm{ProductID: 123})
+	mockService.On("WishlistProduct", int32(123), "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000", "acme").
+		Return(nil, &exceptions.PolicyViolationError{
+			Reason:     "wishlist rules violated: no-electronics",
+			Violations: []exceptions.PolicyViolation{{Rule: "no-electronics", Description: "Partners can't wishlist electronics"}},
+		})
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist",
+		bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	req.Header.Set("X-Partner-Key", "acme")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	var response struct {
+		Error      string                       `json:"error"`
+		Violations []exceptions.PolicyViolation `json:"violations"`
+	}
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &response))
+	assert.Equal(t, "no-electronics", response.Violations[0].Rule)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistController_Export_CSV(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	customerID := "00000000-0000-0000-0000-000000000000"
+	mockService.On("ExportWishlist", customerID, customerID).Return([]models.WishlistExportItem{{
+		ProductID:  1,
+		Title:      "Backpack, blue",
+		Category:   "bags",
+		Price:      models.NewMoney(10995, "USD"),
+		AddedPrice: models.NewMoney(9995, "USD"),
+		AddedAt:    time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+		Tags:       []string{"gift", "school"},
+	}}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/customers/"+customerID+"/wishlist/export", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", customerID)
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Header().Get("Content-Disposition"), "wishlist-"+customerID+".csv")
+	assert.Equal(t, "product_id,title,category,price,added_price,added_at,tags\n"+
+		`1,"Backpack, blue",bags,109.95,99.95,2026-10-01T12:00:00Z,gift|school`+"\n", resp.Body.String())
+}
+
+func TestWishlistController_Export_UnknownFormat(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/customers/00000000-0000-0000-0000-000000000000/wishlist/export?format=xml", nil)
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", "00000000-0000-0000-0000-000000000000")
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "ExportWishlist", mock.Anything, mock.Anything)
+}
+
+func TestWishlistController_Import_DryRunFromJSONFile(t *testing.T) {
+	r, mockService := setupWishlistTestRouter(t)
+
+	customerID := "00000000-0000-0000-0000-000000000000"
+	mockService.On("ImportWishlist", customerID, customerID, models.WishlistFormatJSON, mock.Anything, true, "").
+		Return(&models.WishlistImportReport{DryRun: true, Added: 1,
+			Lines: []models.WishlistImportLine{{Line: 1, ProductID: 2, Status: models.ImportLineAdded}}}, nil)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "favorites.json")
+	_, _ = part.Write([]byte(`[{"product_id": 2}]`))
+	_ = writer.WriteField("dry_run", "true")
+	_ = writer.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/customers/"+customerID+"/wishlist/import", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("X-Api-Key", config.API_KEY)
+	req.Header.Set("X-Customer-ID", customerID)
+	resp := httptest.NewRecorder()
+
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var report models.WishlistImportReport
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &report))
+	assert.True(t, report.DryRun)
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"produtos-favoritos/src/api/forms"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ExportWishlist godoc
+// @Security     ApiKeyAuth
+// @Summary      Export Wishlist
+// @Description  Download the wishlist as csv (default) or json. The csv has the columns
+// @Description  product_id, title, category, price, added_price, added_at and tags (separated by "|")
+// @Tags         wishlist
+// @Produce      text/csv
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, the owner or a collaborator, defaults to the owner"
+// @Param        format  query  string  false  "File format"  Enums(csv, json)
+// @Success      200  {array}  models.WishlistExportItem
+// @Router       /api/v1/customers/{id}/wishlist/export [get]
+func (wc *WishlistController) Export(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	actorID, ok := wc.actingCustomer(c)
+	if !ok {
+		return
+	}
+	var form forms.WishlistExportForm
+	if err := c.ShouldBindQuery(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	items, err := wc.WishlistService.ExportWishlist(customerID, actorID)
+	if err != nil {
+		wc.respondError(c, err)
+		return
+	}
+
+	format := form.GetFormat()
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="wishlist-%s.%s"`, customerID, format))
+	if format == models.WishlistFormatJSON {
+		c.JSON(http.StatusOK, items)
+		return
+	}
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Status(http.StatusOK)
+	writer := csv.NewWriter(c.Writer)
+	_ = writer.Write([]string{"product_id", "title", "category", "price", "added_price", "added_at", "tags"})
+	for _, item := range items {
+		_ = writer.Write([]string{
+			strconv.Itoa(int(item.ProductID)),
+			item.Title,
+			item.Category,
+			item.Price.String(),
+			item.AddedPrice.String(),
+			item.AddedAt.UTC().Format(time.RFC3339),
+			strings.Join(item.Tags, "|"),
+		})
+	}
+	writer.Flush()
+}
+
+// ImportWishlist godoc
+// @Security     ApiKeyAuth
+// @Summary      Import Wishlist
+// @Description  Add the products of a csv or json file, in the export format. Only product_id is required, tags are optional.
+// @Description  Each product is checked against the catalog and the wishlist rules, products already in the wishlist
+// @Description  or repeated are skipped and the report tells the outcome of every line. Nothing is saved on a dry run
+// @Tags         wishlist
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, the owner or a collaborator, defaults to the owner"
+// @Param        X-Partner-Key  header  string  false  "Partner integration doing the request, checked by the wishlist rules"
+// @Param        file     formData  file    true   "CSV or JSON file"
+// @Param        format   formData  string  false  "File format, defaults to the file extension"  Enums(csv, json)
+// @Param        dry_run  formData  bool    false  "Only validate the file"
+// @Success      200  {object}  models.WishlistImportReport
+// @Router       /api/v1/customers/{id}/wishlist/import [post]
+func (wc *WishlistController) Import(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	actorID, ok := wc.actingCustomer(c)
+	if !ok {
+		return
+	}
+	var form forms.WishlistImportForm
+	if err := c.ShouldBind(&form); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	header, err := c.FormFile("file")
+	if err != nil {
+		wc.respondError(c, &exceptions.BadRequestError{Reason: "missing file"})
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		wc.respondError(c, err)
+		return
+	}
+	defer file.Close()
+
+	report, err := wc.WishlistService.ImportWishlist(customerID, actorID, form.GetFormat(header.Filename),
+		file, form.DryRun, wc.partnerKey(c))
+	if err != nil {
+		wc.respondError(c, err)
+		return
+	}
+	wc.respond(c, report)
+}
@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WishlistTrashController struct {
+	BaseController
+	TrashService servicers.WishlistTrashServicer
+}
+
+func NewWishlistTrashController(trashService servicers.WishlistTrashServicer) handlers.WishlistTrashHandler {
+	return &WishlistTrashController{TrashService: trashService}
+}
+
+// ListTrash godoc
+// @Security     ApiKeyAuth
+// @Summary      List Wishlist Trash
+// @Description  Get the items removed from the wishlist that can still be restored, last removed first
+// @Tags         wishlist
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, the owner or a collaborator, defaults to the owner"
+// @Success      200  {array}  models.TrashedWishlistItem
+// @Router       /api/v1/customers/{id}/wishlist/trash [get]
+func (tc *WishlistTrashController) List(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	actorID, ok := tc.actingCustomer(c)
+	if !ok {
+		return
+	}
+
+	items, err := tc.TrashService.ListTrash(customerID, actorID)
+	if err != nil {
+		tc.respondError(c, err)
+		return
+	}
+	tc.respond(c, items)
+}
+
+// RestoreItem godoc
+// @Security     ApiKeyAuth
+// @Summary      Restore Wishlist Item
+// @Description  Put a removed item back in the wishlist with its original added date, price, position and tags
+// @Tags         wishlist
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        product_id path string true "Product ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, the owner or a collaborator, defaults to the owner"
+// @Param        X-Partner-Key  header  string  false  "Partner integration doing the request, checked by the wishlist rules"
+// @Success      200  {object}  models.WishlistItem
+// @Router       /api/v1/customers/{id}/wishlist/trash/{product_id}/restore [post]
+func (tc *WishlistTrashController) Restore(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	actorID, ok := tc.actingCustomer(c)
+	if !ok {
+		return
+	}
+	productID, err := strconv.ParseInt(c.Param("product_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	item, err := tc.TrashService.RestoreItem(customerID, int32(productID), actorID, tc.partnerKey(c))
+	if err != nil {
+		tc.respondError(c, err)
+		return
+	}
+	tc.respond(c, item)
+}
+
+// EmptyTrash godoc
+// @Security     ApiKeyAuth
+// @Summary      Empty Wishlist Trash
+// @Description  Delete the removed items for good
+// @Tags         wishlist
+// @Param        id path string true "Customer ID"
+// @Param        X-Customer-ID  header  string  false  "Acting customer, the owner or a collaborator, defaults to the owner"
+// @Success      204
+// @Router       /api/v1/customers/{id}/wishlist/trash [delete]
+func (tc *WishlistTrashController) Empty(c *gin.Context) {
+	customerID := c.Param("id")
+	if _, err := uuid.Parse(customerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid customer ID"})
+		return
+	}
+	actorID, ok := tc.actingCustomer(c)
+	if !ok {
+		return
+	}
+
+	if err := tc.TrashService.EmptyTrash(customerID, actorID); err != nil {
+		tc.respondError(c, err)
+		return
+	}
+	tc.respondSuccessNoContent(c)
+}
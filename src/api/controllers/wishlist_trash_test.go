@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/api/router"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+const trashCustomerID = "00000000-0000-0000-0000-000000000000"
+
+func setupTrashTestRouter(t *testing.T) (*gin.Engine, *mocks.WishlistTrashServicer) {
+	err := godotenv.Load("../../../.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	config.API_KEY = os.Getenv("API_KEY")
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	trashService := new(mocks.WishlistTrashServicer)
+
+	routeHandlers := mockHandlers()
+	routeHandlers.Trash = NewWishlistTrashController(trashService)
+	router.SetupRouter(r, routeHandlers)
+
+	return r, trashService
+}
+
+func TestWishlistTrashController_List_Success(t *testing.T) {
+	r, mockService := setupTrashTestRouter(t)
+
+	mockService.On("ListTrash", trashCustomerID, trashCustomerID).Return([]models.TrashedWishlistItem{{ProductID: 4}}, nil)
+
+	resp := serveCartRequest(r, http.MethodGet, trashCustomerID+"/wishlist/trash", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var body []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Len(t, body, 1)
+	assert.Equal(t, float64(4), body[0]["product_id"])
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistTrashController_Restore_Success(t *testing.T) {
+	r, mockService := setupTrashTestRouter(t)
+
+	mockService.On("RestoreItem", trashCustomerID, int32(4), trashCustomerID, "").Return(&models.WishlistItem{ProductID: 4}, nil)
+
+	resp := serveCartRequest(r, http.MethodPost, trashCustomerID+"/wishlist/trash/4/restore", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistTrashController_Restore_NotInTrash(t *testing.T) {
+	r, mockService := setupTrashTestRouter(t)
+
+	mockService.On("RestoreItem", trashCustomerID, int32(4), trashCustomerID, "").
+		Return(nil, &exceptions.NotFoundEntityError{Reason: "product not in trash"})
+
+	resp := serveCartRequest(r, http.MethodPost, trashCustomerID+"/wishlist/trash/4/restore", nil)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWishlistTrashController_Restore_InvalidProduct(t *testing.T) {
+	r, mockService := setupTrashTestRouter(t)
+
+	resp := serveCartRequest(r, http.MethodPost, trashCustomerID+"/wishlist/trash/abc/restore", nil)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "RestoreItem", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWishlistTrashController_Empty_Success(t *testing.T) {
+	r, mockService := setupTrashTestRouter(t)
+
+	mockService.On("EmptyTrash", trashCustomerID, trashCustomerID).Return(nil)
+
+	resp := serveCartRequest(r, http.MethodDelete, trashCustomerID+"/wishlist/trash", nil)
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	mockService.AssertExpectations(t)
+}
@@ -15,130 +15,309 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/customers": {
-            "get": {
+        "/api/v1/admin/currencies/rates/import": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
+                    },
+                    {
+                        "AdminKeyAuth": []
                     }
                 ],
-                "description": "Get all customers",
+                "description": "Upload a CSV with \"currency,rate,rate_date\" lines (rate_date as YYYY-MM-DD). Nothing is saved when a line is invalid",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "currencies"
+                ],
+                "summary": "Import exchange rates",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
                 ],
-                "summary": "List customers",
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Customer"
-                            }
-                        }
+                        "description": "OK"
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/v1/admin/currencies/rates/{currency}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
+                    },
+                    {
+                        "AdminKeyAuth": []
                     }
                 ],
-                "description": "Create a customer",
+                "description": "Create or replace the rate of a currency, in units of the currency per USD",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "currencies"
                 ],
-                "summary": "Create a customer",
+                "summary": "Set exchange rate",
                 "parameters": [
                     {
-                        "description": "CustomerForm form",
-                        "name": "customer",
+                        "type": "string",
+                        "description": "ISO 4217 currency code",
+                        "name": "currency",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ExchangeRateForm form",
+                        "name": "rate",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/forms.CustomerForm"
+                            "$ref": "#/definitions/forms.ExchangeRateForm"
                         }
                     }
                 ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "AdminKeyAuth": []
+                    }
+                ],
+                "description": "Stop supporting a currency",
+                "tags": [
+                    "currencies"
+                ],
+                "summary": "Delete exchange rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ISO 4217 currency code",
+                        "name": "currency",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/exports/customers": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "AdminKeyAuth": []
+                    }
+                ],
+                "description": "Stream every customer with the wishlist items. The ndjson export (default) has a customer per\nline, the csv export a line per wishlist item with the columns customer_id, name, email,\ncustomer_created_at, customer_updated_at, product_id, title, category, status, quantity,\nadded_price, added_at and deleted_at. With updated_since only the customers with a change in\nthe change feed after it are exported: the customer updated, or wishlist items added, edited or\nremoved. Customers deleted after it are exported with deleted_at and no name or email.\nupdated_since must be within the change feed retention, older ones get a 410 and need a full\nexport. An error after the first rows truncates the response.",
+                "produces": [
+                    "application/x-ndjson",
+                    "text/csv",
+                    "application/gzip"
+                ],
+                "tags": [
+                    "exports"
+                ],
+                "summary": "Export customers",
+                "parameters": [
+                    {
+                        "enum": [
+                            "ndjson",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Gzip the file",
+                        "name": "gzip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time of the previous export",
+                        "name": "updated_since",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": ""
+                            "$ref": "#/definitions/models.ExportedCustomer"
                         }
                     }
                 }
             }
         },
-        "/api/v1/customers/{id}": {
+        "/api/v1/admin/reminders/pending": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
+                    },
+                    {
+                        "AdminKeyAuth": []
                     }
                 ],
-                "description": "Get a single customer by Id",
+                "description": "Reminders waiting to be delivered by a notification channel, oldest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "reminders"
                 ],
-                "summary": "Get Customer by Id",
+                "summary": "List pending reminders",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WishlistReminder"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/reminders/{reminder_id}/delivered": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "AdminKeyAuth": []
+                    }
+                ],
+                "description": "Called by the notification channel once the reminder reached the customer",
+                "tags": [
+                    "reminders"
+                ],
+                "summary": "Mark reminder delivered",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Customer ID",
-                        "name": "id",
+                        "description": "Reminder ID",
+                        "name": "reminder_id",
                         "in": "path",
                         "required": true
                     }
                 ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/wishlist-rules": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "AdminKeyAuth": []
+                    }
+                ],
+                "description": "Rules checked before adding or removing wishlist items, from the rules file and the database",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist-rules"
+                ],
+                "summary": "List wishlist rules",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": ""
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WishlistRule"
+                            }
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/admin/wishlist-rules/{name}": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
+                    },
+                    {
+                        "AdminKeyAuth": []
                     }
                 ],
-                "description": "Update a customer",
+                "description": "Create or replace a rule. The expression is a CEL condition that must hold, over the variables\naction, actor.is_owner, request.partner_key, customer.id, customer.name, customer.email,\ncustomer.wishlist_count, product.id, product.title, product.category, product.price,\nproduct.rating and product.discontinued. For example: customer.wishlist_count \u003c 100 || action == \"remove\"",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "wishlist-rules"
                 ],
-                "summary": "Update a customer",
+                "summary": "Save wishlist rule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Customer ID",
-                        "name": "id",
+                        "description": "Rule name",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "CustomerForm form",
-                        "name": "customer",
+                        "description": "WishlistRuleForm form",
+                        "name": "rule",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/forms.CustomerForm"
+                            "$ref": "#/definitions/forms.WishlistRuleForm"
                         }
                     }
                 ],
@@ -146,7 +325,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": ""
+                            "$ref": "#/definitions/models.WishlistRule"
                         }
                     }
                 }
@@ -155,214 +334,4021 @@ const docTemplate = `{
                 "security": [
                     {
                         "ApiKeyAuth": []
+                    },
+                    {
+                        "AdminKeyAuth": []
                     }
                 ],
-                "description": "Removes a customer",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Rules of the rules file can't be deleted",
                 "tags": [
-                    "customers"
+                    "wishlist-rules"
                 ],
-                "summary": "Delete a customer",
+                "summary": "Delete wishlist rule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Customer ID",
-                        "name": "id",
+                        "description": "Rule name",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "No Content",
-                        "schema": {
-                            "type": ""
-                        }
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/api/v1/customers/{id}/wishlist": {
-            "post": {
+        "/api/v1/changes": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Given a customer and a product add the product to the customer wishlist",
+                "description": "Created, updated and deleted customers and wishlist items in the order they were committed. Start\nwithout a cursor and send the next_cursor of each page in the following request. With wait the\nrequest is held until there are changes or the seconds pass (up to CHANGE_FEED_MAX_WAIT).\nThe data of a change is the row after it, deletions have none. Changes are kept for\nCHANGE_FEED_RETENTION, an older cursor gets 410 and the data has to be mirrored again",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "wishlist"
+                    "changes"
                 ],
-                "summary": "Add Product To Wishlist",
+                "summary": "List changes",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Customer ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Position to continue from, the next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
                     },
                     {
-                        "description": "WishlistForm form",
-                        "name": "wishlist",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/forms.WishlistForm"
-                        }
+                        "type": "integer",
+                        "description": "Page size (default 100, max 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Seconds to wait for changes when there are none",
+                        "name": "wait",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ChangeFeedPage"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/customers/{id}/wishlist/{product_id}": {
-            "delete": {
+        "/api/v1/currencies/rates": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Given a customer and a product remove the product from the wishlist",
+                "description": "Rates from the catalog currency (USD) to the other supported currencies",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "wishlist"
-                ],
-                "summary": "Remove Product From Wishlist",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Customer ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Product ID",
-                        "name": "product_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "currencies"
                 ],
+                "summary": "List exchange rates",
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ExchangeRate"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/api/v1/products": {
+        "/api/v1/customers": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all products",
+                "description": "Get all customers",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "customers"
                 ],
-                "summary": "List products",
+                "summary": "List customers",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Product"
+                                "$ref": "#/definitions/models.Customer"
                             }
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "forms.CustomerForm": {
-            "type": "object",
-            "required": [
-                "email",
-                "name"
-            ],
-            "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a customer",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Create a customer",
+                "parameters": [
+                    {
+                        "description": "CustomerForm form",
+                        "name": "customer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.CustomerForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": ""
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/import": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Upload a csv with the columns name, email and the optional product_ids (separated by \"|\"). The file is\nprocessed in the background, every row is validated like a create customer request and its products\nare wishlisted. Follow the job for the progress and the error report",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Import customers",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/models.ImportJob"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a single customer by Id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Get Customer by Id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": ""
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a customer",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Update a customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "CustomerForm form",
+                        "name": "customer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.CustomerForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": ""
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes a customer",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Delete a customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": ""
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/cart": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the items in the customer cart with the unit price they had when added, the quantities and the total",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Get Cart",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Also show the product prices in this currency (or use the Accept-Currency header)",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Cart"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/cart/items": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add units of a product to the cart with its current price, the quantity is added to the units already in the cart",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Add Product To Cart",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "CartItemForm form",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.CartItemForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Cart"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/cart/items/{product_id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Set the quantity of a product in the cart",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Update Cart Item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "UpdateCartItemForm form",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.UpdateCartItemForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Cart"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a product from the cart",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Remove Product From Cart",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/cart/move-from-wishlist": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Move a wishlist item, or the whole wishlist when productId is omitted, to the cart in a single transaction.\nItems pending validation or out of the catalog are skipped when moving everything.\nSet keepInWishlist to copy the items instead of moving them, moved items go to the wishlist trash",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Move Wishlist To Cart",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "MoveToCartForm form",
+                        "name": "move",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.MoveToCartForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MoveToCartResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/favorite-categories": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the catalog categories the customer follows",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "favorite-categories"
+                ],
+                "summary": "List Favorite Categories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.FavoriteCategory"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a catalog category to the customer favorites, following it again changes nothing",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "favorite-categories"
+                ],
+                "summary": "Follow Category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "FavoriteCategoryForm form",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.FavoriteCategoryForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.FavoriteCategory"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/favorite-categories/new": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the products added to the followed categories since the last acknowledged cursor, the listing doesn't move the checkpoint",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "favorite-categories"
+                ],
+                "summary": "New In Favorite Categories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.NewInFavoriteCategories"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/favorite-categories/new/ack": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Move the checkpoint to the cursor of a listing of new products, an older cursor changes nothing",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "favorite-categories"
+                ],
+                "summary": "Acknowledge New In Favorite Categories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "NewInFavoriteCategoriesAckForm form",
+                        "name": "ack",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.NewInFavoriteCategoriesAckForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/favorite-categories/{category}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a category from the customer favorites",
+                "tags": [
+                    "favorite-categories"
+                ],
+                "summary": "Unfollow Category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category name",
+                        "name": "category",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/recently-viewed": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the products the customer viewed, most recent first and once each",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recently-viewed"
+                ],
+                "summary": "List Recently Viewed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max number of products, up to the configured history size",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ProductView"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Record that the customer viewed a product. The view is written in the background and shows up in the history after a few seconds.\n404 for an unknown customer, 503 when too many views are waiting to be written. Views of products missing from the catalog are dropped and counted in the admin metrics",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recently-viewed"
+                ],
+                "summary": "Record Product View",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ProductViewForm form",
+                        "name": "view",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.ProductViewForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/registry": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the customer gift registry with the token to share with the guests.\nThe reservations are left out so the owner doesn't know what was already bought",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "registry"
+                ],
+                "summary": "Get Gift Registry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Registry"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Turn the customer wishlist into a gift registry guests can reserve items from.\nEnabling it again only changes the title, the token stays the same",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "registry"
+                ],
+                "summary": "Enable Gift Registry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "RegistryForm form",
+                        "name": "registry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.RegistryForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WishlistRegistry"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Turn the gift registry off, the reservations made by the guests are dropped",
+                "tags": [
+                    "registry"
+                ],
+                "summary": "Disable Gift Registry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/registry/items/{product_id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Set how many units of a wishlist item the customer wants to receive",
+                "tags": [
+                    "registry"
+                ],
+                "summary": "Set Registry Item Quantity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "RegistryItemForm form",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.RegistryItemForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/reminders": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the reminders sent to the customer about old wishlist items, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reminders"
+                ],
+                "summary": "List Reminders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WishlistReminder"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/reminders/preferences": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get whether the customer gets reminders about old wishlist items",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reminders"
+                ],
+                "summary": "Get Reminder Preferences",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReminderPreference"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Opt the customer out of (or back into) the reminders about old wishlist items",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reminders"
+                ],
+                "summary": "Update Reminder Preferences",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ReminderPreferenceForm form",
+                        "name": "preferences",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.ReminderPreferenceForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReminderPreference"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/tags": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the customer tags and how many wishlist items use each of them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "List Tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.TagUsage"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/tags/{tag}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete the customer tag and remove it from every wishlist item",
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Delete Tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag name",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the items in the customer wishlist, flagged as stale when the products api is unavailable\nCollaborators of a shared wishlist can see it with the X-Customer-ID header",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "List Wishlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, the owner or a collaborator, defaults to the owner",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Also show the prices in this currency (or use the Accept-Currency header)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the items with these comma separated tags",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "Match any (default) or all of the tags",
+                        "name": "match",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Wishlist"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Given a customer and a product add the product to the customer wishlist\nWhen the products api is unavailable the product is queued as pending validation (202)\nEditors of a shared wishlist can add products with the X-Customer-ID header\nProducts breaking a wishlist rule are refused (422) with the broken rules",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "Add Product To Wishlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, the owner or a collaborator, defaults to the owner",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Partner integration doing the request, checked by the wishlist rules",
+                        "name": "X-Partner-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "WishlistForm form",
+                        "name": "wishlist",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WishlistForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "202": {
+                        "description": "Accepted"
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist-invitations": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the wishlists other customers shared with the customer",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist-sharing"
+                ],
+                "summary": "List Wishlist Invitations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WishlistCollaborator"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist-invitations/{owner_id}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Accept a pending invitation to the wishlist of another customer",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist-sharing"
+                ],
+                "summary": "Accept Wishlist Invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, defaults to the customer in the path",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wishlist owner customer ID",
+                        "name": "owner_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WishlistCollaborator"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist-invitations/{owner_id}/decline": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Decline a pending invitation to the wishlist of another customer",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist-sharing"
+                ],
+                "summary": "Decline Wishlist Invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, defaults to the customer in the path",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wishlist owner customer ID",
+                        "name": "owner_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WishlistCollaborator"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist/collaborators": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the customers the wishlist is shared with and the state of their invitations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist-sharing"
+                ],
+                "summary": "List Wishlist Collaborators",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WishlistCollaborator"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Share the customer wishlist with another customer, found by id or email, as a viewer or an editor.\nThe invited customer has to accept the invitation, inviting again changes the role",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist-sharing"
+                ],
+                "summary": "Invite To Wishlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, defaults to the customer in the path",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "InviteCollaboratorForm form",
+                        "name": "invite",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.InviteCollaboratorForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WishlistCollaborator"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist/collaborators/{collaborator_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Stop sharing the wishlist with a customer",
+                "tags": [
+                    "wishlist-sharing"
+                ],
+                "summary": "Remove Wishlist Collaborator",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, defaults to the customer in the path",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Collaborator customer ID",
+                        "name": "collaborator_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist/compare": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Compare 2 to 5 wishlisted products side by side: price, rating, category and description length,\nwith the best value of each attribute and the differences between the products",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "Compare Wishlist Products",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, the owner or a collaborator, defaults to the owner",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated product ids, like 1,2,3",
+                        "name": "product_ids",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProductComparison"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist/export": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download the wishlist as csv (default) or json. The csv has the columns\nproduct_id, title, category, price, added_price, added_at and tags (separated by \"|\")",
+                "produces": [
+                    "text/csv",
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "Export Wishlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, the owner or a collaborator, defaults to the owner",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "json"
+                        ],
+                        "type": "string",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WishlistExportItem"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist/import": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add the products of a csv or json file, in the export format. Only product_id is required, tags are optional.\nEach product is checked against the catalog and the wishlist rules, products already in the wishlist\nor repeated are skipped and the report tells the outcome of every line. Nothing is saved on a dry run",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "Import Wishlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, the owner or a collaborator, defaults to the owner",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Partner integration doing the request, checked by the wishlist rules",
+                        "name": "X-Partner-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "file",
+                        "description": "CSV or JSON file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "json"
+                        ],
+                        "type": "string",
+                        "description": "File format, defaults to the file extension",
+                        "name": "format",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only validate the file",
+                        "name": "dry_run",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WishlistImportReport"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist/order": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Set the order of the whole wishlist, the product ids must list every item exactly once",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "Reorder Wishlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, the owner or a collaborator, defaults to the owner",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "WishlistOrderForm form",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WishlistOrderForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Wishlist"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist/summary": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the wishlist value now and when the items were added, by category, with the cheapest\nand most expensive items. Flagged as stale when some products could not be fetched",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "Wishlist Summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, the owner or a collaborator, defaults to the owner",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WishlistSummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist/trash": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the items removed from the wishlist that can still be restored, last removed first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "List Wishlist Trash",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, the owner or a collaborator, defaults to the owner",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.TrashedWishlistItem"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete the removed items for good",
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "Empty Wishlist Trash",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, the owner or a collaborator, defaults to the owner",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist/trash/{product_id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Put a removed item back in the wishlist with its original added date, price, position and tags",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "Restore Wishlist Item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, the owner or a collaborator, defaults to the owner",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Partner integration doing the request, checked by the wishlist rules",
+                        "name": "X-Partner-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WishlistItem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist/{product_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Given a customer and a product remove the product from the wishlist\nEditors of a shared wishlist can remove products with the X-Customer-ID header",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "Remove Product From Wishlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, the owner or a collaborator, defaults to the owner",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Partner integration doing the request, checked by the wishlist rules",
+                        "name": "X-Partner-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist/{product_id}/position": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Move an item to a position of the wishlist, 0 is the top and positions past the end move it to the bottom",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "Move Wishlist Item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Acting customer, the owner or a collaborator, defaults to the owner",
+                        "name": "X-Customer-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "MoveWishlistItemForm form",
+                        "name": "move",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.MoveWishlistItemForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Wishlist"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist/{product_id}/tags": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Put tags on a wishlist item, tags are created on first use and are case insensitive",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Tag Wishlist Item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "TagsForm form",
+                        "name": "tags",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.TagsForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customers/{id}/wishlist/{product_id}/tags/{tag}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a tag from a wishlist item, the tag is kept for the other items",
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Untag Wishlist Item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag name",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/jobs/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Status and progress of a background import, the error report is at /api/v1/jobs/{id}/errors",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jobs"
+                ],
+                "summary": "Get job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ImportJob"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/jobs/{id}/errors": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "The rows that failed so far as csv, with the columns line, email, product_id and error. A row with\na product_id created the customer but could not wishlist the product",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "jobs"
+                ],
+                "summary": "Download job error report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ImportJobError"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Browse the catalog with filters, sorting and pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "List products",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category name",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum price",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum price",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum rating (0 to 5)",
+                        "name": "min_rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text to search in title and description",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "price",
+                            "title",
+                            "rating"
+                        ],
+                        "type": "string",
+                        "description": "Sort field",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Also show the prices in this currency (or use the Accept-Currency header)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Flag the products in the customer wishlist and list their collections",
+                        "name": "customer_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProductPage"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/categories": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all catalog categories",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "List categories",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/changes": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Changes detected by the catalog mirror after the given time, oldest first.\nUpdates have one entry per changed field.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "List catalog changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 timestamp, only later changes are returned",
+                        "name": "since",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProductChangePage"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/search": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Full-text search over title, description and category (english and portuguese) with typo tolerance.\nMatched terms are wrapped in \u003cmark\u003e\u003c/mark\u003e, facets count the matches per category.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Search products",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search terms",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Restrict the results to a category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Also show the prices in this currency (or use the Accept-Currency header)",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProductSearchPage"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a single product from the catalog",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get Product by Id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Also show the price in this currency (or use the Accept-Currency header)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Flag whether the product is in the customer wishlist and list its collections",
+                        "name": "customer_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Product"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/registries/{token}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the gift registry shared with the token, with how many units of each item are still available",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "registry"
+                ],
+                "summary": "Guest Gift Registry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Registry token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Registry"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/registries/{token}/reservations": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Reserve units of a registry item so other guests don't buy them. The reservation expires unless\nit is confirmed, keep its id to confirm or cancel it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "registry"
+                ],
+                "summary": "Reserve Registry Item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Registry token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ReservationForm form",
+                        "name": "reservation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.ReservationForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.RegistryReservation"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/registries/{token}/reservations/{reservation_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cancel a reservation so the units are available to other guests again",
+                "tags": [
+                    "registry"
+                ],
+                "summary": "Cancel Registry Reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Registry token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/registries/{token}/reservations/{reservation_id}/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Confirm a pending reservation so it doesn't expire",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "registry"
+                ],
+                "summary": "Confirm Registry Reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Registry token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RegistryReservation"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/catalog": {
+            "post": {
+                "description": "Catalog change notification (product updated, deleted or price changed). Signed with X-Signature, the hex HMAC-SHA256 of the body. Events older than the last one applied to the product are logged without changing it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Receive catalog change",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "HMAC-SHA256 of the body",
+                        "name": "X-Signature",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "CatalogEventForm form",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.CatalogEventForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "forms.CartItemForm": {
+            "type": "object",
+            "required": [
+                "productId",
+                "quantity"
+            ],
+            "properties": {
+                "productId": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 99,
+                    "minimum": 1
+                }
+            }
+        },
+        "forms.CatalogEventForm": {
+            "type": "object",
+            "required": [
+                "event_id",
+                "occurred_at",
+                "product_id",
+                "type"
+            ],
+            "properties": {
+                "event_id": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "occurred_at": {
+                    "description": "OccurredAt is the RFC 3339 time of the change in the catalog",
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "product.updated",
+                        "product.deleted",
+                        "product.price_changed"
+                    ]
+                }
+            }
+        },
+        "forms.CustomerForm": {
+            "type": "object",
+            "required": [
+                "email",
+                "name"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "forms.ExchangeRateForm": {
+            "type": "object",
+            "required": [
+                "rate",
+                "rate_date"
+            ],
+            "properties": {
+                "rate": {
+                    "type": "number"
+                },
+                "rate_date": {
+                    "type": "string",
+                    "example": "2026-10-19"
+                }
+            }
+        },
+        "forms.FavoriteCategoryForm": {
+            "type": "object",
+            "required": [
+                "category"
+            ],
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "forms.InviteCollaboratorForm": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "customerId": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "viewer",
+                        "editor"
+                    ]
+                }
+            }
+        },
+        "forms.MoveToCartForm": {
+            "type": "object",
+            "properties": {
+                "keepInWishlist": {
+                    "type": "boolean"
+                },
+                "productId": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "forms.MoveWishlistItemForm": {
+            "type": "object",
+            "required": [
+                "position"
+            ],
+            "properties": {
+                "position": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "forms.NewInFavoriteCategoriesAckForm": {
+            "type": "object",
+            "required": [
+                "cursor"
+            ],
+            "properties": {
+                "cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "forms.ProductViewForm": {
+            "type": "object",
+            "required": [
+                "product_id"
+            ],
+            "properties": {
+                "product_id": {
+                    "type": "integer"
+                },
+                "viewed_at": {
+                    "description": "ViewedAt defaults to the time the view is received",
+                    "type": "string"
+                }
+            }
+        },
+        "forms.RegistryForm": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "forms.RegistryItemForm": {
+            "type": "object",
+            "required": [
+                "quantity"
+            ],
+            "properties": {
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 99,
+                    "minimum": 1
+                }
+            }
+        },
+        "forms.ReminderPreferenceForm": {
+            "type": "object",
+            "required": [
+                "stale_items_opt_out"
+            ],
+            "properties": {
+                "stale_items_opt_out": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "forms.ReservationForm": {
+            "type": "object",
+            "required": [
+                "guestName",
+                "productId",
+                "quantity"
+            ],
+            "properties": {
+                "guestEmail": {
+                    "type": "string"
+                },
+                "guestName": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "productId": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 99,
+                    "minimum": 1
+                }
+            }
+        },
+        "forms.TagsForm": {
+            "type": "object",
+            "required": [
+                "tags"
+            ],
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "forms.UpdateCartItemForm": {
+            "type": "object",
+            "required": [
+                "quantity"
+            ],
+            "properties": {
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 99,
+                    "minimum": 1
+                }
+            }
+        },
+        "forms.WishlistForm": {
+            "type": "object",
+            "required": [
+                "productId"
+            ],
+            "properties": {
+                "productId": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "forms.WishlistOrderForm": {
+            "type": "object",
+            "required": [
+                "productIds"
+            ],
+            "properties": {
+                "productIds": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "forms.WishlistRuleForm": {
+            "type": "object",
+            "required": [
+                "expression"
+            ],
+            "properties": {
+                "actions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "expression": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Cart": {
+            "type": "object",
+            "properties": {
+                "customer_id": {
+                    "type": "string"
+                },
+                "item_count": {
+                    "description": "ItemCount sums the quantities of the items",
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CartItem"
+                    }
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.CartItem": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string"
+                },
+                "product": {
+                    "$ref": "#/definitions/models.Product"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "unit_price": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CategoryFacet": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ChangeEvent": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "changed_at": {
+                    "type": "string"
+                },
+                "cursor": {
+                    "type": "string"
+                },
+                "customer_id": {
+                    "type": "string"
+                },
+                "data": {
+                    "type": "object"
+                },
+                "entity": {
+                    "type": "string"
+                },
+                "product_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ChangeFeedPage": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ChangeEvent"
+                    }
+                },
+                "has_more": {
+                    "type": "boolean"
+                },
+                "next_cursor": {
+                    "description": "NextCursor is the position after the last change, to send in the next\nrequest. It is the request cursor when there were no changes.",
+                    "type": "string"
+                }
+            }
+        },
+        "models.ComparedProduct": {
+            "type": "object",
+            "properties": {
+                "image": {
+                    "type": "string"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ComparisonAttribute": {
+            "type": "object",
+            "properties": {
+                "all_equal": {
+                    "type": "boolean"
+                },
+                "best": {
+                    "description": "Best lists the products with the best value, empty when the attribute\nhas no ranking (category) or every product has the same value",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "difference": {
+                    "description": "Difference is the gap between the highest and lowest values, only for\nnumeric attributes",
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                }
+            }
+        },
+        "models.ConvertedPrice": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "rate_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Customer": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wishlist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Product"
+                    }
+                }
+            }
+        },
+        "models.ExchangeRate": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "rate_date": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ExportedCustomer": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wishlist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ExportedWishlistItem"
+                    }
+                }
+            }
+        },
+        "models.ExportedWishlistItem": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string"
+                },
+                "added_price": {
+                    "type": "number"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.FavoriteCategory": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "checked_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ImportJob": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_rows": {
+                    "type": "integer"
+                },
+                "error_count": {
+                    "type": "integer"
+                },
+                "failed_rows": {
+                    "type": "integer"
+                },
+                "filename": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "processed_rows": {
+                    "type": "integer"
+                },
+                "progress": {
+                    "description": "Progress is the percentage of the rows processed",
+                    "type": "number"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total_rows": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ImportJobError": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "line": {
+                    "type": "integer"
+                },
+                "product_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.MoveToCartResult": {
+            "type": "object",
+            "properties": {
+                "cart": {
+                    "$ref": "#/definitions/models.Cart"
+                },
+                "moved": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "skipped": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "models.NewInFavoriteCategories": {
+            "type": "object",
+            "properties": {
+                "cursor": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Product"
+                    }
+                }
+            }
+        },
+        "models.Pagination": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "has_more": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Product": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "collections": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "converted_price": {
+                    "description": "ConvertedPrice is only filled when the client asks for another currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ConvertedPrice"
+                        }
+                    ]
+                },
+                "description": {
+                    "type": "string"
+                },
+                "discontinued": {
+                    "description": "Discontinued is set when the catalog notifies the product was deleted",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "rating": {
+                    "$ref": "#/definitions/models.Rating"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "wishlisted": {
+                    "description": "Wishlisted and Collections are only filled when the client asks for a\ncustomer, Collections are the tags the customer put on the wishlist item",
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ProductChange": {
+            "type": "object",
+            "properties": {
+                "changed_at": {
+                    "type": "string"
+                },
+                "field": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "new_value": {
+                    "type": "string"
+                },
+                "old_value": {
+                    "type": "string"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ProductChangePage": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ProductChange"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                }
+            }
+        },
+        "models.ProductComparison": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ComparisonAttribute"
+                    }
+                },
+                "customer_id": {
+                    "type": "string"
+                },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ComparedProduct"
+                    }
+                },
+                "stale": {
+                    "description": "Stale is set when some products could not be fetched and their stored data was used",
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ProductPage": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Product"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                }
+            }
+        },
+        "models.ProductSearchPage": {
+            "type": "object",
+            "properties": {
+                "facets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CategoryFacet"
+                    }
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ProductSearchResult"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                }
+            }
+        },
+        "models.ProductSearchResult": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "collections": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "converted_price": {
+                    "description": "ConvertedPrice is only filled when the client asks for another currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ConvertedPrice"
+                        }
+                    ]
+                },
+                "description": {
+                    "type": "string"
+                },
+                "discontinued": {
+                    "description": "Discontinued is set when the catalog notifies the product was deleted",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "rank": {
+                    "type": "number"
+                },
+                "rating": {
+                    "$ref": "#/definitions/models.Rating"
+                },
+                "snippet": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "title_highlight": {
+                    "description": "Highlights mark the matched terms with \u003cmark\u003e\u003c/mark\u003e",
+                    "type": "string"
+                },
+                "wishlisted": {
+                    "description": "Wishlisted and Collections are only filled when the client asks for a\ncustomer, Collections are the tags the customer put on the wishlist item",
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ProductView": {
+            "type": "object",
+            "properties": {
+                "product": {
+                    "$ref": "#/definitions/models.Product"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "viewed_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Rating": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "rate": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Registry": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RegistryItem"
+                    }
+                },
+                "owner_name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RegistryItem": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "integer"
+                },
+                "product": {
+                    "$ref": "#/definitions/models.Product"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "description": "Quantity is how many units the owner wants",
+                    "type": "integer"
+                },
+                "reserved": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.RegistryReservation": {
+            "type": "object",
+            "properties": {
+                "confirmed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "ExpiresAt is when a pending reservation frees the quantity again",
+                    "type": "string"
+                },
+                "guest_email": {
+                    "type": "string"
+                },
+                "guest_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
             }
         },
-        "forms.WishlistForm": {
+        "models.ReminderPreference": {
             "type": "object",
-            "required": [
-                "productId"
-            ],
             "properties": {
-                "productId": {
-                    "type": "integer",
-                    "minimum": 1
+                "stale_items_opt_out": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "models.Customer": {
+        "models.TagUsage": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "item_count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.TrashedWishlistItem": {
+            "type": "object",
+            "properties": {
+                "added_at": {
                     "type": "string"
                 },
-                "email": {
+                "added_by": {
                     "type": "string"
                 },
-                "id": {
+                "added_price": {
+                    "type": "number"
+                },
+                "expires_at": {
                     "type": "string"
                 },
-                "name": {
+                "product": {
+                    "$ref": "#/definitions/models.Product"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "removed_at": {
                     "type": "string"
                 },
-                "updated_at": {
+                "removed_by": {
                     "type": "string"
                 },
-                "wishlist": {
+                "status": {
+                    "type": "string"
+                },
+                "tags": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Product"
+                        "type": "string"
                     }
                 }
             }
         },
-        "models.Product": {
+        "models.Wishlist": {
+            "type": "object",
+            "properties": {
+                "customer_id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WishlistItem"
+                    }
+                },
+                "stale": {
+                    "description": "Stale is set when the products api could not be reached and the\nitems carry the last product data stored locally.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.WishlistCategorySummary": {
             "type": "object",
             "properties": {
+                "added_total": {
+                    "type": "number"
+                },
                 "category": {
                     "type": "string"
                 },
-                "description": {
+                "current_total": {
+                    "type": "number"
+                },
+                "item_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.WishlistCollaborator": {
+            "type": "object",
+            "properties": {
+                "collaborator": {
+                    "$ref": "#/definitions/models.Customer"
+                },
+                "collaborator_id": {
+                    "type": "string"
+                },
+                "invited_at": {
+                    "type": "string"
+                },
+                "owner": {
+                    "$ref": "#/definitions/models.Customer"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "responded_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.WishlistExportItem": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string"
+                },
+                "added_price": {
+                    "type": "number"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.WishlistImportLine": {
+            "type": "object",
+            "properties": {
+                "line": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.WishlistImportReport": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "type": "integer"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WishlistImportLine"
+                    }
+                },
+                "skipped": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.WishlistItem": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string"
+                },
+                "added_by": {
+                    "description": "AddedBy is the customer who added the item, the owner or an editor\nof the shared wishlist. Unknown for items added before sharing existed.",
+                    "type": "string"
+                },
+                "added_price": {
+                    "description": "AddedPrice is the product price when it was wishlisted, zero when unknown",
+                    "type": "number"
+                },
+                "available": {
+                    "type": "boolean"
+                },
+                "position": {
+                    "description": "Position is the rank of the item in the order set by the customer,\nsee RankBetween",
+                    "type": "string"
+                },
+                "product": {
+                    "$ref": "#/definitions/models.Product"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "description": "Quantity is how many units the customer wants, used by the gift registry",
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.WishlistRegistry": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "customer_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.WishlistReminder": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "customer_id": {
+                    "type": "string"
+                },
+                "delivered_at": {
                     "type": "string"
                 },
                 "id": {
+                    "type": "string"
+                },
+                "item_added_at": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "product": {
+                    "$ref": "#/definitions/models.Product"
+                },
+                "product_id": {
                     "type": "integer"
                 },
-                "image": {
+                "status": {
                     "type": "string"
                 },
-                "price": {
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.WishlistRule": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "description": "Actions limits the rule to some mutations, empty applies it to all",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "expression": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "source": {
+                    "description": "Source tells if the rule was loaded from the rules file or the database",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.WishlistSummary": {
+            "type": "object",
+            "properties": {
+                "added_total": {
+                    "description": "AddedTotal sums the prices when the items were added, items with an\nunknown added price count with their current price",
                     "type": "number"
                 },
+                "average_rating": {
+                    "type": "number"
+                },
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WishlistCategorySummary"
+                    }
+                },
+                "cheapest": {
+                    "$ref": "#/definitions/models.WishlistSummaryItem"
+                },
+                "current_total": {
+                    "type": "number"
+                },
+                "customer_id": {
+                    "type": "string"
+                },
+                "difference": {
+                    "description": "Difference is CurrentTotal - AddedTotal, negative values are savings",
+                    "type": "number"
+                },
+                "item_count": {
+                    "type": "integer"
+                },
+                "most_expensive": {
+                    "$ref": "#/definitions/models.WishlistSummaryItem"
+                },
+                "rated_count": {
+                    "type": "integer"
+                },
+                "stale": {
+                    "description": "Stale is set when some products could not be fetched and their stored data was used",
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.WishlistSummaryItem": {
+            "type": "object",
+            "properties": {
+                "added_price": {
+                    "type": "number"
+                },
+                "current_price": {
+                    "type": "number"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
                 "title": {
                     "type": "string"
                 }
@@ -370,6 +4356,11 @@ const docTemplate = `{
         }
     },
     "securityDefinitions": {
+        "AdminKeyAuth": {
+            "type": "apiKey",
+            "name": "X-Admin-Key",
+            "in": "header"
+        },
         "ApiKeyAuth": {
             "type": "apiKey",
             "name": "X-Api-Key",
@@ -0,0 +1,17 @@
+package forms
+
+type CartItemForm struct {
+	ProductID int32 `json:"productId" binding:"required,gte=1"`
+	Quantity  int   `json:"quantity" binding:"required,gte=1,lte=99"`
+}
+
+type UpdateCartItemForm struct {
+	Quantity int `json:"quantity" binding:"required,gte=1,lte=99"`
+}
+
+// MoveToCartForm moves a single wishlist item, or all of them when the
+// product is omitted
+type MoveToCartForm struct {
+	ProductID      *int32 `json:"productId" binding:"omitempty,gte=1"`
+	KeepInWishlist bool   `json:"keepInWishlist"`
+}
@@ -0,0 +1,35 @@
+package forms
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type CatalogEventForm struct {
+	BaseForm
+	EventID   string        `json:"event_id" binding:"required,max=100"`
+	Type      string        `json:"type" binding:"required,oneof=product.updated product.deleted product.price_changed"`
+	ProductID int32         `json:"product_id" binding:"required,gte=1"`
+	Price     *models.Money `json:"price" swaggertype:"number"`
+	// OccurredAt is the RFC 3339 time of the change in the catalog
+	OccurredAt time.Time `json:"occurred_at" binding:"required"`
+}
+
+func (f *CatalogEventForm) Validate() bool {
+	if f.Price != nil && f.Price.Amount < 0 {
+		f.addError("price", "must be greater than or equal to 0")
+	}
+
+	return f.IsValid()
+}
+
+func (f *CatalogEventForm) ToModel() *models.CatalogEvent {
+	return &models.CatalogEvent{
+		ID:         f.EventID,
+		Type:       f.Type,
+		ProductID:  f.ProductID,
+		Price:      f.Price,
+		OccurredAt: f.OccurredAt,
+	}
+}
@@ -0,0 +1,23 @@
+package forms
+
+import "time"
+
+const defaultChangeFeedLimit = 100
+
+type ChangeFeedForm struct {
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"omitempty,gte=1,lte=1000"`
+	// Wait is how many seconds to wait for changes when there are none
+	Wait int `form:"wait" binding:"omitempty,gte=0"`
+}
+
+func (f *ChangeFeedForm) GetLimit() int {
+	if f.Limit == 0 {
+		return defaultChangeFeedLimit
+	}
+	return f.Limit
+}
+
+func (f *ChangeFeedForm) GetWait() time.Duration {
+	return time.Duration(f.Wait) * time.Second
+}
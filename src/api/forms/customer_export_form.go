@@ -0,0 +1,22 @@
+package forms
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type CustomerExportForm struct {
+	Format string `form:"format" binding:"omitempty,oneof=ndjson csv"`
+	Gzip   bool   `form:"gzip"`
+	// UpdatedSince is RFC 3339, e.g. 2026-10-01T00:00:00Z
+	UpdatedSince *time.Time `form:"updated_since" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+func (f *CustomerExportForm) ToOptions() models.CustomerExportOptions {
+	options := models.CustomerExportOptions{Format: f.Format, Gzip: f.Gzip, UpdatedSince: f.UpdatedSince}
+	if options.Format == "" {
+		options.Format = models.CustomerExportNDJSON
+	}
+	return options
+}
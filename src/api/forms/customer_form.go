@@ -1,6 +1,10 @@
 package forms
 
-import "produtos-favoritos/src/domain/models"
+import (
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/gin-gonic/gin/binding"
+)
 
 type CustomerForm struct {
 	Name  string `json:"name" binding:"required"`
@@ -13,3 +17,10 @@ func (f *CustomerForm) ToModel() *models.Customer {
 		Email: f.Email,
 	}
 }
+
+// ValidateCustomer checks a customer that didn't come in a request body,
+// like the rows of a customer import, with the CustomerForm rules
+func ValidateCustomer(customer *models.Customer) error {
+	form := CustomerForm{Name: customer.Name, Email: customer.Email}
+	return binding.Validator.ValidateStruct(&form)
+}
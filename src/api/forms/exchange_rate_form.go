@@ -0,0 +1,22 @@
+package forms
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type ExchangeRateForm struct {
+	Rate     float64 `json:"rate" binding:"required,gt=0"`
+	RateDate string  `json:"rate_date" binding:"required,datetime=2006-01-02" example:"2026-10-19"`
+}
+
+func (f *ExchangeRateForm) ToModel(currency string) *models.ExchangeRate {
+	// the binding already checked the layout
+	rateDate, _ := time.Parse(time.DateOnly, f.RateDate)
+	return &models.ExchangeRate{
+		Currency: currency,
+		Rate:     f.Rate,
+		RateDate: rateDate,
+	}
+}
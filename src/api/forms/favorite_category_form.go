@@ -0,0 +1,13 @@
+package forms
+
+import "time"
+
+type FavoriteCategoryForm struct {
+	Category string `json:"category" binding:"required,max=100"`
+}
+
+// NewInFavoriteCategoriesAckForm moves the checkpoint to the cursor of a
+// listing of new products, once the client has handled them
+type NewInFavoriteCategoriesAckForm struct {
+	Cursor *time.Time `json:"cursor" binding:"required"`
+}
@@ -0,0 +1,92 @@
+package forms
+
+import (
+	"math/big"
+	"strings"
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+const (
+	defaultProductSearchLimit = 20
+	defaultProductChangeLimit = 100
+)
+
+type ProductFilterForm struct {
+	BaseForm
+	Category  string   `form:"category"`
+	MinPrice  *float32 `form:"min_price" binding:"omitempty,gte=0"`
+	MaxPrice  *float32 `form:"max_price" binding:"omitempty,gte=0"`
+	MinRating *float32 `form:"min_rating" binding:"omitempty,gte=0,lte=5"`
+	Query     string   `form:"q"`
+	Sort      string   `form:"sort" binding:"omitempty,oneof=price title rating"`
+	Order     string   `form:"order" binding:"omitempty,oneof=asc desc"`
+	Limit     int      `form:"limit" binding:"omitempty,gte=1,lte=100"`
+	Offset    int      `form:"offset" binding:"omitempty,gte=0"`
+}
+
+func (f *ProductFilterForm) Validate() bool {
+	if f.MinPrice != nil && f.MaxPrice != nil && *f.MinPrice > *f.MaxPrice {
+		f.addError("min_price", "must be lower than or equal to max_price")
+	}
+
+	return f.IsValid()
+}
+
+func (f *ProductFilterForm) ToModel() models.ProductFilter {
+	return models.ProductFilter{
+		Category:  f.Category,
+		MinPrice:  priceFilter(f.MinPrice),
+		MaxPrice:  priceFilter(f.MaxPrice),
+		MinRating: f.MinRating,
+		Query:     f.Query,
+		SortBy:    f.Sort,
+		Order:     f.Order,
+		Limit:     f.Limit,
+		Offset:    f.Offset,
+	}
+}
+
+// priceFilter reads the price bounds, given in the catalog currency
+func priceFilter(price *float32) *models.Money {
+	if price == nil {
+		return nil
+	}
+	money := models.MoneyFromRat(new(big.Rat).SetFloat64(float64(*price)), models.DefaultCurrency)
+	return &money
+}
+
+type ProductSearchForm struct {
+	Query    string `form:"q" binding:"required,min=2,max=100"`
+	Category string `form:"category"`
+	Limit    int    `form:"limit" binding:"omitempty,gte=1,lte=100"`
+	Offset   int    `form:"offset" binding:"omitempty,gte=0"`
+}
+
+func (f *ProductSearchForm) ToModel() models.ProductSearch {
+	limit := f.Limit
+	if limit == 0 {
+		limit = defaultProductSearchLimit
+	}
+
+	return models.ProductSearch{
+		Query:    strings.TrimSpace(f.Query),
+		Category: f.Category,
+		Limit:    limit,
+		Offset:   f.Offset,
+	}
+}
+
+type ProductChangesForm struct {
+	Since  time.Time `form:"since" time_format:"2006-01-02T15:04:05Z07:00" binding:"required"`
+	Limit  int       `form:"limit" binding:"omitempty,gte=1,lte=1000"`
+	Offset int       `form:"offset" binding:"omitempty,gte=0"`
+}
+
+func (f *ProductChangesForm) GetLimit() int {
+	if f.Limit == 0 {
+		return defaultProductChangeLimit
+	}
+	return f.Limit
+}
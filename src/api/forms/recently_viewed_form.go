@@ -0,0 +1,13 @@
+package forms
+
+import "time"
+
+type ProductViewForm struct {
+	ProductID int32 `json:"product_id" binding:"required,gt=0"`
+	// ViewedAt defaults to the time the view is received
+	ViewedAt time.Time `json:"viewed_at"`
+}
+
+type RecentlyViewedForm struct {
+	Limit int `form:"limit" binding:"omitempty,gte=1"`
+}
@@ -0,0 +1,27 @@
+package forms
+
+import "produtos-favoritos/src/domain/models"
+
+type RegistryForm struct {
+	Title string `json:"title" binding:"max=200"`
+}
+
+type RegistryItemForm struct {
+	Quantity int `json:"quantity" binding:"required,gte=1,lte=99"`
+}
+
+type ReservationForm struct {
+	ProductID  int32  `json:"productId" binding:"required,gte=1"`
+	Quantity   int    `json:"quantity" binding:"required,gte=1,lte=99"`
+	GuestName  string `json:"guestName" binding:"required,max=100"`
+	GuestEmail string `json:"guestEmail" binding:"omitempty,email"`
+}
+
+func (f *ReservationForm) ToRequest() models.ReservationRequest {
+	return models.ReservationRequest{
+		ProductID:  f.ProductID,
+		Quantity:   f.Quantity,
+		GuestName:  f.GuestName,
+		GuestEmail: f.GuestEmail,
+	}
+}
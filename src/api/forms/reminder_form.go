@@ -0,0 +1,18 @@
+package forms
+
+const defaultPendingReminderLimit = 100
+
+type ReminderPreferenceForm struct {
+	StaleItemsOptOut *bool `json:"stale_items_opt_out" binding:"required"`
+}
+
+type PendingRemindersForm struct {
+	Limit int `form:"limit" binding:"omitempty,gte=1,lte=1000"`
+}
+
+func (f *PendingRemindersForm) GetLimit() int {
+	if f.Limit == 0 {
+		return defaultPendingReminderLimit
+	}
+	return f.Limit
+}
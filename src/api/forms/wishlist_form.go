@@ -1,5 +1,138 @@
 package forms
 
+import (
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+const (
+	minComparedProducts = 2
+	maxComparedProducts = 5
+)
+
 type WishlistForm struct {
 	ProductID int32 `json:"productId" binding:"required,gte=1"`
 }
+
+type CompareProductsForm struct {
+	BaseForm
+	ProductIDs string `form:"product_ids" binding:"required"`
+	productIDs []int32
+}
+
+// Validate parses the comma separated product ids
+func (f *CompareProductsForm) Validate() bool {
+	seen := map[int32]bool{}
+	for _, value := range strings.Split(f.ProductIDs, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(value), 10, 32)
+		if err != nil || id < 1 {
+			f.addError("product_ids", "must be a comma separated list of product ids")
+			return false
+		}
+		if seen[int32(id)] {
+			f.addError("product_ids", "must not repeat products")
+			return false
+		}
+		seen[int32(id)] = true
+		f.productIDs = append(f.productIDs, int32(id))
+	}
+
+	if len(f.productIDs) < minComparedProducts || len(f.productIDs) > maxComparedProducts {
+		f.addError("product_ids", "must have between 2 and 5 products")
+	}
+
+	return f.IsValid()
+}
+
+func (f *CompareProductsForm) GetProductIDs() []int32 {
+	return f.productIDs
+}
+
+// InviteCollaboratorForm invites a customer by id or by email
+type InviteCollaboratorForm struct {
+	BaseForm
+	CustomerID string `json:"customerId" binding:"omitempty,uuid"`
+	Email      string `json:"email" binding:"omitempty,email"`
+	Role       string `json:"role" binding:"required,oneof=viewer editor"`
+}
+
+// Validate requires exactly one of customerId and email
+func (f *InviteCollaboratorForm) Validate() bool {
+	if (f.CustomerID == "") == (f.Email == "") {
+		f.addError("customerId", "inform either the customer id or the email")
+		return false
+	}
+	return true
+}
+
+func (f *InviteCollaboratorForm) ToInvite() models.WishlistInvite {
+	return models.WishlistInvite{
+		CustomerID: f.CustomerID,
+		Email:      f.Email,
+		Role:       f.Role,
+	}
+}
+
+// WishlistOrderForm sets the whole wishlist order
+type WishlistOrderForm struct {
+	ProductIDs []int32 `json:"productIds" binding:"required,min=1,dive,gte=1"`
+}
+
+// MoveWishlistItemForm moves an item to the index, 0 is the top of the list
+type MoveWishlistItemForm struct {
+	Position *int `json:"position" binding:"required,gte=0"`
+}
+
+// WishlistFilterForm filters the wishlist by comma separated tags, matching
+// any of them unless match is all. Tags repeated once normalised count once.
+type WishlistFilterForm struct {
+	Tags  string `form:"tags"`
+	Match string `form:"match" binding:"omitempty,oneof=all any"`
+}
+
+func (f *WishlistFilterForm) GetFilter() models.WishlistFilter {
+	filter := models.WishlistFilter{MatchAll: f.Match == "all"}
+	for _, value := range strings.Split(f.Tags, ",") {
+		if tag := models.NormalizeTag(value); tag != "" && !slices.Contains(filter.Tags, tag) {
+			filter.Tags = append(filter.Tags, tag)
+		}
+	}
+	return filter
+}
+
+// TagsForm attaches tags to a wishlist item
+type TagsForm struct {
+	Tags []string `json:"tags" binding:"required,min=1,max=20,dive,required"`
+}
+
+type WishlistExportForm struct {
+	Format string `form:"format" binding:"omitempty,oneof=csv json"`
+}
+
+func (f *WishlistExportForm) GetFormat() string {
+	if f.Format == "" {
+		return models.WishlistFormatCSV
+	}
+	return f.Format
+}
+
+// WishlistImportForm is sent along the file, the format defaults to the
+// file extension
+type WishlistImportForm struct {
+	Format string `form:"format" binding:"omitempty,oneof=csv json"`
+	DryRun bool   `form:"dry_run"`
+}
+
+func (f *WishlistImportForm) GetFormat(filename string) string {
+	if f.Format != "" {
+		return f.Format
+	}
+	if strings.EqualFold(path.Ext(filename), ".json") {
+		return models.WishlistFormatJSON
+	}
+	return models.WishlistFormatCSV
+}
@@ -0,0 +1,21 @@
+package forms
+
+import "produtos-favoritos/src/domain/models"
+
+// WishlistRuleForm describes a rule, the name comes from the path
+type WishlistRuleForm struct {
+	Description string   `json:"description" binding:"max=255"`
+	Actions     []string `json:"actions" binding:"omitempty,dive,oneof=add remove"`
+	Expression  string   `json:"expression" binding:"required"`
+	Enabled     *bool    `json:"enabled"`
+}
+
+func (f *WishlistRuleForm) ToModel(name string) *models.WishlistRule {
+	return &models.WishlistRule{
+		Name:        name,
+		Description: f.Description,
+		Actions:     f.Actions,
+		Expression:  f.Expression,
+		Enabled:     f.Enabled == nil || *f.Enabled,
+	}
+}
@@ -0,0 +1,29 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"produtos-favoritos/src/infrastructure/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminKeyMiddleware guards the back office routes, which need the admin key
+// on top of the regular api key.
+func AdminKeyMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adminKey := c.GetHeader("X-Admin-Key")
+
+		if adminKey == "" || config.ADMIN_API_KEY == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Missing admin key"})
+			return
+		}
+
+		if subtle.ConstantTimeCompare([]byte(adminKey), []byte(config.ADMIN_API_KEY)) != 1 {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Invalid admin key"})
+			return
+		}
+
+		c.Next()
+	}
+}
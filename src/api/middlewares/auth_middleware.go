@@ -1,6 +1,7 @@
 package middlewares
 
 import (
+	"crypto/subtle"
 	"net/http"
 	"produtos-favoritos/src/infrastructure/config"
 
@@ -16,7 +17,7 @@ func APIKeyMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		if apiKey != config.API_KEY {
+		if subtle.ConstantTimeCompare([]byte(apiKey), []byte(config.API_KEY)) != 1 {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid API key"})
 			return
 		}
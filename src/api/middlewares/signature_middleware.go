@@ -0,0 +1,65 @@
+package middlewares
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"produtos-favoritos/src/infrastructure/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxWebhookBodySize bounds the body read before the signature is checked,
+// catalog events are a few KB at most
+const maxWebhookBodySize = 1 << 20
+
+// SignatureMiddleware authenticates webhook deliveries, X-Signature must be
+// the hex HMAC-SHA256 of the raw body using the shared secret. Bodies over
+// maxWebhookBodySize are refused with a 413.
+func SignatureMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		signature := strings.TrimPrefix(c.GetHeader("X-Signature"), "sha256=")
+
+		if signature == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Missing signature"})
+			return
+		}
+
+		if config.CATALOG_WEBHOOK_SECRET == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Webhook secret not configured"})
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxWebhookBodySize))
+		var tooLargeErr *http.MaxBytesError
+		if errors.As(err, &tooLargeErr) {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Body too large"})
+			return
+		}
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Could not read body"})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		received, err := hex.DecodeString(signature)
+		if err != nil || !hmac.Equal(received, Sign(body, config.CATALOG_WEBHOOK_SECRET)) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid signature"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func Sign(body []byte, secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
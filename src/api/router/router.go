@@ -1,6 +1,8 @@
 package router
 
 import (
+	"expvar"
+
 	_ "produtos-favoritos/src/api/docs" // docs is generated by Swag CLI, you must import it.
 	"produtos-favoritos/src/api/middlewares"
 	handlers "produtos-favoritos/src/domain/interfaces/controllers"
@@ -8,12 +10,33 @@ import (
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.uber.org/dig"
 )
 
-func SetupRouter(router *gin.Engine,
-	customerController handlers.CustomerHandler,
-	productController handlers.ProductHandler,
-	wishlistContoller handlers.WishlistHandler) {
+// Handlers groups every controller exposed by the router, filled by the container
+type Handlers struct {
+	dig.In
+
+	Customer         handlers.CustomerHandler
+	Product          handlers.ProductHandler
+	Wishlist         handlers.WishlistHandler
+	CatalogWebhook   handlers.CatalogWebhookHandler
+	Currency         handlers.CurrencyHandler
+	Cart             handlers.CartHandler
+	Collaboration    handlers.WishlistCollaborationHandler
+	Registry         handlers.RegistryHandler
+	Tag              handlers.TagHandler
+	Trash            handlers.WishlistTrashHandler
+	Reminder         handlers.ReminderHandler
+	WishlistRule     handlers.WishlistRuleHandler
+	FavoriteCategory handlers.FavoriteCategoryHandler
+	RecentlyViewed   handlers.RecentlyViewedHandler
+	CustomerExport   handlers.CustomerExportHandler
+	ImportJob        handlers.ImportJobHandler
+	ChangeFeed       handlers.ChangeFeedHandler
+}
+
+func SetupRouter(router *gin.Engine, h Handlers) {
 	// Define routes
 	baseApiRoute := router.Group("api")
 	{
@@ -22,22 +45,113 @@ func SetupRouter(router *gin.Engine,
 		{
 			customerGroup := v1Group.Group("/customers")
 			{
-				customerGroup.POST("/", customerController.Create)
-				customerGroup.GET("/", customerController.List)
-				customerGroup.GET("/:id", customerController.GetByID)
-				customerGroup.PUT("/:id", customerController.Update)
-				customerGroup.DELETE("/:id", customerController.Delete)
-
-				customerGroup.POST("/:id/wishlist", wishlistContoller.WishlistProduct)
-				customerGroup.DELETE("/:id/wishlist/:product_id", wishlistContoller.RemoveFromWishlist)
+				customerGroup.POST("/", h.Customer.Create)
+				customerGroup.GET("/", h.Customer.List)
+				customerGroup.GET("/:id", h.Customer.GetByID)
+				customerGroup.PUT("/:id", h.Customer.Update)
+				customerGroup.DELETE("/:id", h.Customer.Delete)
+				customerGroup.POST("/import", h.ImportJob.ImportCustomers)
+
+				customerGroup.GET("/:id/wishlist", h.Wishlist.List)
+				customerGroup.GET("/:id/wishlist/summary", h.Wishlist.Summary)
+				customerGroup.GET("/:id/wishlist/compare", h.Wishlist.Compare)
+				customerGroup.POST("/:id/wishlist", h.Wishlist.WishlistProduct)
+				customerGroup.PUT("/:id/wishlist/order", h.Wishlist.Reorder)
+				customerGroup.GET("/:id/wishlist/export", h.Wishlist.Export)
+				customerGroup.POST("/:id/wishlist/import", h.Wishlist.Import)
+				customerGroup.GET("/:id/wishlist/trash", h.Trash.List)
+				customerGroup.DELETE("/:id/wishlist/trash", h.Trash.Empty)
+				customerGroup.POST("/:id/wishlist/trash/:product_id/restore", h.Trash.Restore)
+				customerGroup.PUT("/:id/wishlist/:product_id/position", h.Wishlist.MoveItem)
+				customerGroup.DELETE("/:id/wishlist/:product_id", h.Wishlist.RemoveFromWishlist)
+				customerGroup.POST("/:id/wishlist/:product_id/tags", h.Tag.Attach)
+				customerGroup.DELETE("/:id/wishlist/:product_id/tags/:tag", h.Tag.Detach)
+
+				customerGroup.GET("/:id/tags", h.Tag.List)
+				customerGroup.DELETE("/:id/tags/:tag", h.Tag.Delete)
+
+				customerGroup.GET("/:id/favorite-categories", h.FavoriteCategory.List)
+				customerGroup.POST("/:id/favorite-categories", h.FavoriteCategory.Follow)
+				customerGroup.GET("/:id/favorite-categories/new", h.FavoriteCategory.ListNew)
+				customerGroup.POST("/:id/favorite-categories/new/ack", h.FavoriteCategory.AcknowledgeNew)
+				customerGroup.DELETE("/:id/favorite-categories/:category", h.FavoriteCategory.Unfollow)
+
+				customerGroup.GET("/:id/recently-viewed", h.RecentlyViewed.List)
+				customerGroup.POST("/:id/recently-viewed", h.RecentlyViewed.RecordView)
+
+				customerGroup.GET("/:id/reminders", h.Reminder.List)
+				customerGroup.GET("/:id/reminders/preferences", h.Reminder.GetPreferences)
+				customerGroup.PUT("/:id/reminders/preferences", h.Reminder.UpdatePreferences)
+
+				customerGroup.GET("/:id/wishlist/collaborators", h.Collaboration.ListCollaborators)
+				customerGroup.POST("/:id/wishlist/collaborators", h.Collaboration.Invite)
+				customerGroup.DELETE("/:id/wishlist/collaborators/:collaborator_id", h.Collaboration.RemoveCollaborator)
+				customerGroup.GET("/:id/wishlist-invitations", h.Collaboration.ListInvitations)
+				customerGroup.POST("/:id/wishlist-invitations/:owner_id/accept", h.Collaboration.AcceptInvitation)
+				customerGroup.POST("/:id/wishlist-invitations/:owner_id/decline", h.Collaboration.DeclineInvitation)
+
+				customerGroup.GET("/:id/cart", h.Cart.Get)
+				customerGroup.POST("/:id/cart/items", h.Cart.AddItem)
+				customerGroup.PUT("/:id/cart/items/:product_id", h.Cart.UpdateItem)
+				customerGroup.DELETE("/:id/cart/items/:product_id", h.Cart.RemoveItem)
+				customerGroup.POST("/:id/cart/move-from-wishlist", h.Cart.MoveFromWishlist)
+
+				customerGroup.GET("/:id/registry", h.Registry.Get)
+				customerGroup.PUT("/:id/registry", h.Registry.Enable)
+				customerGroup.DELETE("/:id/registry", h.Registry.Disable)
+				customerGroup.PUT("/:id/registry/items/:product_id", h.Registry.SetItemQuantity)
 			}
 			productGroup := v1Group.Group("/products")
 			{
-				productGroup.GET("/", productController.List)
+				productGroup.GET("/", h.Product.List)
+				productGroup.GET("/categories", h.Product.Categories)
+				productGroup.GET("/search", h.Product.Search)
+				productGroup.GET("/changes", h.Product.Changes)
+				productGroup.GET("/:id", h.Product.GetByID)
+			}
+			// Guests open a gift registry with the token shared by its owner
+			registryGroup := v1Group.Group("/registries")
+			{
+				registryGroup.GET("/:token", h.Registry.GuestView)
+				registryGroup.POST("/:token/reservations", h.Registry.Reserve)
+				registryGroup.POST("/:token/reservations/:reservation_id/confirm", h.Registry.ConfirmReservation)
+				registryGroup.DELETE("/:token/reservations/:reservation_id", h.Registry.CancelReservation)
+			}
+			currencyGroup := v1Group.Group("/currencies")
+			{
+				currencyGroup.GET("/rates", h.Currency.ListRates)
+			}
+			v1Group.GET("/changes", h.ChangeFeed.List)
+			jobGroup := v1Group.Group("/jobs")
+			{
+				jobGroup.GET("/:id", h.ImportJob.Get)
+				jobGroup.GET("/:id/errors", h.ImportJob.ErrorReport)
+			}
+			adminGroup := v1Group.Group("/admin")
+			{
+				adminGroup.Use(middlewares.AdminKeyMiddleware())
+				adminGroup.PUT("/currencies/rates/:currency", h.Currency.SetRate)
+				adminGroup.DELETE("/currencies/rates/:currency", h.Currency.DeleteRate)
+				adminGroup.POST("/currencies/rates/import", h.Currency.ImportRates)
+				adminGroup.GET("/reminders/pending", h.Reminder.ListPending)
+				adminGroup.POST("/reminders/:reminder_id/delivered", h.Reminder.MarkDelivered)
+				adminGroup.GET("/wishlist-rules", h.WishlistRule.List)
+				adminGroup.PUT("/wishlist-rules/:name", h.WishlistRule.Save)
+				adminGroup.DELETE("/wishlist-rules/:name", h.WishlistRule.Delete)
+				adminGroup.GET("/exports/customers", h.CustomerExport.Export)
+				// Runtime metrics as json, like the dropped product views
+				adminGroup.GET("/metrics", gin.WrapH(expvar.Handler()))
 			}
 		}
 	}
 
+	// Inbound webhooks are signed by the sender instead of using the api key
+	webhookGroup := router.Group("webhooks")
+	{
+		webhookGroup.Use(middlewares.SignatureMiddleware())
+		webhookGroup.POST("/catalog", h.CatalogWebhook.Receive)
+	}
+
 	// Swagger endpoint
 	router.GET("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 }
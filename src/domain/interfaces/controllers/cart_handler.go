@@ -0,0 +1,11 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type CartHandler interface {
+	Get(c *gin.Context)
+	AddItem(c *gin.Context)
+	UpdateItem(c *gin.Context)
+	RemoveItem(c *gin.Context)
+	MoveFromWishlist(c *gin.Context)
+}
@@ -0,0 +1,7 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type CatalogWebhookHandler interface {
+	Receive(c *gin.Context)
+}
@@ -0,0 +1,10 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type CurrencyHandler interface {
+	ListRates(c *gin.Context)
+	SetRate(c *gin.Context)
+	DeleteRate(c *gin.Context)
+	ImportRates(c *gin.Context)
+}
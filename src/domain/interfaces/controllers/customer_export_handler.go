@@ -0,0 +1,7 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type CustomerExportHandler interface {
+	Export(c *gin.Context)
+}
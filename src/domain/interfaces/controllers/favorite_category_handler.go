@@ -0,0 +1,11 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type FavoriteCategoryHandler interface {
+	List(c *gin.Context)
+	Follow(c *gin.Context)
+	Unfollow(c *gin.Context)
+	ListNew(c *gin.Context)
+	AcknowledgeNew(c *gin.Context)
+}
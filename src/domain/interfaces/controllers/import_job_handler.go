@@ -0,0 +1,9 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type ImportJobHandler interface {
+	ImportCustomers(c *gin.Context)
+	Get(c *gin.Context)
+	ErrorReport(c *gin.Context)
+}
@@ -4,4 +4,8 @@ import "github.com/gin-gonic/gin"
 
 type ProductHandler interface {
 	List(c *gin.Context)
+	GetByID(c *gin.Context)
+	Categories(c *gin.Context)
+	Search(c *gin.Context)
+	Changes(c *gin.Context)
 }
@@ -0,0 +1,8 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type RecentlyViewedHandler interface {
+	RecordView(c *gin.Context)
+	List(c *gin.Context)
+}
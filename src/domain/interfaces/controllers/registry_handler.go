@@ -0,0 +1,14 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type RegistryHandler interface {
+	Get(c *gin.Context)
+	Enable(c *gin.Context)
+	Disable(c *gin.Context)
+	SetItemQuantity(c *gin.Context)
+	GuestView(c *gin.Context)
+	Reserve(c *gin.Context)
+	ConfirmReservation(c *gin.Context)
+	CancelReservation(c *gin.Context)
+}
@@ -0,0 +1,11 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type ReminderHandler interface {
+	List(c *gin.Context)
+	GetPreferences(c *gin.Context)
+	UpdatePreferences(c *gin.Context)
+	ListPending(c *gin.Context)
+	MarkDelivered(c *gin.Context)
+}
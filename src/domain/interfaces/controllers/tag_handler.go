@@ -0,0 +1,10 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type TagHandler interface {
+	List(c *gin.Context)
+	Delete(c *gin.Context)
+	Attach(c *gin.Context)
+	Detach(c *gin.Context)
+}
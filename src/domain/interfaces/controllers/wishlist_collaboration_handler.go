@@ -0,0 +1,12 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type WishlistCollaborationHandler interface {
+	Invite(c *gin.Context)
+	ListCollaborators(c *gin.Context)
+	RemoveCollaborator(c *gin.Context)
+	ListInvitations(c *gin.Context)
+	AcceptInvitation(c *gin.Context)
+	DeclineInvitation(c *gin.Context)
+}
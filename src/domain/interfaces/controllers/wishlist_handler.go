@@ -5,4 +5,11 @@ import "github.com/gin-gonic/gin"
 type WishlistHandler interface {
 	WishlistProduct(c *gin.Context)
 	RemoveFromWishlist(c *gin.Context)
+	List(c *gin.Context)
+	Summary(c *gin.Context)
+	Compare(c *gin.Context)
+	Reorder(c *gin.Context)
+	MoveItem(c *gin.Context)
+	Export(c *gin.Context)
+	Import(c *gin.Context)
 }
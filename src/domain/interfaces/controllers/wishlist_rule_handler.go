@@ -0,0 +1,9 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type WishlistRuleHandler interface {
+	List(c *gin.Context)
+	Save(c *gin.Context)
+	Delete(c *gin.Context)
+}
@@ -0,0 +1,9 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type WishlistTrashHandler interface {
+	List(c *gin.Context)
+	Restore(c *gin.Context)
+	Empty(c *gin.Context)
+}
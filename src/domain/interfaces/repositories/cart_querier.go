@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type CartQuerier interface {
+	ListByCustomer(customerID string) ([]models.CartItem, error)
+	Get(customerID string, productID int32) (*models.CartItem, error)
+	Add(item *models.CartItem) (bool, error)
+	UpdateQuantity(customerID string, productID int32, quantity int) error
+	Remove(customerID string, productID int32) error
+	MoveFromWishlist(customerID string, items []models.CartItem, keepInWishlist bool, trashExpiresAt time.Time) ([]int32, error)
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type CatalogEventQuerier interface {
+	Exists(eventID string) (bool, error)
+	LastOccurredAt(productID int32) (time.Time, error)
+	Record(event *models.CatalogEvent, product *models.Product, changes []models.ProductChange) (bool, error)
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type ChangeEventQuerier interface {
+	// ListAfter returns the changes after the cursor in feed order, leaving
+	// out those of transactions started after a still running one
+	ListAfter(cursor models.ChangeCursor, limit int) ([]models.ChangeEvent, error)
+	// PrunedThrough is the position of the last change removed by Prune,
+	// zero when nothing was removed
+	PrunedThrough() (models.ChangeCursor, error)
+	Prune(before time.Time) (int64, error)
+}
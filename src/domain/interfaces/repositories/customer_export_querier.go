@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type CustomerExportQuerier interface {
+	StreamCustomers(updatedSince *time.Time, batchSize int, fn func(rows []models.CustomerExportRow) error) error
+}
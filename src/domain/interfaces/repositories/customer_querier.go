@@ -7,6 +7,7 @@ import (
 type CustomerQuerier interface {
 	Create(customer *models.Customer) error
 	GetByID(id string) (*models.Customer, error)
+	Exists(id string) (bool, error)
 	Update(customer *models.Customer) (*models.Customer, error)
 	Delete(id string) error
 	List() ([]models.Customer, error)
@@ -0,0 +1,11 @@
+package repositories
+
+import "produtos-favoritos/src/domain/models"
+
+type ExchangeRateQuerier interface {
+	List() ([]models.ExchangeRate, error)
+	Get(currency string) (*models.ExchangeRate, error)
+	Upsert(rate *models.ExchangeRate) error
+	UpsertMany(rates []models.ExchangeRate) error
+	Delete(currency string) error
+}
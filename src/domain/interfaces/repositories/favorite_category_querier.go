@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type FavoriteCategoryQuerier interface {
+	List(customerID string) ([]models.FavoriteCategory, error)
+	Add(favorite *models.FavoriteCategory) error
+	Delete(customerID string, category string) (bool, error)
+	ListNewProducts(customerID string, until time.Time) ([]models.Product, error)
+	MarkChecked(customerID string, checkedAt time.Time) error
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type ImportJobQuerier interface {
+	Create(job *models.ImportJob) error
+	GetByID(id string) (*models.ImportJob, error)
+	// ClaimNext marks the oldest pending job, or a running job without
+	// progress since staleBefore, as running under a new lease and returns
+	// it with the file
+	ClaimNext(staleBefore time.Time) (*models.ImportJob, error)
+	// SaveProgress returns false, saving nothing, when the job lease was
+	// taken by another worker
+	SaveProgress(job *models.ImportJob, errors []models.ImportJobError) (bool, error)
+	ListErrors(jobID string) ([]models.ImportJobError, error)
+}
@@ -0,0 +1,7 @@
+package repositories
+
+type LockQuerier interface {
+	// TryWithLock runs fn only when no other instance holds the named lock,
+	// reporting whether it ran.
+	TryWithLock(name string, fn func() error) (bool, error)
+}
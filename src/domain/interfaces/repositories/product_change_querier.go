@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type ProductChangeQuerier interface {
+	ListSince(since time.Time, limit int, offset int) ([]models.ProductChange, error)
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import "produtos-favoritos/src/domain/models"
+
+type ProductQuerier interface {
+	GetByID(id int32) (*models.Product, error)
+	ListAll() ([]models.Product, error)
+	Upsert(product *models.Product) error
+	// AddPlaceholder stores a placeholder product unless the product is known
+	AddPlaceholder(id int32) error
+	UpsertMany(products []models.Product) error
+	SyncCatalog(products []models.Product, removedIDs []int32, changes []models.ProductChange) error
+	Search(search models.ProductSearch) ([]models.ProductSearchResult, []models.CategoryFacet, error)
+}
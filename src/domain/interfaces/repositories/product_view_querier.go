@@ -0,0 +1,8 @@
+package repositories
+
+import "produtos-favoritos/src/domain/models"
+
+type ProductViewQuerier interface {
+	RecordViews(views []models.ProductView, maxPerCustomer int) (int, error)
+	ListByCustomer(customerID string, limit int) ([]models.ProductView, error)
+}
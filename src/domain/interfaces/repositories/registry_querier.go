@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type RegistryQuerier interface {
+	GetByCustomer(customerID string) (*models.WishlistRegistry, error)
+	GetByToken(token string) (*models.WishlistRegistry, error)
+	Save(registry *models.WishlistRegistry) error
+	Delete(customerID string) error
+	ReservedQuantities(customerID string, now time.Time) (map[int32]int, error)
+	Reserve(reservation *models.RegistryReservation, now time.Time) (bool, error)
+	GetReservation(customerID string, reservationID string) (*models.RegistryReservation, error)
+	SaveReservation(reservation *models.RegistryReservation) error
+	DeleteReservation(reservationID string) error
+	DeleteExpiredReservations(now time.Time) (int64, error)
+}
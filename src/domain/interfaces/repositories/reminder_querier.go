@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type ReminderQuerier interface {
+	// CreateStaleReminders reminds about the confirmed items added before
+	// addedBefore that were not reminded yet, skipping the customers who
+	// opted out. It returns how many reminders were created.
+	CreateStaleReminders(addedBefore time.Time, limit int) (int64, error)
+	ListByCustomer(customerID string) ([]models.WishlistReminder, error)
+	ListPending(limit int) ([]models.WishlistReminder, error)
+	MarkDelivered(reminderID string, deliveredAt time.Time) (bool, error)
+	GetPreference(customerID string) (*models.ReminderPreference, error)
+	SavePreference(preference *models.ReminderPreference) error
+}
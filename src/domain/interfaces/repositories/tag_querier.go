@@ -0,0 +1,12 @@
+package repositories
+
+import "produtos-favoritos/src/domain/models"
+
+type TagQuerier interface {
+	ListUsage(customerID string) ([]models.TagUsage, error)
+	Attach(customerID string, productID int32, names []string) error
+	Detach(customerID string, productID int32, name string) (bool, error)
+	Delete(customerID string, name string) (bool, error)
+	TagsByProduct(customerID string) (map[int32][]string, error)
+	ProductIDsByTags(customerID string, names []string, matchAll bool) ([]int32, error)
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import "produtos-favoritos/src/domain/models"
+
+type WishlistCollaboratorQuerier interface {
+	Get(ownerID string, collaboratorID string) (*models.WishlistCollaborator, error)
+	ListByOwner(ownerID string) ([]models.WishlistCollaborator, error)
+	ListByCollaborator(collaboratorID string) ([]models.WishlistCollaborator, error)
+	Save(collaborator *models.WishlistCollaborator) error
+	Remove(ownerID string, collaboratorID string) error
+}
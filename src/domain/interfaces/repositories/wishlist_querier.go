@@ -0,0 +1,15 @@
+package repositories
+
+import "produtos-favoritos/src/domain/models"
+
+type WishlistQuerier interface {
+	Add(item *models.WishlistItem) error
+	ListByCustomer(customerID string) ([]models.WishlistItem, error)
+	ListByStatus(status string, limit int) ([]models.WishlistItem, error)
+	UpdateStatus(customerID string, productID int32, status string) error
+	UpdateAddedPrice(customerID string, productID int32, price models.Money) error
+	UpdateQuantity(customerID string, productID int32, quantity int) error
+	UpdatePositions(customerID string, positions map[int32]string) error
+	Remove(customerID string, productID int32) error
+	ListMemberships(customerID string, productIDs []int32) (map[int32][]string, error)
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import "produtos-favoritos/src/domain/models"
+
+type WishlistRuleQuerier interface {
+	List() ([]models.WishlistRule, error)
+	ListEnabled() ([]models.WishlistRule, error)
+	// Save creates the rule or replaces the one with the same name
+	Save(rule *models.WishlistRule) error
+	Delete(name string) (bool, error)
+}
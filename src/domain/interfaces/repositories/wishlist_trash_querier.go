@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/google/uuid"
+)
+
+type WishlistTrashQuerier interface {
+	// Trash moves the wishlist item to the trash until expiresAt
+	Trash(customerID string, productID int32, removedBy *uuid.UUID, expiresAt time.Time) error
+	Get(customerID string, productID int32, now time.Time) (*models.TrashedWishlistItem, error)
+	ListByCustomer(customerID string, now time.Time) ([]models.TrashedWishlistItem, error)
+	// Restore puts the entry back in the wishlist with its tags
+	Restore(entry *models.TrashedWishlistItem) error
+	Empty(customerID string) (int64, error)
+	DeleteExpired(now time.Time) (int64, error)
+}
@@ -0,0 +1,11 @@
+package services
+
+import "produtos-favoritos/src/domain/models"
+
+type CartServicer interface {
+	GetCart(customerID string) (*models.Cart, error)
+	AddItem(customerID string, productID int32, quantity int) (*models.Cart, error)
+	UpdateItem(customerID string, productID int32, quantity int) (*models.Cart, error)
+	RemoveItem(customerID string, productID int32) error
+	MoveFromWishlist(customerID string, productID *int32, keepInWishlist bool) (*models.MoveToCartResult, error)
+}
@@ -0,0 +1,7 @@
+package services
+
+import "produtos-favoritos/src/domain/models"
+
+type CatalogEventServicer interface {
+	Process(event *models.CatalogEvent) (bool, error)
+}
@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type ChangeFeedServicer interface {
+	ListChanges(ctx context.Context, cursor string, limit int, wait time.Duration) (*models.ChangeFeedPage, error)
+}
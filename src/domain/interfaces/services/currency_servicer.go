@@ -0,0 +1,15 @@
+package services
+
+import (
+	"io"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type CurrencyServicer interface {
+	ListRates() ([]models.ExchangeRate, error)
+	SetRate(rate *models.ExchangeRate) error
+	DeleteRate(currency string) error
+	ImportRates(file io.Reader) (int, error)
+	ConvertProducts(currency string, products ...*models.Product) error
+}
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"io"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type CustomerExportServicer interface {
+	ExportCustomers(w io.Writer, options models.CustomerExportOptions) (int, error)
+}
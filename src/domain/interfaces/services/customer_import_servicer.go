@@ -0,0 +1,18 @@
+package services
+
+import (
+	"io"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+// CustomerValidator checks a customer with the rules of the create
+// customer request
+type CustomerValidator func(customer *models.Customer) error
+
+type CustomerImportServicer interface {
+	ImportCustomers(filename string, file io.Reader) (*models.ImportJob, error)
+	GetJob(id string) (*models.ImportJob, error)
+	ListJobErrors(id string) ([]models.ImportJobError, error)
+	ProcessPendingImports() error
+}
@@ -3,4 +3,6 @@ package services
 type FakeProductApiClientServicer interface {
 	ListProducts() ([]byte, error)
 	GetProduct(productID int32) ([]byte, error)
+	QueryProducts(category string, limit int, sort string) ([]byte, error)
+	ListCategories() ([]byte, error)
 }
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type FavoriteCategoryServicer interface {
+	ListCategories(customerID string) ([]models.FavoriteCategory, error)
+	FollowCategory(customerID string, category string) (*models.FavoriteCategory, error)
+	UnfollowCategory(customerID string, category string) error
+	ListNewProducts(customerID string) (*models.NewInFavoriteCategories, error)
+	AcknowledgeNewProducts(customerID string, cursor time.Time) error
+}
@@ -0,0 +1,10 @@
+package services
+
+import "time"
+
+// Job is a background task run periodically by the scheduler
+type Job interface {
+	Name() string
+	Interval() time.Duration
+	Run() error
+}
@@ -0,0 +1,11 @@
+package services
+
+import "produtos-favoritos/src/domain/models"
+
+type PolicyServicer interface {
+	// Check returns a PolicyViolationError when the mutation breaks a rule
+	Check(input models.PolicyInput) error
+	ListRules() ([]models.WishlistRule, error)
+	SaveRule(rule *models.WishlistRule) (*models.WishlistRule, error)
+	DeleteRule(name string) error
+}
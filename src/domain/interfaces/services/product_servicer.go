@@ -1,8 +1,16 @@
 package services
 
-import "produtos-favoritos/src/domain/models"
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
 
 type ProductServicer interface {
 	GetProducts() ([]models.Product, error)
 	GetProductByID(productID int32) (*models.Product, error)
+	ListProducts(filter models.ProductFilter) (*models.ProductPage, error)
+	GetCategories() ([]string, error)
+	SearchProducts(search models.ProductSearch) (*models.ProductSearchPage, error)
+	GetProductChanges(since time.Time, limit int, offset int) (*models.ProductChangePage, error)
 }
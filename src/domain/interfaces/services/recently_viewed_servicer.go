@@ -0,0 +1,13 @@
+package services
+
+import (
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+)
+
+type RecentlyViewedServicer interface {
+	RecordView(customerID string, productID int32, viewedAt time.Time) error
+	ListRecentlyViewed(customerID string, limit int) ([]models.ProductView, error)
+	Flush() error
+}
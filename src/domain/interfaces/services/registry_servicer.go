@@ -0,0 +1,14 @@
+package services
+
+import "produtos-favoritos/src/domain/models"
+
+type RegistryServicer interface {
+	EnableRegistry(customerID string, title string) (*models.WishlistRegistry, error)
+	DisableRegistry(customerID string) error
+	GetOwnerRegistry(customerID string) (*models.Registry, error)
+	SetItemQuantity(customerID string, productID int32, quantity int) error
+	GetGuestRegistry(token string) (*models.Registry, error)
+	Reserve(token string, request models.ReservationRequest) (*models.RegistryReservation, error)
+	ConfirmReservation(token string, reservationID string) (*models.RegistryReservation, error)
+	CancelReservation(token string, reservationID string) error
+}
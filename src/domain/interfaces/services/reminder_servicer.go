@@ -0,0 +1,11 @@
+package services
+
+import "produtos-favoritos/src/domain/models"
+
+type ReminderServicer interface {
+	ListReminders(customerID string) ([]models.WishlistReminder, error)
+	GetPreference(customerID string) (*models.ReminderPreference, error)
+	UpdatePreference(customerID string, staleItemsOptOut bool) (*models.ReminderPreference, error)
+	ListPending(limit int) ([]models.WishlistReminder, error)
+	MarkDelivered(reminderID string) error
+}
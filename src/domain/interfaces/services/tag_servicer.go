@@ -0,0 +1,10 @@
+package services
+
+import "produtos-favoritos/src/domain/models"
+
+type TagServicer interface {
+	ListTags(customerID string) ([]models.TagUsage, error)
+	AttachTags(customerID string, productID int32, names []string) ([]string, error)
+	DetachTag(customerID string, productID int32, name string) error
+	DeleteTag(customerID string, name string) error
+}
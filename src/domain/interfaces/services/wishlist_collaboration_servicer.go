@@ -0,0 +1,11 @@
+package services
+
+import "produtos-favoritos/src/domain/models"
+
+type WishlistCollaborationServicer interface {
+	Invite(ownerID string, actorID string, invite models.WishlistInvite) (*models.WishlistCollaborator, error)
+	ListCollaborators(ownerID string) ([]models.WishlistCollaborator, error)
+	RemoveCollaborator(ownerID string, actorID string, collaboratorID string) error
+	ListInvitations(customerID string) ([]models.WishlistCollaborator, error)
+	RespondInvitation(customerID string, actorID string, ownerID string, accept bool) (*models.WishlistCollaborator, error)
+}
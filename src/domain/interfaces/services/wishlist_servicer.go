@@ -1,6 +1,20 @@
 package services
 
+import (
+	"io"
+
+	"produtos-favoritos/src/domain/models"
+)
+
 type WishlistServicer interface {
-	WishlistProduct(productID int32, customerID string) error
-	RemoveProductFromWishlist(customerID string, productID int32) error
+	WishlistProduct(productID int32, customerID string, actorID string, partnerKey string) (*models.WishlistItem, error)
+	RemoveProductFromWishlist(customerID string, productID int32, actorID string, partnerKey string) error
+	GetWishlist(customerID string, actorID string, filter models.WishlistFilter) (*models.Wishlist, error)
+	GetWishlistSummary(customerID string, actorID string) (*models.WishlistSummary, error)
+	CompareProducts(customerID string, actorID string, productIDs []int32) (*models.ProductComparison, error)
+	MoveItem(customerID string, productID int32, position int, actorID string) (*models.Wishlist, error)
+	ReorderItems(customerID string, productIDs []int32, actorID string) (*models.Wishlist, error)
+	MarkWishlisted(customerID string, products ...*models.Product) error
+	ExportWishlist(customerID string, actorID string) ([]models.WishlistExportItem, error)
+	ImportWishlist(customerID string, actorID string, format string, file io.Reader, dryRun bool, partnerKey string) (*models.WishlistImportReport, error)
 }
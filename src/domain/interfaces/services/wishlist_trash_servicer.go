@@ -0,0 +1,9 @@
+package services
+
+import "produtos-favoritos/src/domain/models"
+
+type WishlistTrashServicer interface {
+	ListTrash(customerID string, actorID string) ([]models.TrashedWishlistItem, error)
+	RestoreItem(customerID string, productID int32, actorID string, partnerKey string) (*models.WishlistItem, error)
+	EmptyTrash(customerID string, actorID string) error
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxCartItemQuantity caps the units of a single product in the cart
+const MaxCartItemQuantity = 99
+
+// CartItem is a product in the customer cart with the unit price it had when
+// it was put in the cart.
+type CartItem struct {
+	CustomerID uuid.UUID `json:"-" gorm:"type:uuid;primaryKey"`
+	ProductID  int32     `json:"product_id" gorm:"primaryKey"`
+	Quantity   int       `json:"quantity" gorm:"not null"`
+	UnitPrice  Money     `json:"unit_price" gorm:"embedded;embeddedPrefix:unit_price_" swaggertype:"number"`
+	CreatedAt  time.Time `json:"added_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	Product    *Product  `json:"product" gorm:"foreignKey:ProductID"`
+}
+
+func (CartItem) TableName() string {
+	return "cart_items"
+}
+
+// Subtotal is the unit price times the quantity
+func (i CartItem) Subtotal() Money {
+	return NewMoney(i.UnitPrice.Amount*int64(i.Quantity), i.UnitPrice.Currency)
+}
+
+type Cart struct {
+	CustomerID uuid.UUID  `json:"customer_id"`
+	Items      []CartItem `json:"items"`
+	// ItemCount sums the quantities of the items
+	ItemCount int   `json:"item_count"`
+	Total     Money `json:"total" swaggertype:"number"`
+}
+
+// MoveToCartResult reports which wishlist items went to the cart, products
+// that are pending validation or out of the catalog stay in the wishlist.
+type MoveToCartResult struct {
+	Cart    *Cart   `json:"cart"`
+	Moved   []int32 `json:"moved"`
+	Skipped []int32 `json:"skipped"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+const (
+	CatalogEventProductUpdated      = "product.updated"
+	CatalogEventProductDeleted      = "product.deleted"
+	CatalogEventProductPriceChanged = "product.price_changed"
+)
+
+// CatalogEvent is a change notification pushed by the catalog team. Processed
+// events are logged by their delivery id so redeliveries are ignored.
+type CatalogEvent struct {
+	ID        string `json:"event_id" gorm:"primaryKey;size:100"`
+	Type      string `json:"type" gorm:"size:50;not null"`
+	ProductID int32  `json:"product_id" gorm:"not null;index"`
+	Price     *Money `json:"price,omitempty" gorm:"-" swaggertype:"number"`
+	// OccurredAt is when the catalog made the change. Deliveries can arrive
+	// out of order, an event older than the last one applied to the product
+	// is logged without changing it.
+	OccurredAt  time.Time `json:"occurred_at" gorm:"not null"`
+	ProcessedAt time.Time `json:"processed_at" gorm:"autoCreateTime"`
+}
@@ -0,0 +1,104 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ChangeEntityCustomer     = "customer"
+	ChangeEntityWishlistItem = "wishlist_item"
+
+	ChangeCreated = "created"
+	ChangeUpdated = "updated"
+	ChangeDeleted = "deleted"
+)
+
+// ChangeEvent is an entry of the change feed, written by database triggers
+// on customers and wishlists. The feed is ordered by the writing transaction
+// and then by ID, see ChangeCursor. Data is the row after the change with
+// the table columns, deletions have no data.
+type ChangeEvent struct {
+	ID         uint64          `json:"-" gorm:"primaryKey;index:idx_change_events_position,priority:2"`
+	TxID       int64           `json:"-" gorm:"not null;index:idx_change_events_position,priority:1"`
+	Cursor     string          `json:"cursor" gorm:"-"`
+	Entity     string          `json:"entity" gorm:"size:20;not null"`
+	Action     string          `json:"action" gorm:"size:20;not null"`
+	CustomerID uuid.UUID       `json:"customer_id" gorm:"type:uuid;not null"`
+	ProductID  *int32          `json:"product_id,omitempty"`
+	Data       json.RawMessage `json:"data" gorm:"type:jsonb" swaggertype:"object"`
+	ChangedAt  time.Time       `json:"changed_at" gorm:"not null;index"`
+}
+
+type ChangeFeedPage struct {
+	Changes []ChangeEvent `json:"changes"`
+	// NextCursor is the position after the last change, to send in the next
+	// request. It is the request cursor when there were no changes.
+	NextCursor string `json:"next_cursor"`
+	HasMore    bool   `json:"has_more"`
+}
+
+// ChangeFeedState keeps the position of the last change removed by the
+// retention, cursors before it may have missed changes
+type ChangeFeedState struct {
+	ID         int    `gorm:"primaryKey"`
+	PrunedTxID int64  `gorm:"not null"`
+	PrunedID   uint64 `gorm:"not null"`
+}
+
+func (ChangeFeedState) TableName() string {
+	return "change_feed_state"
+}
+
+// ChangeCursor is a position in the change feed. Changes are served only
+// once every older transaction finished, so a transaction committing late
+// can't land behind a cursor already handed out. The zero cursor is the
+// start of the feed.
+type ChangeCursor struct {
+	TxID int64
+	ID   uint64
+}
+
+func (c ChangeCursor) IsZero() bool {
+	return c.TxID == 0 && c.ID == 0
+}
+
+func (c ChangeCursor) Before(other ChangeCursor) bool {
+	return c.TxID < other.TxID || (c.TxID == other.TxID && c.ID < other.ID)
+}
+
+func (c ChangeCursor) String() string {
+	if c.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%d-%d", c.TxID, c.ID)
+}
+
+func (e *ChangeEvent) Position() ChangeCursor {
+	return ChangeCursor{TxID: e.TxID, ID: e.ID}
+}
+
+// ParseChangeCursor reads a cursor written by String, empty is the start
+func ParseChangeCursor(value string) (ChangeCursor, error) {
+	if value == "" {
+		return ChangeCursor{}, nil
+	}
+	txPart, idPart, found := strings.Cut(value, "-")
+	if !found {
+		return ChangeCursor{}, fmt.Errorf("invalid cursor %q", value)
+	}
+	txID, err := strconv.ParseInt(txPart, 10, 64)
+	if err != nil || txID <= 0 {
+		return ChangeCursor{}, fmt.Errorf("invalid cursor %q", value)
+	}
+	id, err := strconv.ParseUint(idPart, 10, 64)
+	if err != nil {
+		return ChangeCursor{}, fmt.Errorf("invalid cursor %q", value)
+	}
+	return ChangeCursor{TxID: txID, ID: id}, nil
+}
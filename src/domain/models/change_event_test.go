@@ -0,0 +1,33 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseChangeCursor(t *testing.T) {
+	cursor, err := ParseChangeCursor("1042-7")
+	assert.NoError(t, err)
+	assert.Equal(t, ChangeCursor{TxID: 1042, ID: 7}, cursor)
+	assert.Equal(t, "1042-7", cursor.String())
+
+	cursor, err = ParseChangeCursor("")
+	assert.NoError(t, err)
+	assert.True(t, cursor.IsZero())
+	assert.Equal(t, "", cursor.String())
+
+	for _, value := range []string{"1042", "abc-1", "0-1", "-1-2", "1-x", "1-2-3"} {
+		_, err := ParseChangeCursor(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestChangeCursorBefore(t *testing.T) {
+	// The transaction orders the feed before the ID
+	assert.True(t, ChangeCursor{TxID: 10, ID: 50}.Before(ChangeCursor{TxID: 11, ID: 2}))
+	assert.True(t, ChangeCursor{TxID: 10, ID: 1}.Before(ChangeCursor{TxID: 10, ID: 2}))
+	assert.False(t, ChangeCursor{TxID: 10, ID: 2}.Before(ChangeCursor{TxID: 10, ID: 2}))
+	assert.False(t, ChangeCursor{TxID: 11, ID: 1}.Before(ChangeCursor{TxID: 10, ID: 9}))
+	assert.True(t, ChangeCursor{}.Before(ChangeCursor{TxID: 1, ID: 1}))
+}
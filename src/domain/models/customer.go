@@ -1,8 +1,13 @@
 package models
 
+import "github.com/google/uuid"
+
 type Customer struct {
 	BaseModel
 	Name     string     `json:"name"`
 	Email    string     `json:"email" gorm:"uniqueIndex"`
 	Wishlist []*Product `json:"wishlist" gorm:"many2many:wishlists;constraint:OnDelete:CASCADE;"`
+	// ImportJobID is the import that created the customer, a job taken over
+	// after a crash recognises the customers it already created
+	ImportJobID *uuid.UUID `json:"-" gorm:"type:uuid"`
 }
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	CustomerExportNDJSON = "ndjson"
+	CustomerExportCSV    = "csv"
+)
+
+// CustomerExportOptions selects what the bulk export writes. UpdatedSince
+// keeps the customers changed after it, including their wishlist, and the
+// customers deleted after it.
+type CustomerExportOptions struct {
+	Format       string
+	Gzip         bool
+	UpdatedSince *time.Time
+}
+
+// CustomerExportRow is a customer joined with one of its wishlist items,
+// customers without items have a single row without product. A deleted
+// customer has a single row with DeletedAt and no name or email.
+type CustomerExportRow struct {
+	CustomerID         uuid.UUID
+	Name               string
+	Email              string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	DeletedAt          *time.Time
+	ProductID          *int32
+	Title              *string
+	Category           *string
+	Status             *string
+	Quantity           *int
+	AddedPriceAmount   *int64
+	AddedPriceCurrency *string
+	AddedAt            *time.Time
+}
+
+// ExportedCustomer is a line of the ndjson export, DeletedAt is only set on
+// the customers deleted after updated_since
+type ExportedCustomer struct {
+	ID        uuid.UUID              `json:"id"`
+	Name      string                 `json:"name"`
+	Email     string                 `json:"email"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+	DeletedAt *time.Time             `json:"deleted_at,omitempty"`
+	Wishlist  []ExportedWishlistItem `json:"wishlist"`
+}
+
+type ExportedWishlistItem struct {
+	ProductID  int32     `json:"product_id"`
+	Title      string    `json:"title"`
+	Category   string    `json:"category"`
+	Status     string    `json:"status"`
+	Quantity   int       `json:"quantity"`
+	AddedPrice Money     `json:"added_price" swaggertype:"number"`
+	AddedAt    time.Time `json:"added_at"`
+}
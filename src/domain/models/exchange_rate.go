@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// ExchangeRate is the price of one unit of the catalog currency (DefaultCurrency)
+// in Currency, for example 5.4321 BRL per USD.
+type ExchangeRate struct {
+	Currency  string    `json:"currency" gorm:"primaryKey;size:3"`
+	Rate      float64   `json:"rate" gorm:"type:numeric(18,8);not null"`
+	RateDate  time.Time `json:"rate_date" gorm:"type:date;not null"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ConvertedPrice is a catalog price shown in the currency asked by the client
+type ConvertedPrice struct {
+	Price    Money     `json:"price" swaggertype:"number"`
+	Currency string    `json:"currency"`
+	Rate     float64   `json:"rate"`
+	RateDate time.Time `json:"rate_date"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FavoriteCategory is a catalog category the customer follows. CheckedAt is
+// the last time the customer looked for new products in it, it starts when
+// the category is followed so older products are not reported as new.
+type FavoriteCategory struct {
+	CustomerID uuid.UUID `json:"-" gorm:"type:uuid;primaryKey"`
+	Category   string    `json:"category" gorm:"size:100;primaryKey"`
+	CheckedAt  time.Time `json:"checked_at" gorm:"not null"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// NewInFavoriteCategories are the products added to the followed categories
+// since the last check. Cursor is when this listing stops, acknowledging it
+// moves the checkpoint there.
+type NewInFavoriteCategories struct {
+	Items  []Product `json:"items"`
+	Cursor time.Time `json:"cursor"`
+}
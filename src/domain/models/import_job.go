@@ -0,0 +1,76 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	ImportJobCustomers = "customers"
+
+	ImportJobPending   = "pending"
+	ImportJobRunning   = "running"
+	ImportJobCompleted = "completed"
+	ImportJobFailed    = "failed"
+
+	// MaxCustomerImportRows caps the lines of a customer import file
+	MaxCustomerImportRows = 100000
+)
+
+// ImportJob is an uploaded file processed in the background. The file is
+// kept until the job completes, ProcessedRows is where a job taken over
+// after a crash resumes.
+type ImportJob struct {
+	BaseModel
+	Type          string     `json:"type" gorm:"size:50;not null"`
+	Status        string     `json:"status" gorm:"size:20;not null;index"`
+	Filename      string     `json:"filename" gorm:"size:255"`
+	TotalRows     int        `json:"total_rows"`
+	ProcessedRows int        `json:"processed_rows"`
+	CreatedRows   int        `json:"created_rows"`
+	FailedRows    int        `json:"failed_rows"`
+	ErrorCount    int        `json:"error_count"`
+	StartedAt     *time.Time `json:"started_at"`
+	FinishedAt    *time.Time `json:"finished_at"`
+	File          []byte     `json:"-" gorm:"type:bytea"`
+	// ClaimedBy is the lease of the worker processing the job, renewed on
+	// each claim so a worker whose job was taken over can't save progress
+	ClaimedBy *uuid.UUID `json:"-" gorm:"type:uuid"`
+	// Progress is the percentage of the rows processed
+	Progress float64 `json:"progress" gorm:"-"`
+}
+
+func (j *ImportJob) AfterFind(tx *gorm.DB) error {
+	j.Progress = 0
+	switch {
+	case j.Status == ImportJobCompleted:
+		j.Progress = 100
+	case j.TotalRows > 0:
+		j.Progress = math.Floor(float64(j.ProcessedRows)*1000/float64(j.TotalRows)) / 10
+	}
+	return nil
+}
+
+// ImportJobError is a line of the error report. A row can have more than
+// one, ProductID is set when the customer was created but the product could
+// not be wishlisted.
+type ImportJobError struct {
+	ID        uint      `json:"-" gorm:"primaryKey"`
+	JobID     uuid.UUID `json:"-" gorm:"type:uuid;not null;index"`
+	Line      int       `json:"line"`
+	Email     string    `json:"email"`
+	ProductID *int32    `json:"product_id"`
+	Error     string    `json:"error"`
+}
+
+// CustomerImportRow is a line of a customer import file, ProductIDs is the
+// optional product_ids column as written, separated by "|"
+type CustomerImportRow struct {
+	Line       int
+	Name       string
+	Email      string
+	ProductIDs string
+}
@@ -0,0 +1,146 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// DefaultCurrency is the currency of the catalog prices
+const DefaultCurrency = "USD"
+
+// currencyExponents lists the ISO 4217 currencies without two decimal places
+var currencyExponents = map[string]int{
+	"BHD": 3, "CLP": 0, "ISK": 0, "JOD": 3, "JPY": 0,
+	"KRW": 0, "KWD": 3, "OMR": 3, "PYG": 0, "TND": 3,
+	"UGX": 0, "VND": 0,
+}
+
+// CurrencyExponent is the number of decimal places of the currency minor unit
+func CurrencyExponent(currency string) int {
+	if exponent, ok := currencyExponents[currency]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// Money is an exact amount in the minor unit (cents) of its currency.
+// In JSON it is written as a plain decimal number, like the float prices it
+// replaced, and it reads either a number (in the default currency) or an
+// {"amount": "109.95", "currency": "BRL"} object.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency" gorm:"size:3"`
+}
+
+func NewMoney(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// ParseMoney reads a decimal amount in major units ("109.95"), rounding half
+// away from zero to the currency minor unit.
+func ParseMoney(value string, currency string) (Money, error) {
+	rat, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return Money{}, fmt.Errorf("invalid amount %q", value)
+	}
+	return MoneyFromRat(rat, currency), nil
+}
+
+// MoneyFromRat rounds an exact amount in major units to the currency minor unit
+func MoneyFromRat(value *big.Rat, currency string) Money {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(CurrencyExponent(currency))), nil)
+	scaled := new(big.Rat).Mul(value, new(big.Rat).SetInt(scale))
+
+	quotient, remainder := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	// round half away from zero
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(scaled.Denom()) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(scaled.Num().Sign())))
+	}
+
+	return Money{Amount: quotient.Int64(), Currency: currency}
+}
+
+// Rat is the exact amount in major units
+func (m Money) Rat() *big.Rat {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(CurrencyExponent(m.Currency))), nil)
+	return new(big.Rat).SetFrac(big.NewInt(m.Amount), scale)
+}
+
+// Cmp compares two amounts of the same currency, returning -1, 0 or +1
+func (m Money) Cmp(other Money) int {
+	switch {
+	case m.Amount < other.Amount:
+		return -1
+	case m.Amount > other.Amount:
+		return 1
+	}
+	return 0
+}
+
+func (m Money) Add(other Money) Money {
+	return Money{Amount: m.Amount + other.Amount, Currency: m.currencyOr(other)}
+}
+
+func (m Money) Sub(other Money) Money {
+	return Money{Amount: m.Amount - other.Amount, Currency: m.currencyOr(other)}
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// String formats the amount in major units, like "109.95"
+func (m Money) String() string {
+	return m.Rat().FloatString(CurrencyExponent(m.Currency))
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '{' {
+		var value struct {
+			Amount   json.Number `json:"amount"`
+			Currency string      `json:"currency"`
+		}
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		if value.Currency == "" {
+			value.Currency = DefaultCurrency
+		}
+		parsed, err := ParseMoney(value.Amount.String(), strings.ToUpper(value.Currency))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return fmt.Errorf("invalid money value %s", data)
+	}
+	parsed, err := ParseMoney(number.String(), DefaultCurrency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+func (m Money) currencyOr(other Money) string {
+	if m.Currency == "" {
+		return other.Currency
+	}
+	return m.Currency
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_JSONIsBackwardCompatible(t *testing.T) {
+	var product Product
+	err := json.Unmarshal([]byte(`{"id": 1, "price": 109.95}`), &product)
+	assert.NoError(t, err)
+	assert.Equal(t, NewMoney(10995, "USD"), product.Price)
+
+	body, err := json.Marshal(product)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"price":109.95`)
+}
+
+func TestMoney_UnmarshalObject(t *testing.T) {
+	var m Money
+	err := json.Unmarshal([]byte(`{"amount": "1500", "currency": "jpy"}`), &m)
+	assert.NoError(t, err)
+	assert.Equal(t, NewMoney(1500, "JPY"), m)
+	assert.Equal(t, "1500", m.String())
+}
+
+func TestMoney_UnmarshalInvalid(t *testing.T) {
+	var m Money
+	assert.Error(t, json.Unmarshal([]byte(`"cheap"`), &m))
+}
+
+func TestParseMoney_Rounding(t *testing.T) {
+	cases := map[string]int64{
+		"0.1":     10,
+		"22.3":    2230,
+		"7.955":   796,
+		"-7.955":  -796,
+		"7.954":   795,
+		"1e2":     10000,
+		"109.950": 10995,
+	}
+	for value, expected := range cases {
+		m, err := ParseMoney(value, "BRL")
+		assert.NoError(t, err)
+		assert.Equal(t, expected, m.Amount, value)
+	}
+
+	_, err := ParseMoney("abc", "BRL")
+	assert.Error(t, err)
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	a := NewMoney(10995, "USD")
+	b := NewMoney(5, "USD")
+
+	assert.Equal(t, NewMoney(11000, "USD"), a.Add(b))
+	assert.Equal(t, NewMoney(10990, "USD"), a.Sub(b))
+	assert.Equal(t, 1, a.Cmp(b))
+	assert.Equal(t, -1, b.Cmp(a))
+	assert.Equal(t, 0, a.Cmp(a))
+	assert.Equal(t, "0.05", b.String())
+}
@@ -1,10 +1,28 @@
 package models
 
 type Product struct {
-	ID          int32   `json:"id"`
-	Title       string  `json:"title"`
-	Price       float32 `json:"price"`
-	Description string  `json:"description"`
-	Category    string  `json:"category"`
-	Image       string  `json:"image"`
+	ID          int32  `json:"id"`
+	Title       string `json:"title"`
+	Price       Money  `json:"price" gorm:"embedded;embeddedPrefix:price_" swaggertype:"number"`
+	Description string `json:"description"`
+	Category    string `json:"category"`
+	Image       string `json:"image"`
+	Rating      Rating `json:"rating" gorm:"embedded;embeddedPrefix:rating_"`
+	// Discontinued is set when the catalog notifies the product was deleted
+	Discontinued bool `json:"discontinued" gorm:"not null"`
+	// Placeholder marks a product stored without catalog data, only so an
+	// item pending validation can reference it. It is left out of the search
+	// and the catalog mirror until the catalog data replaces it.
+	Placeholder bool `json:"-" gorm:"not null"`
+	// ConvertedPrice is only filled when the client asks for another currency
+	ConvertedPrice *ConvertedPrice `json:"converted_price,omitempty" gorm:"-"`
+	// Wishlisted and Collections are only filled when the client asks for a
+	// customer, Collections are the tags the customer put on the wishlist item
+	Wishlisted  *bool    `json:"wishlisted,omitempty" gorm:"-"`
+	Collections []string `json:"collections,omitempty" gorm:"-"`
+}
+
+type Rating struct {
+	Rate  float32 `json:"rate"`
+	Count int32   `json:"count"`
 }
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+const (
+	ProductChangeCreated  = "created"
+	ProductChangeUpdated  = "updated"
+	ProductChangeRemoved  = "removed"
+	ProductChangeRestored = "restored"
+)
+
+// ProductChange is an entry of the catalog changelog. Updates are recorded
+// one field at a time, the other types have no field.
+type ProductChange struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	ProductID int32     `json:"product_id" gorm:"not null;index"`
+	Type      string    `json:"type" gorm:"size:20;not null"`
+	Field     string    `json:"field,omitempty" gorm:"size:50"`
+	OldValue  string    `json:"old_value,omitempty"`
+	NewValue  string    `json:"new_value,omitempty"`
+	ChangedAt time.Time `json:"changed_at" gorm:"not null;index"`
+}
+
+type ProductChangePage struct {
+	Items      []ProductChange `json:"items"`
+	Pagination Pagination      `json:"pagination"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import "github.com/google/uuid"
+
+const (
+	ComparisonPrice             = "price"
+	ComparisonRating            = "rating"
+	ComparisonCategory          = "category"
+	ComparisonDescriptionLength = "description_length"
+)
+
+// ProductComparison is an attribute matrix: every attribute has one value per
+// product, in the same order as Products.
+type ProductComparison struct {
+	CustomerID uuid.UUID             `json:"customer_id"`
+	Products   []ComparedProduct     `json:"products"`
+	Attributes []ComparisonAttribute `json:"attributes"`
+	// Stale is set when some products could not be fetched and their stored data was used
+	Stale bool `json:"stale"`
+}
+
+type ComparedProduct struct {
+	ProductID int32  `json:"product_id"`
+	Title     string `json:"title"`
+	Image     string `json:"image"`
+}
+
+type ComparisonAttribute struct {
+	Name   string `json:"name"`
+	Values []any  `json:"values" swaggertype:"array,object"`
+	// Best lists the products with the best value, empty when the attribute
+	// has no ranking (category) or every product has the same value
+	Best []int32 `json:"best"`
+	// Difference is the gap between the highest and lowest values, only for
+	// numeric attributes
+	Difference any  `json:"difference,omitempty" swaggertype:"object"`
+	AllEqual   bool `json:"all_equal"`
+}
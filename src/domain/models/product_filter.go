@@ -0,0 +1,37 @@
+package models
+
+const (
+	ProductSortPrice  = "price"
+	ProductSortTitle  = "title"
+	ProductSortRating = "rating"
+
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// ProductFilter holds the catalog browsing options. Zero values mean
+// "not filtered", a zero Limit returns every matching product.
+type ProductFilter struct {
+	Category  string
+	MinPrice  *Money
+	MaxPrice  *Money
+	MinRating *float32
+	Query     string
+	SortBy    string
+	Order     string
+	Limit     int
+	Offset    int
+}
+
+type Pagination struct {
+	Limit   int  `json:"limit"`
+	Offset  int  `json:"offset"`
+	Count   int  `json:"count"`
+	Total   *int `json:"total,omitempty"`
+	HasMore bool `json:"has_more"`
+}
+
+type ProductPage struct {
+	Items      []Product  `json:"items"`
+	Pagination Pagination `json:"pagination"`
+}
@@ -0,0 +1,27 @@
+package models
+
+type ProductSearch struct {
+	Query    string
+	Category string
+	Limit    int
+	Offset   int
+}
+
+type ProductSearchResult struct {
+	Product
+	Rank float32 `json:"rank"`
+	// Highlights mark the matched terms with <mark></mark>
+	TitleHighlight string `json:"title_highlight"`
+	Snippet        string `json:"snippet"`
+}
+
+type CategoryFacet struct {
+	Category string `json:"category"`
+	Count    int    `json:"count"`
+}
+
+type ProductSearchPage struct {
+	Items      []ProductSearchResult `json:"items"`
+	Facets     []CategoryFacet       `json:"facets"`
+	Pagination Pagination            `json:"pagination"`
+}
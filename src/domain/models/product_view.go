@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProductView is the last time a customer viewed a product, a customer has
+// one row per product so the history has no duplicates
+type ProductView struct {
+	CustomerID uuid.UUID `json:"-" gorm:"type:uuid;primaryKey;index:idx_product_views_recent,priority:1"`
+	ProductID  int32     `json:"product_id" gorm:"primaryKey"`
+	ViewedAt   time.Time `json:"viewed_at" gorm:"not null;index:idx_product_views_recent,priority:2,sort:desc"`
+	Product    *Product  `json:"product" gorm:"foreignKey:ProductID"`
+}
@@ -0,0 +1,66 @@
+package models
+
+import "strings"
+
+// rankDigits are the digits of the ranks, in byte order so the ranks sort
+// as plain strings (COLLATE "C" in postgres)
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+const rankBase = len(rankDigits)
+
+// MaxRankLength is the rank size after which the list should be rebalanced
+const MaxRankLength = 32
+
+// RankBetween returns a rank sorting between before and after, an empty
+// before is the start of the list and an empty after the end. Ranks never
+// end with the lowest digit so there is always room before any of them.
+func RankBetween(before string, after string) string {
+	var rank strings.Builder
+	bounded := after != ""
+	for i := 0; ; i++ {
+		low := 0
+		if i < len(before) {
+			low = strings.IndexByte(rankDigits, before[i])
+		}
+		high := rankBase
+		if bounded && i < len(after) {
+			high = strings.IndexByte(rankDigits, after[i])
+		}
+
+		if low == high {
+			rank.WriteByte(rankDigits[low])
+			continue
+		}
+		if mid := (low + high) / 2; mid > low {
+			rank.WriteByte(rankDigits[mid])
+			return rank.String()
+		}
+		// No digit fits between low and high, keep low and look further
+		// down where anything after before is below after.
+		rank.WriteByte(rankDigits[low])
+		bounded = false
+	}
+}
+
+// EvenRanks returns n ranks spread evenly, used to set the order of a whole
+// list with the shortest ranks and the most room between them.
+func EvenRanks(n int) []string {
+	width, space := 1, rankBase
+	for space < 2*(n+1) {
+		width++
+		space *= rankBase
+	}
+	step := space / (n + 1)
+
+	ranks := make([]string, n)
+	for k := range ranks {
+		value := (k + 1) * step
+		digits := make([]byte, width)
+		for i := width - 1; i >= 0; i-- {
+			digits[i] = rankDigits[value%rankBase]
+			value /= rankBase
+		}
+		ranks[k] = strings.TrimRight(string(digits), rankDigits[:1])
+	}
+	return ranks
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankBetween(t *testing.T) {
+	cases := []struct {
+		before string
+		after  string
+	}{
+		{"", ""},
+		{"", "i"},
+		{"i", ""},
+		{"a", "b"},
+		{"a", "a1"},
+		{"az", "b"},
+		{"z", ""},
+		{"zz", ""},
+		{"", "01"},
+		{"a5", "a6"},
+	}
+	for _, c := range cases {
+		rank := RankBetween(c.before, c.after)
+		assert.Greater(t, rank, c.before, "%q %q", c.before, c.after)
+		if c.after != "" {
+			assert.Less(t, rank, c.after, "%q %q", c.before, c.after)
+		}
+		assert.NotEqual(t, byte('0'), rank[len(rank)-1])
+	}
+}
+
+func TestRankBetween_RepeatedMoves(t *testing.T) {
+	// Moving to the same spot again and again keeps finding room
+	before, after := "a", "b"
+	for range 200 {
+		rank := RankBetween(before, after)
+		assert.Greater(t, rank, before)
+		assert.Less(t, rank, after)
+		after = rank
+	}
+
+	last := ""
+	for range 200 {
+		rank := RankBetween(last, "")
+		assert.Greater(t, rank, last)
+		last = rank
+	}
+}
+
+func TestEvenRanks(t *testing.T) {
+	for _, n := range []int{0, 1, 5, 35, 36, 1000} {
+		ranks := EvenRanks(n)
+		assert.Len(t, ranks, n)
+		assert.True(t, sort.StringsAreSorted(ranks), "n=%d", n)
+		for i, rank := range ranks {
+			assert.NotEmpty(t, rank)
+			assert.NotEqual(t, byte('0'), rank[len(rank)-1])
+			if i > 0 {
+				assert.NotEqual(t, ranks[i-1], rank)
+				assert.NotEmpty(t, RankBetween(ranks[i-1], rank))
+			}
+		}
+	}
+}
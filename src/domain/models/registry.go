@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ReservationPending holds the quantity until it expires
+	ReservationPending = "pending"
+	// ReservationConfirmed holds the quantity for good
+	ReservationConfirmed = "confirmed"
+)
+
+// WishlistRegistry turns the customer wishlist into a gift registry that
+// guests open with the token.
+type WishlistRegistry struct {
+	CustomerID uuid.UUID `json:"customer_id" gorm:"type:uuid;primaryKey"`
+	Token      string    `json:"token" gorm:"size:64;not null;uniqueIndex"`
+	Title      string    `json:"title" gorm:"size:200"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func (WishlistRegistry) TableName() string {
+	return "wishlist_registries"
+}
+
+// RegistryReservation is a guest promise to buy units of a registry item,
+// the id is only known by the guest and is used to confirm or cancel it.
+type RegistryReservation struct {
+	BaseModel
+	CustomerID uuid.UUID `json:"-" gorm:"type:uuid;not null;index:idx_registry_reservations_item"`
+	ProductID  int32     `json:"product_id" gorm:"not null;index:idx_registry_reservations_item"`
+	Quantity   int       `json:"quantity" gorm:"not null"`
+	GuestName  string    `json:"guest_name" gorm:"size:100"`
+	GuestEmail string    `json:"guest_email" gorm:"size:255"`
+	Status     string    `json:"status" gorm:"size:20;not null"`
+	// ExpiresAt is when a pending reservation frees the quantity again
+	ExpiresAt   *time.Time `json:"expires_at"`
+	ConfirmedAt *time.Time `json:"confirmed_at"`
+}
+
+func (RegistryReservation) TableName() string {
+	return "registry_reservations"
+}
+
+// Active reports whether the reservation still holds its quantity
+func (r RegistryReservation) Active(now time.Time) bool {
+	return r.Status == ReservationConfirmed || (r.ExpiresAt != nil && r.ExpiresAt.After(now))
+}
+
+// Registry is the registry as seen by the owner or by a guest. The owner view
+// leaves Reserved and Available out so the gifts stay a surprise.
+type Registry struct {
+	Title     string         `json:"title"`
+	OwnerName string         `json:"owner_name"`
+	Token     string         `json:"token,omitempty"`
+	Items     []RegistryItem `json:"items"`
+}
+
+type RegistryItem struct {
+	ProductID int32    `json:"product_id"`
+	Product   *Product `json:"product"`
+	// Quantity is how many units the owner wants
+	Quantity  int  `json:"quantity"`
+	Reserved  *int `json:"reserved,omitempty"`
+	Available *int `json:"available,omitempty"`
+}
+
+// ReservationRequest is a guest asking to reserve units of a registry item
+type ReservationRequest struct {
+	ProductID  int32
+	Quantity   int
+	GuestName  string
+	GuestEmail string
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ReminderStaleItem nudges the customer about an item sitting in the
+	// wishlist for a long time
+	ReminderStaleItem = "stale_item"
+
+	ReminderPending   = "pending"
+	ReminderDelivered = "delivered"
+)
+
+// WishlistReminder is created by the reminder job and delivered by the
+// notification channels. A wishlist item gets one reminder for each time it
+// was added, so removing and adding the product again can remind again.
+type WishlistReminder struct {
+	BaseModel
+	CustomerID  uuid.UUID  `json:"customer_id" gorm:"type:uuid;not null;uniqueIndex:idx_reminders_item"`
+	ProductID   int32      `json:"product_id" gorm:"not null;uniqueIndex:idx_reminders_item"`
+	ItemAddedAt time.Time  `json:"item_added_at" gorm:"not null;uniqueIndex:idx_reminders_item"`
+	Kind        string     `json:"kind" gorm:"size:30;not null;uniqueIndex:idx_reminders_item"`
+	Status      string     `json:"status" gorm:"size:20;not null;default:pending;index"`
+	DeliveredAt *time.Time `json:"delivered_at"`
+	Product     *Product   `json:"product" gorm:"foreignKey:ProductID"`
+}
+
+// ReminderPreference holds the customer choices about reminders, customers
+// without one get every reminder
+type ReminderPreference struct {
+	CustomerID       uuid.UUID `json:"-" gorm:"type:uuid;primaryKey"`
+	StaleItemsOptOut bool      `json:"stale_items_opt_out" gorm:"not null;default:false"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxTagLength is the size of a tag name in characters
+const MaxTagLength = 50
+
+// Tag is a free-form label a customer puts on wishlist items
+type Tag struct {
+	BaseModel
+	CustomerID uuid.UUID `json:"-" gorm:"type:uuid;not null;uniqueIndex:idx_tags_customer_name"`
+	Name       string    `json:"name" gorm:"size:50;not null;uniqueIndex:idx_tags_customer_name"`
+}
+
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// WishlistItemTag puts a tag on a wishlist item
+type WishlistItemTag struct {
+	CustomerID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ProductID  int32     `gorm:"primaryKey"`
+	TagID      uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+	CreatedAt  time.Time
+}
+
+func (WishlistItemTag) TableName() string {
+	return "wishlist_item_tags"
+}
+
+// TagUsage is a customer tag with the number of wishlist items using it
+type TagUsage struct {
+	Name      string `json:"name"`
+	ItemCount int    `json:"item_count"`
+}
+
+// WishlistFilter narrows the wishlist to the items with the tags, all of
+// them when MatchAll is set or any of them otherwise
+type WishlistFilter struct {
+	Tags     []string
+	MatchAll bool
+}
+
+// NormalizeTag makes "Black  Friday " and "black friday" the same tag
+func NormalizeTag(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	WishlistItemConfirmed = "confirmed"
+	// WishlistItemPending marks items added while the products api was
+	// unavailable, they are confirmed or dropped by the validation job.
+	WishlistItemPending = "pending"
+)
+
+// WishlistItem maps the wishlists join table behind Customer.Wishlist
+type WishlistItem struct {
+	CustomerID uuid.UUID `json:"-" gorm:"type:uuid;primaryKey"`
+	ProductID  int32     `json:"product_id" gorm:"primaryKey"`
+	Status     string    `json:"status" gorm:"size:20;not null;default:confirmed"`
+	CreatedAt  time.Time `json:"added_at"`
+	// AddedPrice is the product price when it was wishlisted, zero when unknown
+	AddedPrice Money `json:"added_price" gorm:"embedded;embeddedPrefix:added_price_" swaggertype:"number"`
+	// AddedBy is the customer who added the item, the owner or an editor
+	// of the shared wishlist. Unknown for items added before sharing existed.
+	AddedBy *uuid.UUID `json:"added_by" gorm:"type:uuid"`
+	// Quantity is how many units the customer wants, used by the gift registry
+	Quantity int `json:"quantity" gorm:"not null;default:1"`
+	// Position is the rank of the item in the order set by the customer,
+	// see RankBetween
+	Position string `json:"position" gorm:"size:255"`
+	// PartnerKey is the partner that added a pending item, the wishlist rules
+	// are checked with it once the validation job knows the product
+	PartnerKey string   `json:"-" gorm:"size:100"`
+	Product    *Product `json:"product" gorm:"foreignKey:ProductID"`
+	Available  bool     `json:"available" gorm:"-"`
+	Tags       []string `json:"tags" gorm:"-"`
+}
+
+func (WishlistItem) TableName() string {
+	return "wishlists"
+}
+
+type Wishlist struct {
+	CustomerID uuid.UUID      `json:"customer_id"`
+	Items      []WishlistItem `json:"items"`
+	// Stale is set when the products api could not be reached and the
+	// items carry the last product data stored locally.
+	Stale bool `json:"stale"`
+}
+
+type WishlistSummary struct {
+	CustomerID    uuid.UUID `json:"customer_id"`
+	ItemCount     int       `json:"item_count"`
+	RatedCount    int       `json:"rated_count"`
+	AverageRating float32   `json:"average_rating"`
+	CurrentTotal  Money     `json:"current_total" swaggertype:"number"`
+	// AddedTotal sums the prices when the items were added, items with an
+	// unknown added price count with their current price
+	AddedTotal Money `json:"added_total" swaggertype:"number"`
+	// Difference is CurrentTotal - AddedTotal, negative values are savings
+	Difference    Money                     `json:"difference" swaggertype:"number"`
+	Categories    []WishlistCategorySummary `json:"categories"`
+	Cheapest      *WishlistSummaryItem      `json:"cheapest"`
+	MostExpensive *WishlistSummaryItem      `json:"most_expensive"`
+	// Stale is set when some products could not be fetched and their stored data was used
+	Stale bool `json:"stale"`
+}
+
+type WishlistCategorySummary struct {
+	Category     string `json:"category"`
+	ItemCount    int    `json:"item_count"`
+	CurrentTotal Money  `json:"current_total" swaggertype:"number"`
+	AddedTotal   Money  `json:"added_total" swaggertype:"number"`
+}
+
+type WishlistSummaryItem struct {
+	ProductID    int32  `json:"product_id"`
+	Title        string `json:"title"`
+	CurrentPrice Money  `json:"current_price" swaggertype:"number"`
+	AddedPrice   Money  `json:"added_price" swaggertype:"number"`
+}
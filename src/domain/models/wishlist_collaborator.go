@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// CollaboratorViewer can see the shared wishlist
+	CollaboratorViewer = "viewer"
+	// CollaboratorEditor can also add and remove items
+	CollaboratorEditor = "editor"
+
+	InvitationPending  = "pending"
+	InvitationAccepted = "accepted"
+	InvitationDeclined = "declined"
+)
+
+// WishlistCollaborator is a customer invited to the wishlist of another
+// customer, the role only applies once the invitation is accepted.
+type WishlistCollaborator struct {
+	OwnerID        uuid.UUID  `json:"owner_id" gorm:"type:uuid;primaryKey"`
+	CollaboratorID uuid.UUID  `json:"collaborator_id" gorm:"type:uuid;primaryKey;index"`
+	Role           string     `json:"role" gorm:"size:20;not null"`
+	Status         string     `json:"status" gorm:"size:20;not null"`
+	CreatedAt      time.Time  `json:"invited_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	RespondedAt    *time.Time `json:"responded_at"`
+	Owner          *Customer  `json:"owner,omitempty" gorm:"foreignKey:OwnerID;constraint:OnDelete:CASCADE;"`
+	Collaborator   *Customer  `json:"collaborator,omitempty" gorm:"foreignKey:CollaboratorID;constraint:OnDelete:CASCADE;"`
+}
+
+func (WishlistCollaborator) TableName() string {
+	return "wishlist_collaborators"
+}
+
+// CanEdit reports whether the collaborator may change the wishlist
+func (c WishlistCollaborator) CanEdit() bool {
+	return c.Status == InvitationAccepted && c.Role == CollaboratorEditor
+}
+
+// CanView reports whether the collaborator may see the wishlist
+func (c WishlistCollaborator) CanView() bool {
+	return c.Status == InvitationAccepted
+}
+
+// WishlistInvite identifies the invited customer by id or by email
+type WishlistInvite struct {
+	CustomerID string
+	Email      string
+	Role       string
+}
@@ -0,0 +1,40 @@
+package models
+
+import "slices"
+
+const (
+	RuleActionAdd    = "add"
+	RuleActionRemove = "remove"
+
+	RuleSourceConfig   = "config"
+	RuleSourceDatabase = "database"
+)
+
+// WishlistRule is a policy checked before the wishlist changes. The
+// expression is a condition over the customer, product and request that has
+// to hold, see the rules package for the syntax.
+type WishlistRule struct {
+	BaseModel
+	Name        string `json:"name" gorm:"size:100;uniqueIndex;not null"`
+	Description string `json:"description" gorm:"size:255"`
+	// Actions limits the rule to some mutations, empty applies it to all
+	Actions    []string `json:"actions" gorm:"serializer:json"`
+	Expression string   `json:"expression" gorm:"type:text;not null"`
+	Enabled    bool     `json:"enabled" gorm:"not null"`
+	// Source tells if the rule was loaded from the rules file or the database
+	Source string `json:"source" gorm:"-"`
+}
+
+func (r *WishlistRule) AppliesTo(action string) bool {
+	return len(r.Actions) == 0 || slices.Contains(r.Actions, action)
+}
+
+// PolicyInput is the wishlist mutation the rules are checked against
+type PolicyInput struct {
+	Action   string
+	Customer *Customer
+	Product  *Product
+	// ActorID is the customer making the change, the owner or an editor
+	ActorID    string
+	PartnerKey string
+}
@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+const (
+	WishlistFormatCSV  = "csv"
+	WishlistFormatJSON = "json"
+
+	// MaxWishlistImportLines bounds the products checked against the catalog
+	// by a single import
+	MaxWishlistImportLines = 500
+)
+
+// Outcome of each line of a wishlist import
+const (
+	ImportLineAdded     = "added"
+	ImportLineDuplicate = "duplicate"
+	ImportLineInvalid   = "invalid"
+	ImportLineNotFound  = "not_found"
+	ImportLineRefused   = "refused"
+	ImportLineFailed    = "failed"
+)
+
+// WishlistExportItem is a wishlist item as downloaded by the export, the
+// same columns are read back by the import
+type WishlistExportItem struct {
+	ProductID  int32     `json:"product_id"`
+	Title      string    `json:"title"`
+	Category   string    `json:"category"`
+	Price      Money     `json:"price" swaggertype:"number"`
+	AddedPrice Money     `json:"added_price" swaggertype:"number"`
+	AddedAt    time.Time `json:"added_at"`
+	Tags       []string  `json:"tags"`
+}
+
+// WishlistImportLine reports what happened to a line of the file, the
+// entries of a json file are numbered from 1 as well
+type WishlistImportLine struct {
+	Line      int    `json:"line"`
+	ProductID int32  `json:"product_id,omitempty"`
+	Status    string `json:"status"`
+	Message   string `json:"message,omitempty"`
+}
+
+// WishlistImportReport sums up an import, nothing is saved on a dry run and
+// added counts the items that would be added
+type WishlistImportReport struct {
+	DryRun  bool                 `json:"dry_run"`
+	Added   int                  `json:"added"`
+	Skipped int                  `json:"skipped"`
+	Failed  int                  `json:"failed"`
+	Lines   []WishlistImportLine `json:"lines"`
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashedWishlistItem is a removed wishlist item kept until ExpiresAt so it
+// can be restored as it was, with its added date, price, position, tags and
+// the guest reservations of a registry item.
+type TrashedWishlistItem struct {
+	CustomerID uuid.UUID  `json:"-" gorm:"type:uuid;primaryKey"`
+	ProductID  int32      `json:"product_id" gorm:"primaryKey"`
+	Status     string     `json:"status" gorm:"size:20;not null"`
+	AddedAt    time.Time  `json:"added_at" gorm:"not null"`
+	AddedPrice Money      `json:"added_price" gorm:"embedded;embeddedPrefix:added_price_" swaggertype:"number"`
+	AddedBy    *uuid.UUID `json:"added_by" gorm:"type:uuid"`
+	Quantity   int        `json:"quantity" gorm:"not null;default:1"`
+	Position   string     `json:"-" gorm:"size:255"`
+	Tags       []string   `json:"tags" gorm:"serializer:json"`
+	// Reservations are private to the guests, they are only put back on restore
+	Reservations []RegistryReservation `json:"-" gorm:"serializer:json"`
+	RemovedAt    time.Time             `json:"removed_at" gorm:"not null"`
+	RemovedBy    *uuid.UUID            `json:"removed_by" gorm:"type:uuid"`
+	ExpiresAt    time.Time             `json:"expires_at" gorm:"not null;index"`
+	Product      *Product              `json:"product" gorm:"foreignKey:ProductID"`
+}
+
+func (TrashedWishlistItem) TableName() string {
+	return "wishlist_trash"
+}
+
+// ToWishlistItem rebuilds the wishlist item the entry was made from
+func (t *TrashedWishlistItem) ToWishlistItem() *WishlistItem {
+	return &WishlistItem{
+		CustomerID: t.CustomerID,
+		ProductID:  t.ProductID,
+		Status:     t.Status,
+		CreatedAt:  t.AddedAt,
+		AddedPrice: t.AddedPrice,
+		AddedBy:    t.AddedBy,
+		Quantity:   t.Quantity,
+		Position:   t.Position,
+		Product:    t.Product,
+		Tags:       t.Tags,
+	}
+}
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+type CartService struct {
+	CustomerRepository querier.CustomerQuerier
+	CartRepository     querier.CartQuerier
+	WishlistRepository querier.WishlistQuerier
+	ProductRepository  querier.ProductQuerier
+	ProductService     servicers.ProductServicer
+	// PolicyService checks the remove rules of the items leaving the wishlist
+	PolicyService servicers.PolicyServicer
+}
+
+func NewCartService(customerRepository querier.CustomerQuerier,
+	cartRepository querier.CartQuerier,
+	wishlistRepository querier.WishlistQuerier,
+	productRepository querier.ProductQuerier,
+	productService servicers.ProductServicer,
+	policyService servicers.PolicyServicer) servicers.CartServicer {
+	return &CartService{
+		CustomerRepository: customerRepository,
+		CartRepository:     cartRepository,
+		WishlistRepository: wishlistRepository,
+		ProductRepository:  productRepository,
+		ProductService:     productService,
+		PolicyService:      policyService,
+	}
+}
+
+func (cs *CartService) GetCart(customerID string) (*models.Cart, error) {
+	customer, err := cs.getCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+	return cs.loadCart(customer)
+}
+
+// AddItem puts the product in the cart with its current catalog price, the
+// quantity is added to the one already in the cart.
+func (cs *CartService) AddItem(customerID string, productID int32, quantity int) (*models.Cart, error) {
+	customer, err := cs.getCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := cs.ProductService.GetProductByID(productID)
+	if err != nil {
+		var notFoundErr *exceptions.NotFoundEntityError
+		if errors.As(err, &notFoundErr) {
+			return nil, &exceptions.NotFoundEntityError{
+				Reason: "product not found",
+			}
+		}
+		return nil, err
+	}
+	if product.Discontinued {
+		return nil, &exceptions.BadRequestError{
+			Reason: "product is no longer available",
+		}
+	}
+
+	if err := cs.ProductRepository.Upsert(product); err != nil {
+		return nil, err
+	}
+	item := &models.CartItem{
+		CustomerID: customer.ID,
+		ProductID:  productID,
+		Quantity:   quantity,
+		UnitPrice:  product.Price,
+	}
+	added, err := cs.CartRepository.Add(item)
+	if err != nil {
+		return nil, err
+	}
+	if !added {
+		return nil, quantityExceededError()
+	}
+
+	return cs.loadCart(customer)
+}
+
+// UpdateItem sets the quantity of a product already in the cart, the unit
+// price snapshot is kept.
+func (cs *CartService) UpdateItem(customerID string, productID int32, quantity int) (*models.Cart, error) {
+	customer, err := cs.getCartItemCustomer(customerID, productID)
+	if err != nil {
+		return nil, err
+	}
+	if err := cs.CartRepository.UpdateQuantity(customerID, productID, quantity); err != nil {
+		return nil, err
+	}
+	return cs.loadCart(customer)
+}
+
+func (cs *CartService) RemoveItem(customerID string, productID int32) error {
+	if _, err := cs.getCartItemCustomer(customerID, productID); err != nil {
+		return err
+	}
+	return cs.CartRepository.Remove(customerID, productID)
+}
+
+// MoveFromWishlist moves a single wishlist item, or the whole wishlist when
+// productID is nil, to the cart with the current catalog prices. Items pending
+// validation or out of the catalog can't be priced: moving one of them alone
+// is refused, when moving everything they are skipped and stay in the wishlist.
+// Items the remove rules keep in the wishlist are handled the same way.
+func (cs *CartService) MoveFromWishlist(customerID string, productID *int32, keepInWishlist bool) (*models.MoveToCartResult, error) {
+	customer, err := cs.getCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlist, err := cs.WishlistRepository.ListByCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+	if productID != nil {
+		wishlist = filterWishlistItem(wishlist, *productID)
+		if len(wishlist) == 0 {
+			return nil, &exceptions.NotFoundEntityError{
+				Reason: "product not in wishlist",
+			}
+		}
+	}
+
+	// The prices are checked against the catalog, a cart can't be filled
+	// with the stale data stored locally. Pending items are never priced.
+	productIDs := []int32{}
+	for _, wished := range wishlist {
+		if wished.Status != models.WishlistItemPending {
+			productIDs = append(productIDs, wished.ProductID)
+		}
+	}
+	catalog, errs := fetchProducts(cs.ProductService, productIDs)
+	for _, err := range errs {
+		var notFoundErr *exceptions.NotFoundEntityError
+		if !errors.As(err, &notFoundErr) {
+			return nil, err
+		}
+	}
+
+	cart, err := cs.CartRepository.ListByCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+	quantities := make(map[int32]int, len(cart))
+	for _, item := range cart {
+		quantities[item.ProductID] = item.Quantity
+	}
+
+	result := &models.MoveToCartResult{Moved: []int32{}, Skipped: []int32{}}
+	items := []models.CartItem{}
+	moved := []models.Product{}
+	for _, wished := range wishlist {
+		product, ok := catalog[wished.ProductID]
+		reason := ""
+		switch {
+		case wished.Status == models.WishlistItemPending:
+			reason = "product is pending validation"
+		case !ok || product.Discontinued:
+			reason = "product is no longer available"
+		case quantities[wished.ProductID]+1 > models.MaxCartItemQuantity:
+			reason = fmt.Sprintf("cart already has %d units of the product", models.MaxCartItemQuantity)
+		}
+		if reason != "" {
+			if productID != nil {
+				return nil, &exceptions.BadRequestError{Reason: reason}
+			}
+			result.Skipped = append(result.Skipped, wished.ProductID)
+			continue
+		}
+		if !keepInWishlist {
+			err := cs.PolicyService.Check(models.PolicyInput{
+				Action:   models.RuleActionRemove,
+				Customer: customer,
+				Product:  product,
+				ActorID:  customerID,
+			})
+			var violationErr *exceptions.PolicyViolationError
+			if errors.As(err, &violationErr) && productID == nil {
+				result.Skipped = append(result.Skipped, wished.ProductID)
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		items = append(items, models.CartItem{
+			CustomerID: wished.CustomerID,
+			ProductID:  wished.ProductID,
+			Quantity:   1,
+			UnitPrice:  product.Price,
+		})
+		moved = append(moved, *product)
+		result.Moved = append(result.Moved, wished.ProductID)
+	}
+
+	if len(items) > 0 {
+		if err := cs.ProductRepository.UpsertMany(moved); err != nil {
+			return nil, err
+		}
+		// The moved items go to the trash like any other removal
+		var trashExpiresAt time.Time
+		if config.WISHLIST_TRASH_RETENTION > 0 {
+			trashExpiresAt = time.Now().Add(config.WISHLIST_TRASH_RETENTION)
+		}
+		// The cap is checked again while the cart is locked, a concurrent
+		// change may have filled it since it was read
+		full, err := cs.CartRepository.MoveFromWishlist(customerID, items, keepInWishlist, trashExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+		if len(full) > 0 && productID != nil {
+			return nil, quantityExceededError()
+		}
+		result.Moved = slices.DeleteFunc(result.Moved, func(id int32) bool { return slices.Contains(full, id) })
+		result.Skipped = append(result.Skipped, full...)
+	}
+
+	result.Cart, err = cs.loadCart(customer)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (cs *CartService) getCustomer(customerID string) (*models.Customer, error) {
+	customer, err := cs.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	return customer, nil
+}
+
+// getCartItemCustomer returns the customer once the product is found in its cart
+func (cs *CartService) getCartItemCustomer(customerID string, productID int32) (*models.Customer, error) {
+	customer, err := cs.getCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := cs.CartRepository.Get(customerID, productID)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "product not in cart",
+		}
+	}
+	return customer, nil
+}
+
+func (cs *CartService) loadCart(customer *models.Customer) (*models.Cart, error) {
+	items, err := cs.CartRepository.ListByCustomer(customer.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	cart := &models.Cart{
+		CustomerID: customer.ID,
+		Items:      items,
+		Total:      models.NewMoney(0, models.DefaultCurrency),
+	}
+	for _, item := range items {
+		cart.ItemCount += item.Quantity
+		cart.Total = cart.Total.Add(item.Subtotal())
+	}
+	return cart, nil
+}
+
+func filterWishlistItem(items []models.WishlistItem, productID int32) []models.WishlistItem {
+	for _, item := range items {
+		if item.ProductID == productID {
+			return []models.WishlistItem{item}
+		}
+	}
+	return nil
+}
+
+func quantityExceededError() error {
+	return &exceptions.BadRequestError{
+		Reason: fmt.Sprintf("quantity can't exceed %d units per product", models.MaxCartItemQuantity),
+	}
+}
@@ -0,0 +1,293 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+type cartMocks struct {
+	customerRepo *mocks.CustomerQuerier
+	cartRepo     *mocks.CartQuerier
+	wishlistRepo *mocks.WishlistQuerier
+	productRepo  *mocks.ProductQuerier
+	productSvc   *mocks.ProductServicer
+	policySvc    *mocks.PolicyServicer
+}
+
+func newCartService() (servicers.CartServicer, *cartMocks) {
+	m := &cartMocks{
+		customerRepo: new(mocks.CustomerQuerier),
+		cartRepo:     new(mocks.CartQuerier),
+		wishlistRepo: new(mocks.WishlistQuerier),
+		productRepo:  new(mocks.ProductQuerier),
+		productSvc:   new(mocks.ProductServicer),
+		policySvc:    new(mocks.PolicyServicer),
+	}
+	service := NewCartService(m.customerRepo, m.cartRepo, m.wishlistRepo, m.productRepo, m.productSvc, m.policySvc)
+	return service, m
+}
+
+func (m *cartMocks) assertExpectations(t *testing.T) {
+	m.customerRepo.AssertExpectations(t)
+	m.cartRepo.AssertExpectations(t)
+	m.wishlistRepo.AssertExpectations(t)
+	m.productRepo.AssertExpectations(t)
+	m.productSvc.AssertExpectations(t)
+	m.policySvc.AssertExpectations(t)
+}
+
+func TestCartService_GetCart_Totals(t *testing.T) {
+	service, m := newCartService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.cartRepo.On("ListByCustomer", customerID.String()).Return([]models.CartItem{
+		{ProductID: 1, Quantity: 2, UnitPrice: models.NewMoney(1050, "USD")},
+		{ProductID: 2, Quantity: 1, UnitPrice: models.NewMoney(300, "USD")},
+	}, nil)
+
+	cart, err := service.GetCart(customerID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, customerID, cart.CustomerID)
+	assert.Equal(t, 3, cart.ItemCount)
+	assert.Equal(t, "24.00", cart.Total.String())
+	m.assertExpectations(t)
+}
+
+func TestCartService_GetCart_CustomerNotFound(t *testing.T) {
+	service, m := newCartService()
+	customerID := uuid.New().String()
+
+	m.customerRepo.On("GetByID", customerID).Return(nil, nil)
+
+	_, err := service.GetCart(customerID)
+
+	var notFoundErr *exceptions.NotFoundEntityError
+	assert.ErrorAs(t, err, &notFoundErr)
+	m.assertExpectations(t)
+}
+
+func TestCartService_AddItem_SnapshotsPrice(t *testing.T) {
+	service, m := newCartService()
+	customerID := uuid.New()
+	product := &models.Product{ID: 1, Title: "Test Product", Price: models.NewMoney(1999, "USD")}
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(product, nil)
+	m.productRepo.On("Upsert", product).Return(nil)
+	m.cartRepo.On("Add", mock.MatchedBy(func(item *models.CartItem) bool {
+		return item.CustomerID == customerID && item.ProductID == 1 &&
+			item.Quantity == 2 && item.UnitPrice.Amount == 1999
+	})).Return(true, nil)
+	m.cartRepo.On("ListByCustomer", customerID.String()).Return([]models.CartItem{
+		{ProductID: 1, Quantity: 2, UnitPrice: product.Price},
+	}, nil)
+
+	cart, err := service.AddItem(customerID.String(), 1, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "39.98", cart.Total.String())
+	m.assertExpectations(t)
+}
+
+func TestCartService_AddItem_QuantityExceeded(t *testing.T) {
+	service, m := newCartService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(createProduct(1), nil)
+	m.productRepo.On("Upsert", mock.Anything).Return(nil)
+	m.cartRepo.On("Add", mock.Anything).Return(false, nil)
+
+	_, err := service.AddItem(customerID.String(), 1, 1)
+
+	var badRequestErr *exceptions.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+	m.cartRepo.AssertNotCalled(t, "ListByCustomer", mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestCartService_AddItem_ProductNotFound(t *testing.T) {
+	service, m := newCartService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.productSvc.On("GetProductByID", int32(9)).
+		Return(nil, &exceptions.NotFoundEntityError{Reason: "not found"})
+
+	_, err := service.AddItem(customerID.String(), 9, 1)
+
+	var notFoundErr *exceptions.NotFoundEntityError
+	assert.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "product not found", err.Error())
+	m.assertExpectations(t)
+}
+
+func TestCartService_RemoveItem_NotInCart(t *testing.T) {
+	service, m := newCartService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.cartRepo.On("Get", customerID.String(), int32(1)).Return(nil, nil)
+
+	err := service.RemoveItem(customerID.String(), 1)
+
+	var notFoundErr *exceptions.NotFoundEntityError
+	assert.ErrorAs(t, err, &notFoundErr)
+	m.cartRepo.AssertNotCalled(t, "Remove", mock.Anything, mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestCartService_MoveFromWishlist_All(t *testing.T) {
+	service, m := newCartService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return([]models.WishlistItem{
+		{CustomerID: customerID, ProductID: 1, Status: models.WishlistItemConfirmed},
+		{CustomerID: customerID, ProductID: 2, Status: models.WishlistItemPending},
+		{CustomerID: customerID, ProductID: 3, Status: models.WishlistItemConfirmed},
+	}, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(&models.Product{ID: 1, Price: models.NewMoney(1000, "USD")}, nil)
+	m.productSvc.On("GetProductByID", int32(3)).Return(nil, &exceptions.NotFoundEntityError{Reason: "not found"})
+	m.cartRepo.On("ListByCustomer", customerID.String()).Return([]models.CartItem{}, nil)
+	m.policySvc.On("Check", mock.MatchedBy(func(input models.PolicyInput) bool {
+		return input.Action == models.RuleActionRemove && input.Product.ID == 1
+	})).Return(nil)
+	m.productRepo.On("UpsertMany", mock.MatchedBy(func(products []models.Product) bool {
+		return len(products) == 1 && products[0].ID == 1
+	})).Return(nil)
+	m.cartRepo.On("MoveFromWishlist", customerID.String(), mock.MatchedBy(func(items []models.CartItem) bool {
+		return len(items) == 1 && items[0].ProductID == 1 && items[0].Quantity == 1 &&
+			items[0].UnitPrice.Amount == 1000
+	}), false, mock.MatchedBy(func(trashExpiresAt time.Time) bool {
+		return trashExpiresAt.After(time.Now())
+	})).Return([]int32{}, nil)
+
+	result, err := service.MoveFromWishlist(customerID.String(), nil, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{1}, result.Moved)
+	assert.Equal(t, []int32{2, 3}, result.Skipped)
+	m.assertExpectations(t)
+}
+
+func TestCartService_MoveFromWishlist_CartFilledConcurrently(t *testing.T) {
+	service, m := newCartService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return([]models.WishlistItem{
+		{CustomerID: customerID, ProductID: 1, Status: models.WishlistItemConfirmed},
+		{CustomerID: customerID, ProductID: 2, Status: models.WishlistItemConfirmed},
+	}, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(&models.Product{ID: 1, Price: models.NewMoney(1000, "USD")}, nil)
+	m.productSvc.On("GetProductByID", int32(2)).Return(&models.Product{ID: 2, Price: models.NewMoney(500, "USD")}, nil)
+	m.cartRepo.On("ListByCustomer", customerID.String()).Return([]models.CartItem{}, nil)
+	m.productRepo.On("UpsertMany", mock.Anything).Return(nil)
+	// the cart was filled with product 2 after it was read
+	m.cartRepo.On("MoveFromWishlist", customerID.String(), mock.Anything, true, mock.Anything).
+		Return([]int32{2}, nil)
+
+	result, err := service.MoveFromWishlist(customerID.String(), nil, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{1}, result.Moved)
+	assert.Equal(t, []int32{2}, result.Skipped)
+
+	productID := int32(2)
+	_, err = service.MoveFromWishlist(customerID.String(), &productID, true)
+	assert.IsType(t, &exceptions.BadRequestError{}, err)
+}
+
+func TestCartService_MoveFromWishlist_SinglePendingRefused(t *testing.T) {
+	service, m := newCartService()
+	customerID := uuid.New()
+	productID := int32(2)
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return([]models.WishlistItem{
+		{CustomerID: customerID, ProductID: 1, Status: models.WishlistItemConfirmed},
+		{CustomerID: customerID, ProductID: 2, Status: models.WishlistItemPending},
+	}, nil)
+	m.cartRepo.On("ListByCustomer", customerID.String()).Return([]models.CartItem{}, nil)
+
+	_, err := service.MoveFromWishlist(customerID.String(), &productID, true)
+
+	var badRequestErr *exceptions.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+	m.cartRepo.AssertNotCalled(t, "MoveFromWishlist", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.productSvc.AssertNotCalled(t, "GetProductByID", mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestCartService_MoveFromWishlist_RemoveRulesKeepItems(t *testing.T) {
+	service, m := newCartService()
+	customerID := uuid.New()
+	productID := int32(1)
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return([]models.WishlistItem{
+		{CustomerID: customerID, ProductID: 1, Status: models.WishlistItemConfirmed},
+	}, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(&models.Product{ID: 1, Price: models.NewMoney(1000, "USD")}, nil)
+	m.cartRepo.On("ListByCustomer", customerID.String()).Return([]models.CartItem{}, nil)
+	m.policySvc.On("Check", mock.Anything).Return(&exceptions.PolicyViolationError{
+		Violations: []exceptions.PolicyViolation{{Rule: "keep-items"}},
+	})
+
+	_, err := service.MoveFromWishlist(customerID.String(), &productID, false)
+
+	var violationErr *exceptions.PolicyViolationError
+	assert.ErrorAs(t, err, &violationErr)
+
+	result, err := service.MoveFromWishlist(customerID.String(), nil, false)
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Moved)
+	assert.Equal(t, []int32{1}, result.Skipped)
+	m.cartRepo.AssertNotCalled(t, "MoveFromWishlist", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestCartService_MoveFromWishlist_NotInWishlist(t *testing.T) {
+	service, m := newCartService()
+	customerID := uuid.New()
+	productID := int32(7)
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return([]models.WishlistItem{}, nil)
+
+	_, err := service.MoveFromWishlist(customerID.String(), &productID, false)
+
+	var notFoundErr *exceptions.NotFoundEntityError
+	assert.ErrorAs(t, err, &notFoundErr)
+	m.assertExpectations(t)
+}
+
+func TestCartService_MoveFromWishlist_ProductsUnavailable(t *testing.T) {
+	service, m := newCartService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return([]models.WishlistItem{
+		{CustomerID: customerID, ProductID: 1, Status: models.WishlistItemConfirmed},
+	}, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(nil, errors.New("products api down"))
+
+	_, err := service.MoveFromWishlist(customerID.String(), nil, false)
+
+	assert.Error(t, err)
+	m.assertExpectations(t)
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+type CatalogEventService struct {
+	EventRepository   querier.CatalogEventQuerier
+	ProductRepository querier.ProductQuerier
+	ProductService    servicers.ProductServicer
+}
+
+func NewCatalogEventService(eventRepository querier.CatalogEventQuerier,
+	productRepository querier.ProductQuerier,
+	productService servicers.ProductServicer) servicers.CatalogEventServicer {
+	return &CatalogEventService{
+		EventRepository:   eventRepository,
+		ProductRepository: productRepository,
+		ProductService:    productService,
+	}
+}
+
+// Process applies a catalog change to the locally stored product data and
+// logs what changed. It returns false when the event was already processed.
+// An event older than the last one applied to the product is only logged,
+// a delayed delivery can't bring back outdated data.
+func (s *CatalogEventService) Process(event *models.CatalogEvent) (bool, error) {
+	// Redeliveries are usually caught here, Record decides between
+	// concurrent ones
+	processed, err := s.EventRepository.Exists(event.ID)
+	if err != nil {
+		return false, err
+	}
+	if processed {
+		return false, nil
+	}
+
+	stored, err := s.ProductRepository.GetByID(event.ProductID)
+	if err != nil {
+		return false, err
+	}
+
+	// Only products someone wishlisted are stored locally, changes to any
+	// other product need no work besides being logged.
+	var product *models.Product
+	var changes []models.ProductChange
+	if stored != nil {
+		last, err := s.EventRepository.LastOccurredAt(event.ProductID)
+		if err != nil {
+			return false, err
+		}
+		if event.OccurredAt.Before(last) {
+			log.Printf("catalog event %s: product %d has a newer change, ignored", event.ID, event.ProductID)
+			return s.EventRepository.Record(event, nil, nil)
+		}
+
+		product, err = s.apply(event, stored)
+		if err != nil {
+			return false, err
+		}
+		changes = catalogEventChanges(*stored, *product, time.Now())
+	}
+
+	return s.EventRepository.Record(event, product, changes)
+}
+
+// apply returns the stored product as the event leaves it
+func (s *CatalogEventService) apply(event *models.CatalogEvent, stored *models.Product) (*models.Product, error) {
+	product := *stored
+	switch event.Type {
+	case models.CatalogEventProductDeleted:
+		product.Discontinued = true
+		return &product, nil
+	case models.CatalogEventProductPriceChanged:
+		if event.Price != nil {
+			product.Price = *event.Price
+			return &product, nil
+		}
+	}
+
+	// Updates (and price changes without a price) are refreshed from the catalog
+	current, err := s.ProductService.GetProductByID(event.ProductID)
+	var notFoundErr *exceptions.NotFoundEntityError
+	if errors.As(err, &notFoundErr) {
+		log.Printf("catalog event %s: product %d no longer exists", event.ID, event.ProductID)
+		product.Discontinued = true
+		return &product, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+// catalogEventChanges are the changelog entries of a product going from old
+// to current, the same the catalog mirror records. A placeholder getting its
+// catalog data is logged as created when it is saved.
+func catalogEventChanges(old models.Product, current models.Product, now time.Time) []models.ProductChange {
+	var changes []models.ProductChange
+	switch {
+	case current.Discontinued && !old.Discontinued:
+		changes = append(changes, models.ProductChange{ProductID: current.ID, Type: models.ProductChangeRemoved, ChangedAt: now})
+	case !current.Discontinued && old.Discontinued && !old.Placeholder:
+		changes = append(changes, models.ProductChange{ProductID: current.ID, Type: models.ProductChangeRestored, ChangedAt: now})
+	}
+	if old.Placeholder {
+		return changes
+	}
+
+	for _, field := range productFieldChanges(old, current) {
+		field.ProductID = current.ID
+		field.Type = models.ProductChangeUpdated
+		field.ChangedAt = now
+		changes = append(changes, field)
+	}
+	return changes
+}
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func newCatalogEventService() (*CatalogEventService, *mocks.CatalogEventQuerier, *mocks.ProductQuerier, *mocks.ProductServicer) {
+	eventRepo := new(mocks.CatalogEventQuerier)
+	productRepo := new(mocks.ProductQuerier)
+	productSvc := new(mocks.ProductServicer)
+	service := NewCatalogEventService(eventRepo, productRepo, productSvc).(*CatalogEventService)
+	return service, eventRepo, productRepo, productSvc
+}
+
+func TestCatalogEventProcess_Duplicate(t *testing.T) {
+	service, eventRepo, productRepo, _ := newCatalogEventService()
+
+	event := &models.CatalogEvent{ID: "evt-1", Type: models.CatalogEventProductDeleted, ProductID: 1}
+	eventRepo.On("Exists", "evt-1").Return(true, nil)
+
+	processed, err := service.Process(event)
+
+	assert.NoError(t, err)
+	assert.False(t, processed)
+	productRepo.AssertNotCalled(t, "GetByID", int32(1))
+	eventRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCatalogEventProcess_ConcurrentDuplicate(t *testing.T) {
+	service, eventRepo, productRepo, _ := newCatalogEventService()
+
+	event := &models.CatalogEvent{ID: "evt-1", Type: models.CatalogEventProductDeleted, ProductID: 1}
+	eventRepo.On("Exists", "evt-1").Return(false, nil)
+	productRepo.On("GetByID", int32(1)).Return(createProduct(1), nil)
+	eventRepo.On("LastOccurredAt", int32(1)).Return(time.Time{}, nil)
+	eventRepo.On("Record", event, mock.Anything, mock.Anything).Return(false, nil)
+
+	processed, err := service.Process(event)
+
+	assert.NoError(t, err)
+	assert.False(t, processed)
+}
+
+func TestCatalogEventProcess_Deleted(t *testing.T) {
+	service, eventRepo, productRepo, _ := newCatalogEventService()
+
+	event := &models.CatalogEvent{ID: "evt-1", Type: models.CatalogEventProductDeleted, ProductID: 1}
+	eventRepo.On("Exists", "evt-1").Return(false, nil)
+	productRepo.On("GetByID", int32(1)).Return(createProduct(1), nil)
+	eventRepo.On("LastOccurredAt", int32(1)).Return(time.Time{}, nil)
+	eventRepo.On("Record", event, mock.MatchedBy(func(product *models.Product) bool {
+		return product.ID == 1 && product.Discontinued
+	}), mock.MatchedBy(func(changes []models.ProductChange) bool {
+		return len(changes) == 1 && changes[0].Type == models.ProductChangeRemoved
+	})).Return(true, nil)
+
+	processed, err := service.Process(event)
+
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	eventRepo.AssertExpectations(t)
+	productRepo.AssertExpectations(t)
+}
+
+func TestCatalogEventProcess_OlderThanTheLastApplied(t *testing.T) {
+	service, eventRepo, productRepo, productSvc := newCatalogEventService()
+
+	price := models.NewMoney(5550, "USD")
+	now := time.Now()
+	event := &models.CatalogEvent{ID: "evt-7", Type: models.CatalogEventProductPriceChanged, ProductID: 1,
+		Price: &price, OccurredAt: now.Add(-time.Minute)}
+	eventRepo.On("Exists", "evt-7").Return(false, nil)
+	productRepo.On("GetByID", int32(1)).Return(createProduct(1), nil)
+	eventRepo.On("LastOccurredAt", int32(1)).Return(now, nil)
+	// Logged as processed so the sender doesn't retry it, the product is kept
+	eventRepo.On("Record", event, (*models.Product)(nil), []models.ProductChange(nil)).Return(true, nil)
+
+	processed, err := service.Process(event)
+
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	productSvc.AssertNotCalled(t, "GetProductByID", int32(1))
+	eventRepo.AssertExpectations(t)
+}
+
+func TestCatalogEventProcess_PriceChanged(t *testing.T) {
+	service, eventRepo, productRepo, productSvc := newCatalogEventService()
+
+	price := models.NewMoney(5550, "USD")
+	event := &models.CatalogEvent{ID: "evt-2", Type: models.CatalogEventProductPriceChanged, ProductID: 1, Price: &price}
+	stored := createProduct(1)
+	eventRepo.On("Exists", "evt-2").Return(false, nil)
+	productRepo.On("GetByID", int32(1)).Return(stored, nil)
+	eventRepo.On("LastOccurredAt", int32(1)).Return(time.Time{}, nil)
+	eventRepo.On("Record", event, mock.MatchedBy(func(product *models.Product) bool {
+		return product.Price == price && product.Title == stored.Title
+	}), mock.MatchedBy(func(changes []models.ProductChange) bool {
+		return len(changes) == 1 && changes[0].Field == "price" && changes[0].OldValue == stored.Price.String() &&
+			changes[0].NewValue == price.String()
+	})).Return(true, nil)
+
+	processed, err := service.Process(event)
+
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	productSvc.AssertNotCalled(t, "GetProductByID", int32(1))
+	eventRepo.AssertExpectations(t)
+}
+
+func TestCatalogEventProcess_UpdatedRefreshesFromCatalog(t *testing.T) {
+	service, eventRepo, productRepo, productSvc := newCatalogEventService()
+
+	event := &models.CatalogEvent{ID: "evt-3", Type: models.CatalogEventProductUpdated, ProductID: 1}
+	stored := createProduct(1)
+	current := *stored
+	current.Title = "New title"
+	eventRepo.On("Exists", "evt-3").Return(false, nil)
+	productRepo.On("GetByID", int32(1)).Return(stored, nil)
+	eventRepo.On("LastOccurredAt", int32(1)).Return(time.Time{}, nil)
+	productSvc.On("GetProductByID", int32(1)).Return(&current, nil)
+	eventRepo.On("Record", event, &current, mock.MatchedBy(func(changes []models.ProductChange) bool {
+		return len(changes) == 1 && changes[0].Field == "title" && changes[0].NewValue == "New title"
+	})).Return(true, nil)
+
+	processed, err := service.Process(event)
+
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	eventRepo.AssertExpectations(t)
+	productSvc.AssertExpectations(t)
+}
+
+func TestCatalogEventProcess_UpdatedButGoneUpstream(t *testing.T) {
+	service, eventRepo, productRepo, productSvc := newCatalogEventService()
+
+	event := &models.CatalogEvent{ID: "evt-4", Type: models.CatalogEventProductUpdated, ProductID: 1}
+	eventRepo.On("Exists", "evt-4").Return(false, nil)
+	productRepo.On("GetByID", int32(1)).Return(createProduct(1), nil)
+	eventRepo.On("LastOccurredAt", int32(1)).Return(time.Time{}, nil)
+	productSvc.On("GetProductByID", int32(1)).Return(nil, &exceptions.NotFoundEntityError{Reason: "product not found"})
+	eventRepo.On("Record", event, mock.MatchedBy(func(product *models.Product) bool {
+		return product.Discontinued
+	}), mock.Anything).Return(true, nil)
+
+	processed, err := service.Process(event)
+
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	eventRepo.AssertExpectations(t)
+}
+
+func TestCatalogEventProcess_UnknownProductIsOnlyLogged(t *testing.T) {
+	service, eventRepo, productRepo, productSvc := newCatalogEventService()
+
+	event := &models.CatalogEvent{ID: "evt-5", Type: models.CatalogEventProductUpdated, ProductID: 9}
+	eventRepo.On("Exists", "evt-5").Return(false, nil)
+	productRepo.On("GetByID", int32(9)).Return(nil, nil)
+	eventRepo.On("Record", event, (*models.Product)(nil), []models.ProductChange(nil)).Return(true, nil)
+
+	processed, err := service.Process(event)
+
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	productSvc.AssertNotCalled(t, "GetProductByID", int32(9))
+	eventRepo.AssertExpectations(t)
+}
+
+func TestCatalogEventProcess_ApiErrorIsNotLogged(t *testing.T) {
+	service, eventRepo, productRepo, productSvc := newCatalogEventService()
+
+	event := &models.CatalogEvent{ID: "evt-6", Type: models.CatalogEventProductUpdated, ProductID: 1}
+	eventRepo.On("Exists", "evt-6").Return(false, nil)
+	productRepo.On("GetByID", int32(1)).Return(createProduct(1), nil)
+	eventRepo.On("LastOccurredAt", int32(1)).Return(time.Time{}, nil)
+	productSvc.On("GetProductByID", int32(1)).Return(nil, errors.New("connection refused"))
+
+	processed, err := service.Process(event)
+
+	assert.Error(t, err)
+	assert.False(t, processed)
+	// Not logged so the sender's retry is processed
+	eventRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCatalogEventChanges_PlaceholderOnlyLogsCreation(t *testing.T) {
+	current := createProduct(1)
+	changes := catalogEventChanges(models.Product{ID: 1, Placeholder: true}, *current, time.Now())
+
+	assert.Empty(t, changes)
+}
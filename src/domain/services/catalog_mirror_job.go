@@ -0,0 +1,138 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+)
+
+// CatalogMirrorJob copies the whole catalog into the products table so it can
+// be searched locally, logging what changed since the previous pull.
+type CatalogMirrorJob struct {
+	LockRepository    querier.LockQuerier
+	ProductRepository querier.ProductQuerier
+	ProductService    servicers.ProductServicer
+}
+
+func NewCatalogMirrorJob(lockRepository querier.LockQuerier,
+	productRepository querier.ProductQuerier,
+	productService servicers.ProductServicer) servicers.Job {
+	return &CatalogMirrorJob{
+		LockRepository:    lockRepository,
+		ProductRepository: productRepository,
+		ProductService:    productService,
+	}
+}
+
+func (j *CatalogMirrorJob) Name() string {
+	return "catalog-mirror"
+}
+
+func (j *CatalogMirrorJob) Interval() time.Duration {
+	return config.CATALOG_MIRROR_INTERVAL
+}
+
+// Run syncs the catalog unless another instance is already doing it
+func (j *CatalogMirrorJob) Run() error {
+	ran, err := j.LockRepository.TryWithLock(j.Name(), j.sync)
+	if err == nil && !ran {
+		log.Printf("job %s is running on another instance, skipping", j.Name())
+	}
+	return err
+}
+
+func (j *CatalogMirrorJob) sync() error {
+	products, err := j.ProductService.GetProducts()
+	if err != nil {
+		return err
+	}
+	// an empty pull is much more likely an upstream hiccup than an empty catalog
+	if len(products) == 0 {
+		return errors.New("products api returned an empty catalog")
+	}
+
+	previous, err := j.ProductRepository.ListAll()
+	if err != nil {
+		return err
+	}
+
+	removedIDs, changes := diffCatalog(previous, products, time.Now())
+	if err := j.ProductRepository.SyncCatalog(products, removedIDs, changes); err != nil {
+		return err
+	}
+
+	if len(changes) > 0 {
+		log.Printf("catalog mirror recorded %d changes", len(changes))
+	}
+	return nil
+}
+
+// diffCatalog compares the stored products with a fresh pull, returning the
+// products that disappeared upstream and the changelog entries.
+func diffCatalog(previous []models.Product, current []models.Product, now time.Time) ([]int32, []models.ProductChange) {
+	known := make(map[int32]models.Product, len(previous))
+	for _, p := range previous {
+		known[p.ID] = p
+	}
+
+	var changes []models.ProductChange
+	seen := make(map[int32]bool, len(current))
+	for _, p := range current {
+		seen[p.ID] = true
+
+		old, ok := known[p.ID]
+		switch {
+		case !ok:
+			changes = append(changes, models.ProductChange{ProductID: p.ID, Type: models.ProductChangeCreated, ChangedAt: now})
+		case old.Discontinued:
+			changes = append(changes, models.ProductChange{ProductID: p.ID, Type: models.ProductChangeRestored, ChangedAt: now})
+		}
+		if !ok {
+			continue
+		}
+
+		for _, field := range productFieldChanges(old, p) {
+			field.ProductID = p.ID
+			field.Type = models.ProductChangeUpdated
+			field.ChangedAt = now
+			changes = append(changes, field)
+		}
+	}
+
+	var removedIDs []int32
+	for _, p := range previous {
+		if seen[p.ID] || p.Discontinued {
+			continue
+		}
+		removedIDs = append(removedIDs, p.ID)
+		changes = append(changes, models.ProductChange{ProductID: p.ID, Type: models.ProductChangeRemoved, ChangedAt: now})
+	}
+
+	return removedIDs, changes
+}
+
+func productFieldChanges(old models.Product, current models.Product) []models.ProductChange {
+	fields := []struct {
+		name     string
+		old, new string
+	}{
+		{"title", old.Title, current.Title},
+		{"price", old.Price.String(), current.Price.String()},
+		{"description", old.Description, current.Description},
+		{"category", old.Category, current.Category},
+		{"image", old.Image, current.Image},
+	}
+
+	var changes []models.ProductChange
+	for _, f := range fields {
+		if f.old != f.new {
+			changes = append(changes, models.ProductChange{Field: f.name, OldValue: f.old, NewValue: f.new})
+		}
+	}
+	return changes
+}
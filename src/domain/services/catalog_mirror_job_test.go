@@ -0,0 +1,103 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+// lockAcquired makes the lock mock run the guarded function
+func lockAcquired(name string, fn func() error) (bool, error) {
+	return true, fn()
+}
+
+func TestCatalogMirrorJob_SyncsCatalogWithChanges(t *testing.T) {
+	lockRepo := new(mocks.LockQuerier)
+	productRepo := new(mocks.ProductQuerier)
+	productSvc := new(mocks.ProductServicer)
+
+	stored := []models.Product{{ID: 1, Title: "Jacket", Price: models.NewMoney(1000, "USD")}, {ID: 2, Title: "Ring"}}
+	products := []models.Product{{ID: 1, Title: "Jacket", Price: models.NewMoney(850, "USD")}, {ID: 3, Title: "Bag"}}
+
+	lockRepo.On("TryWithLock", "catalog-mirror", mock.Anything).Return(lockAcquired)
+	productSvc.On("GetProducts").Return(products, nil)
+	productRepo.On("ListAll").Return(stored, nil)
+	productRepo.On("SyncCatalog", products, []int32{2}, mock.MatchedBy(func(changes []models.ProductChange) bool {
+		return len(changes) == 3
+	})).Return(nil)
+
+	err := NewCatalogMirrorJob(lockRepo, productRepo, productSvc).Run()
+
+	assert.NoError(t, err)
+	lockRepo.AssertExpectations(t)
+	productRepo.AssertExpectations(t)
+	productSvc.AssertExpectations(t)
+}
+
+func TestCatalogMirrorJob_SkipsWhenLocked(t *testing.T) {
+	lockRepo := new(mocks.LockQuerier)
+	productRepo := new(mocks.ProductQuerier)
+	productSvc := new(mocks.ProductServicer)
+
+	lockRepo.On("TryWithLock", "catalog-mirror", mock.Anything).Return(false, nil)
+
+	err := NewCatalogMirrorJob(lockRepo, productRepo, productSvc).Run()
+
+	assert.NoError(t, err)
+	productSvc.AssertNotCalled(t, "GetProducts")
+}
+
+func TestCatalogMirrorJob_UpstreamErrors(t *testing.T) {
+	for name, result := range map[string][]models.Product{"error": nil, "empty catalog": {}} {
+		t.Run(name, func(t *testing.T) {
+			lockRepo := new(mocks.LockQuerier)
+			productRepo := new(mocks.ProductQuerier)
+			productSvc := new(mocks.ProductServicer)
+
+			var upstreamErr error
+			if result == nil {
+				upstreamErr = errors.New("api down")
+			}
+			lockRepo.On("TryWithLock", "catalog-mirror", mock.Anything).Return(lockAcquired)
+			productSvc.On("GetProducts").Return(result, upstreamErr)
+
+			err := NewCatalogMirrorJob(lockRepo, productRepo, productSvc).Run()
+
+			assert.Error(t, err)
+			productRepo.AssertNotCalled(t, "SyncCatalog", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestDiffCatalog(t *testing.T) {
+	now := time.Now()
+	previous := []models.Product{
+		{ID: 1, Title: "Jacket", Price: models.NewMoney(1000, "USD"), Category: "men's clothing", Image: "a.png"},
+		{ID: 2, Title: "Ring"},
+		{ID: 3, Title: "Old Bag", Discontinued: true},
+		{ID: 4, Title: "Gone", Discontinued: true},
+	}
+	current := []models.Product{
+		{ID: 1, Title: "Warm Jacket", Price: models.NewMoney(1000, "USD"), Category: "women's clothing", Image: "b.png"},
+		{ID: 3, Title: "Old Bag"},
+		{ID: 5, Title: "New"},
+	}
+
+	removedIDs, changes := diffCatalog(previous, current, now)
+
+	assert.Equal(t, []int32{2}, removedIDs)
+	assert.Equal(t, []models.ProductChange{
+		{ProductID: 1, Type: models.ProductChangeUpdated, Field: "title", OldValue: "Jacket", NewValue: "Warm Jacket", ChangedAt: now},
+		{ProductID: 1, Type: models.ProductChangeUpdated, Field: "category", OldValue: "men's clothing", NewValue: "women's clothing", ChangedAt: now},
+		{ProductID: 1, Type: models.ProductChangeUpdated, Field: "image", OldValue: "a.png", NewValue: "b.png", ChangedAt: now},
+		{ProductID: 3, Type: models.ProductChangeRestored, ChangedAt: now},
+		{ProductID: 5, Type: models.ProductChangeCreated, ChangedAt: now},
+		{ProductID: 2, Type: models.ProductChangeRemoved, ChangedAt: now},
+	}, changes)
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+type ChangeFeedService struct {
+	ChangeEventRepository querier.ChangeEventQuerier
+}
+
+func NewChangeFeedService(changeEventRepository querier.ChangeEventQuerier) servicers.ChangeFeedServicer {
+	return &ChangeFeedService{ChangeEventRepository: changeEventRepository}
+}
+
+// ListChanges returns the changes after the cursor. When there are none it
+// waits up to wait (capped to CHANGE_FEED_MAX_WAIT) for new ones, returning
+// an empty page when the time is up or ctx is done.
+func (s *ChangeFeedService) ListChanges(ctx context.Context, cursor string, limit int, wait time.Duration) (*models.ChangeFeedPage, error) {
+	position, err := models.ParseChangeCursor(cursor)
+	if err != nil {
+		return nil, &exceptions.BadRequestError{Reason: err.Error()}
+	}
+	if !position.IsZero() {
+		pruned, err := s.ChangeEventRepository.PrunedThrough()
+		if err != nil {
+			return nil, err
+		}
+		if position.Before(pruned) {
+			return nil, &exceptions.GoneError{
+				Reason: "the cursor is older than the change feed retention, mirror the data again and start without a cursor",
+			}
+		}
+	}
+
+	if wait > config.CHANGE_FEED_MAX_WAIT {
+		wait = config.CHANGE_FEED_MAX_WAIT
+	}
+	deadline := time.Now().Add(wait)
+	for {
+		events, err := s.ChangeEventRepository.ListAfter(position, limit+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) > 0 || !time.Now().Before(deadline) {
+			return changeFeedPage(position, events, limit), nil
+		}
+
+		timer := time.NewTimer(min(config.CHANGE_FEED_POLL_INTERVAL, time.Until(deadline)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return changeFeedPage(position, nil, limit), nil
+		case <-timer.C:
+		}
+	}
+}
+
+func changeFeedPage(position models.ChangeCursor, events []models.ChangeEvent, limit int) *models.ChangeFeedPage {
+	page := &models.ChangeFeedPage{Changes: []models.ChangeEvent{}, NextCursor: position.String()}
+	if len(events) > limit {
+		page.HasMore = true
+		events = events[:limit]
+	}
+	for i := range events {
+		events[i].Cursor = events[i].Position().String()
+	}
+	if len(events) > 0 {
+		page.Changes = events
+		page.NextCursor = events[len(events)-1].Cursor
+	}
+	return page
+}
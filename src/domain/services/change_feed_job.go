@@ -0,0 +1,38 @@
+package services
+
+import (
+	"log"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/infrastructure/config"
+)
+
+// ChangeFeedCleanupJob removes the changes past the feed retention
+type ChangeFeedCleanupJob struct {
+	ChangeEventRepository querier.ChangeEventQuerier
+}
+
+func NewChangeFeedCleanupJob(changeEventRepository querier.ChangeEventQuerier) servicers.Job {
+	return &ChangeFeedCleanupJob{ChangeEventRepository: changeEventRepository}
+}
+
+func (j *ChangeFeedCleanupJob) Name() string {
+	return "change-feed-cleanup"
+}
+
+func (j *ChangeFeedCleanupJob) Interval() time.Duration {
+	return config.CHANGE_FEED_CLEANUP_INTERVAL
+}
+
+func (j *ChangeFeedCleanupJob) Run() error {
+	pruned, err := j.ChangeEventRepository.Prune(time.Now().Add(-config.CHANGE_FEED_RETENTION))
+	if err != nil {
+		return err
+	}
+	if pruned > 0 {
+		log.Printf("pruned %d changes past the feed retention", pruned)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func changeEvents(txID int64, ids ...uint64) []models.ChangeEvent {
+	events := make([]models.ChangeEvent, len(ids))
+	for i, id := range ids {
+		events[i] = models.ChangeEvent{TxID: txID, ID: id, Entity: models.ChangeEntityCustomer, Action: models.ChangeCreated}
+	}
+	return events
+}
+
+func setChangeFeedPollInterval(t *testing.T, interval time.Duration) {
+	previous := config.CHANGE_FEED_POLL_INTERVAL
+	config.CHANGE_FEED_POLL_INTERVAL = interval
+	t.Cleanup(func() { config.CHANGE_FEED_POLL_INTERVAL = previous })
+}
+
+func TestListChanges_PageFromCursor(t *testing.T) {
+	repo := new(mocks.ChangeEventQuerier)
+	service := NewChangeFeedService(repo)
+
+	repo.On("PrunedThrough").Return(models.ChangeCursor{TxID: 5, ID: 1}, nil)
+	repo.On("ListAfter", models.ChangeCursor{TxID: 10, ID: 3}, 3).Return(changeEvents(11, 4, 5, 6), nil)
+
+	page, err := service.ListChanges(context.Background(), "10-3", 2, 0)
+
+	assert.NoError(t, err)
+	assert.True(t, page.HasMore)
+	assert.Len(t, page.Changes, 2)
+	assert.Equal(t, "11-4", page.Changes[0].Cursor)
+	assert.Equal(t, "11-5", page.NextCursor)
+}
+
+func TestListChanges_EmptyKeepsCursor(t *testing.T) {
+	repo := new(mocks.ChangeEventQuerier)
+	service := NewChangeFeedService(repo)
+
+	repo.On("ListAfter", models.ChangeCursor{}, 101).Return([]models.ChangeEvent{}, nil)
+
+	page, err := service.ListChanges(context.Background(), "", 100, 0)
+
+	assert.NoError(t, err)
+	assert.Empty(t, page.Changes)
+	assert.False(t, page.HasMore)
+	assert.Equal(t, "", page.NextCursor)
+	// Starting from the beginning doesn't check the retention
+	repo.AssertNotCalled(t, "PrunedThrough")
+}
+
+func TestListChanges_InvalidCursor(t *testing.T) {
+	service := NewChangeFeedService(new(mocks.ChangeEventQuerier))
+
+	_, err := service.ListChanges(context.Background(), "yesterday", 100, 0)
+
+	var badRequestErr *exceptions.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+}
+
+func TestListChanges_CursorPastRetention(t *testing.T) {
+	repo := new(mocks.ChangeEventQuerier)
+	service := NewChangeFeedService(repo)
+
+	repo.On("PrunedThrough").Return(models.ChangeCursor{TxID: 20, ID: 9}, nil)
+
+	_, err := service.ListChanges(context.Background(), "19-30", 100, 0)
+
+	var goneErr *exceptions.GoneError
+	assert.ErrorAs(t, err, &goneErr)
+	repo.AssertNotCalled(t, "ListAfter", mock.Anything, mock.Anything)
+}
+
+func TestListChanges_LongPollReturnsNewChanges(t *testing.T) {
+	repo := new(mocks.ChangeEventQuerier)
+	service := NewChangeFeedService(repo)
+	setChangeFeedPollInterval(t, time.Millisecond)
+
+	cursor := models.ChangeCursor{TxID: 10, ID: 3}
+	repo.On("PrunedThrough").Return(models.ChangeCursor{}, nil)
+	repo.On("ListAfter", cursor, 101).Return([]models.ChangeEvent{}, nil).Twice()
+	repo.On("ListAfter", cursor, 101).Return(changeEvents(12, 8), nil).Once()
+
+	page, err := service.ListChanges(context.Background(), "10-3", 100, 10*time.Second)
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Changes, 1)
+	assert.Equal(t, "12-8", page.NextCursor)
+	repo.AssertExpectations(t)
+}
+
+func TestListChanges_LongPollTimesOut(t *testing.T) {
+	repo := new(mocks.ChangeEventQuerier)
+	service := NewChangeFeedService(repo)
+	setChangeFeedPollInterval(t, time.Millisecond)
+
+	repo.On("PrunedThrough").Return(models.ChangeCursor{}, nil)
+	repo.On("ListAfter", mock.Anything, mock.Anything).Return([]models.ChangeEvent{}, nil)
+
+	start := time.Now()
+	page, err := service.ListChanges(context.Background(), "10-3", 100, 20*time.Millisecond)
+
+	assert.NoError(t, err)
+	assert.Empty(t, page.Changes)
+	assert.Equal(t, "10-3", page.NextCursor)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+}
+
+func TestListChanges_LongPollStopsWithTheRequest(t *testing.T) {
+	repo := new(mocks.ChangeEventQuerier)
+	service := NewChangeFeedService(repo)
+	setChangeFeedPollInterval(t, time.Hour)
+
+	repo.On("ListAfter", mock.Anything, mock.Anything).Return([]models.ChangeEvent{}, nil).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	page, err := service.ListChanges(ctx, "", 100, 10*time.Second)
+
+	assert.NoError(t, err)
+	assert.Empty(t, page.Changes)
+	repo.AssertExpectations(t)
+}
+
+func TestChangeFeedCleanupJob_PrunesPastRetention(t *testing.T) {
+	repo := new(mocks.ChangeEventQuerier)
+	job := NewChangeFeedCleanupJob(repo)
+
+	before := time.Now().Add(-config.CHANGE_FEED_RETENTION)
+	repo.On("Prune", mock.MatchedBy(func(cutoff time.Time) bool {
+		return !cutoff.Before(before) && cutoff.Before(time.Now().Add(-config.CHANGE_FEED_RETENTION).Add(time.Second))
+	})).Return(int64(3), nil)
+
+	assert.NoError(t, job.Run())
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+const rateDateLayout = "2006-01-02"
+
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+type CurrencyService struct {
+	rateRepository querier.ExchangeRateQuerier
+}
+
+func NewCurrencyService(rateRepository querier.ExchangeRateQuerier) services.CurrencyServicer {
+	return &CurrencyService{rateRepository: rateRepository}
+}
+
+func (s *CurrencyService) ListRates() ([]models.ExchangeRate, error) {
+	return s.rateRepository.List()
+}
+
+func (s *CurrencyService) SetRate(rate *models.ExchangeRate) error {
+	rate.Currency = strings.ToUpper(rate.Currency)
+	if err := validateRate(rate); err != nil {
+		return &exceptions.InvalidEntityError{Reason: err.Error()}
+	}
+	return s.rateRepository.Upsert(rate)
+}
+
+func (s *CurrencyService) DeleteRate(currency string) error {
+	rate, err := s.rateRepository.Get(strings.ToUpper(currency))
+	if err != nil {
+		return err
+	}
+	if rate == nil {
+		return &exceptions.NotFoundEntityError{Reason: "exchange rate not found"}
+	}
+	return s.rateRepository.Delete(rate.Currency)
+}
+
+// ImportRates reads a "currency,rate,rate_date" CSV, the header line is
+// optional. Nothing is saved when any line is invalid or a currency is
+// repeated.
+func (s *CurrencyService) ImportRates(file io.Reader) (int, error) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	var rates []models.ExchangeRate
+	var problems []string
+	currencyLines := map[string]int{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, &exceptions.InvalidEntityError{Reason: err.Error()}
+		}
+		if line == 1 && strings.EqualFold(record[0], "currency") {
+			continue
+		}
+
+		rate, err := parseRateRecord(record)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: %s", line, err))
+			continue
+		}
+		if first, found := currencyLines[rate.Currency]; found {
+			problems = append(problems, fmt.Sprintf("line %d: %s repeats line %d", line, rate.Currency, first))
+			continue
+		}
+		currencyLines[rate.Currency] = line
+		rates = append(rates, *rate)
+	}
+
+	if len(problems) > 0 {
+		return 0, &exceptions.InvalidEntityError{Reason: strings.Join(problems, "; ")}
+	}
+	if len(rates) == 0 {
+		return 0, &exceptions.InvalidEntityError{Reason: "the file has no exchange rates"}
+	}
+
+	if err := s.rateRepository.UpsertMany(rates); err != nil {
+		return 0, err
+	}
+	return len(rates), nil
+}
+
+// ConvertProducts fills the converted price of the products. Prices in the
+// catalog currency are returned as they are, with a rate of 1.
+func (s *CurrencyService) ConvertProducts(currency string, products ...*models.Product) error {
+	currency = strings.ToUpper(currency)
+	if !currencyCodePattern.MatchString(currency) {
+		return &exceptions.BadRequestError{Reason: "invalid currency code"}
+	}
+
+	rate := &models.ExchangeRate{Currency: currency, Rate: 1, RateDate: today()}
+	if currency != models.DefaultCurrency {
+		var err error
+		rate, err = s.rateRepository.Get(currency)
+		if err != nil {
+			return err
+		}
+		if rate == nil {
+			return &exceptions.BadRequestError{Reason: fmt.Sprintf("no exchange rate for %s", currency)}
+		}
+	}
+
+	// the shortest decimal form of the rate is exactly what was stored
+	multiplier, _ := new(big.Rat).SetString(strconv.FormatFloat(rate.Rate, 'f', -1, 64))
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		converted := new(big.Rat).Mul(product.Price.Rat(), multiplier)
+		product.ConvertedPrice = &models.ConvertedPrice{
+			Price:    models.MoneyFromRat(converted, currency),
+			Currency: currency,
+			Rate:     rate.Rate,
+			RateDate: rate.RateDate,
+		}
+	}
+	return nil
+}
+
+func parseRateRecord(record []string) (*models.ExchangeRate, error) {
+	rateValue, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate %q", record[1])
+	}
+	rateDate, err := time.Parse(rateDateLayout, strings.TrimSpace(record[2]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate date %q, expected YYYY-MM-DD", record[2])
+	}
+
+	rate := &models.ExchangeRate{
+		Currency: strings.ToUpper(strings.TrimSpace(record[0])),
+		Rate:     rateValue,
+		RateDate: rateDate,
+	}
+	if err := validateRate(rate); err != nil {
+		return nil, err
+	}
+	return rate, nil
+}
+
+func validateRate(rate *models.ExchangeRate) error {
+	if !currencyCodePattern.MatchString(rate.Currency) {
+		return fmt.Errorf("invalid currency code %q", rate.Currency)
+	}
+	if rate.Currency == models.DefaultCurrency {
+		return fmt.Errorf("%s is the catalog currency", rate.Currency)
+	}
+	if rate.Rate <= 0 {
+		return errors.New("rate must be greater than 0")
+	}
+	if rate.RateDate.IsZero() {
+		return errors.New("rate date is required")
+	}
+	return nil
+}
+
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
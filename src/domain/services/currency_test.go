@@ -0,0 +1,125 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func TestConvertProducts_RoundsToTargetCurrency(t *testing.T) {
+	rateRepo := new(mocks.ExchangeRateQuerier)
+	rateDate := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	rateRepo.On("Get", "BRL").Return(&models.ExchangeRate{Currency: "BRL", Rate: 5.4321, RateDate: rateDate}, nil)
+	rateRepo.On("Get", "JPY").Return(&models.ExchangeRate{Currency: "JPY", Rate: 149.87, RateDate: rateDate}, nil)
+
+	service := NewCurrencyService(rateRepo)
+	product := &models.Product{ID: 1, Price: models.NewMoney(10995, "USD")}
+
+	err := service.ConvertProducts("brl", product, nil)
+	assert.NoError(t, err)
+	// 109.95 * 5.4321 = 597.259395
+	assert.Equal(t, models.NewMoney(59726, "BRL"), product.ConvertedPrice.Price)
+	assert.Equal(t, "BRL", product.ConvertedPrice.Currency)
+	assert.Equal(t, 5.4321, product.ConvertedPrice.Rate)
+	assert.Equal(t, rateDate, product.ConvertedPrice.RateDate)
+
+	err = service.ConvertProducts("JPY", product)
+	assert.NoError(t, err)
+	// 109.95 * 149.87 = 16478.2065
+	assert.Equal(t, models.NewMoney(16478, "JPY"), product.ConvertedPrice.Price)
+	rateRepo.AssertExpectations(t)
+}
+
+func TestConvertProducts_CatalogCurrency(t *testing.T) {
+	rateRepo := new(mocks.ExchangeRateQuerier)
+	product := &models.Product{ID: 1, Price: models.NewMoney(10995, "USD")}
+
+	err := NewCurrencyService(rateRepo).ConvertProducts("USD", product)
+
+	assert.NoError(t, err)
+	assert.Equal(t, product.Price, product.ConvertedPrice.Price)
+	assert.Equal(t, float64(1), product.ConvertedPrice.Rate)
+	rateRepo.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func TestConvertProducts_UnsupportedCurrency(t *testing.T) {
+	rateRepo := new(mocks.ExchangeRateQuerier)
+	rateRepo.On("Get", "EUR").Return(nil, nil)
+	service := NewCurrencyService(rateRepo)
+
+	err := service.ConvertProducts("EUR", &models.Product{})
+	assert.IsType(t, &exceptions.BadRequestError{}, err)
+
+	err = service.ConvertProducts("euro", &models.Product{})
+	assert.IsType(t, &exceptions.BadRequestError{}, err)
+}
+
+func TestImportRates_Success(t *testing.T) {
+	rateRepo := new(mocks.ExchangeRateQuerier)
+	rateRepo.On("UpsertMany", []models.ExchangeRate{
+		{Currency: "BRL", Rate: 5.4321, RateDate: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		{Currency: "EUR", Rate: 0.92, RateDate: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+	}).Return(nil)
+
+	csv := "currency,rate,rate_date\nbrl,5.4321,2026-10-19\nEUR, 0.92, 2026-10-18\n"
+	imported, err := NewCurrencyService(rateRepo).ImportRates(strings.NewReader(csv))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, imported)
+	rateRepo.AssertExpectations(t)
+}
+
+func TestImportRates_InvalidLines(t *testing.T) {
+	rateRepo := new(mocks.ExchangeRateQuerier)
+
+	csv := "BRL,5.4321,2026-10-19\nEUR,-1,2026-10-18\nUSD,1,2026-10-18\nGBP,0.8,18/10/2026\n"
+	_, err := NewCurrencyService(rateRepo).ImportRates(strings.NewReader(csv))
+
+	assert.IsType(t, &exceptions.InvalidEntityError{}, err)
+	assert.Contains(t, err.Error(), "line 2")
+	assert.Contains(t, err.Error(), "line 3")
+	assert.Contains(t, err.Error(), "line 4")
+	assert.NotContains(t, err.Error(), "line 1")
+	rateRepo.AssertNotCalled(t, "UpsertMany", mock.Anything)
+}
+
+func TestImportRates_RepeatedCurrency(t *testing.T) {
+	rateRepo := new(mocks.ExchangeRateQuerier)
+
+	csv := "currency,rate,rate_date\nBRL,5.4321,2026-10-19\nbrl,5.5,2026-10-20\n"
+	_, err := NewCurrencyService(rateRepo).ImportRates(strings.NewReader(csv))
+
+	assert.IsType(t, &exceptions.InvalidEntityError{}, err)
+	assert.EqualError(t, err, "line 3: BRL repeats line 2")
+	rateRepo.AssertNotCalled(t, "UpsertMany", mock.Anything)
+}
+
+func TestSetRate(t *testing.T) {
+	rateRepo := new(mocks.ExchangeRateQuerier)
+	rate := &models.ExchangeRate{Currency: "brl", Rate: 5.2, RateDate: time.Now()}
+	rateRepo.On("Upsert", rate).Return(nil)
+	service := NewCurrencyService(rateRepo)
+
+	assert.NoError(t, service.SetRate(rate))
+	assert.Equal(t, "BRL", rate.Currency)
+
+	err := service.SetRate(&models.ExchangeRate{Currency: "USD", Rate: 1, RateDate: time.Now()})
+	assert.IsType(t, &exceptions.InvalidEntityError{}, err)
+}
+
+func TestDeleteRate_NotFound(t *testing.T) {
+	rateRepo := new(mocks.ExchangeRateQuerier)
+	rateRepo.On("Get", "EUR").Return(nil, nil)
+
+	err := NewCurrencyService(rateRepo).DeleteRate("eur")
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	rateRepo.AssertNotCalled(t, "Delete", mock.Anything)
+}
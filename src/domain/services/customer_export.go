@@ -0,0 +1,244 @@
+package services
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+// customerExportBatchSize is the number of rows fetched from the cursor at a
+// time, the output is flushed after each batch
+const customerExportBatchSize = 1000
+
+var customerExportCSVHeader = []string{
+	"customer_id", "name", "email", "customer_created_at", "customer_updated_at",
+	"product_id", "title", "category", "status", "quantity", "added_price", "added_at", "deleted_at",
+}
+
+type CustomerExportService struct {
+	ExportRepository querier.CustomerExportQuerier
+}
+
+func NewCustomerExportService(exportRepository querier.CustomerExportQuerier) servicers.CustomerExportServicer {
+	return &CustomerExportService{ExportRepository: exportRepository}
+}
+
+// ExportCustomers streams the customers with their wishlists to w and
+// returns how many were written. The ndjson export has a line per customer,
+// the csv export a line per wishlist item with the customer columns repeated.
+// Nothing is written when the export fails before the first batch, a later
+// failure leaves the output truncated.
+//
+// An incremental export reads the change feed, so updated_since must be
+// within CHANGE_FEED_RETENTION, older changes may have been pruned.
+func (es *CustomerExportService) ExportCustomers(w io.Writer, options models.CustomerExportOptions) (int, error) {
+	if options.UpdatedSince != nil && options.UpdatedSince.Before(time.Now().Add(-config.CHANGE_FEED_RETENTION)) {
+		return 0, &exceptions.GoneError{
+			Reason: "updated_since is older than the change feed retention, run a full export",
+		}
+	}
+
+	var gzipWriter *gzip.Writer
+	if options.Gzip {
+		gzipWriter = gzip.NewWriter(w)
+	}
+	out := bufio.NewWriter(w)
+	if gzipWriter != nil {
+		out = bufio.NewWriter(gzipWriter)
+	}
+
+	var encoder customerEncoder
+	if options.Format == models.CustomerExportCSV {
+		encoder = newCSVCustomerEncoder(out)
+	} else {
+		encoder = &ndjsonCustomerEncoder{encoder: json.NewEncoder(out)}
+	}
+
+	count := 0
+	var current *models.ExportedCustomer
+	err := es.ExportRepository.StreamCustomers(options.UpdatedSince, customerExportBatchSize,
+		func(rows []models.CustomerExportRow) error {
+			for _, row := range rows {
+				if current == nil || current.ID != row.CustomerID {
+					if current != nil {
+						if err := encoder.Customer(current); err != nil {
+							return err
+						}
+					}
+					current = exportedCustomer(row)
+					count++
+				}
+				if err := encoder.Row(row); err != nil {
+					return err
+				}
+				if row.ProductID != nil {
+					current.Wishlist = append(current.Wishlist, exportedWishlistItem(row))
+				}
+			}
+			return flushExport(w, out, gzipWriter, encoder)
+		})
+	if err != nil {
+		return count, err
+	}
+
+	if current != nil {
+		if err := encoder.Customer(current); err != nil {
+			return count, err
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		return count, err
+	}
+	if err := out.Flush(); err != nil {
+		return count, err
+	}
+	if gzipWriter != nil {
+		if err := gzipWriter.Close(); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// flushExport pushes the batch to the client, down to the http response
+// when w can be flushed
+func flushExport(w io.Writer, out *bufio.Writer, gzipWriter *gzip.Writer, encoder customerEncoder) error {
+	if err := encoder.Flush(); err != nil {
+		return err
+	}
+	if err := out.Flush(); err != nil {
+		return err
+	}
+	if gzipWriter != nil {
+		if err := gzipWriter.Flush(); err != nil {
+			return err
+		}
+	}
+	if flusher, ok := w.(interface{ Flush() }); ok {
+		flusher.Flush()
+	}
+	return nil
+}
+
+// customerEncoder writes the export, Row is called for every row and
+// Customer once the rows of the customer were read
+type customerEncoder interface {
+	Row(row models.CustomerExportRow) error
+	Customer(customer *models.ExportedCustomer) error
+	Flush() error
+}
+
+type ndjsonCustomerEncoder struct {
+	encoder *json.Encoder
+}
+
+func (e *ndjsonCustomerEncoder) Row(models.CustomerExportRow) error {
+	return nil
+}
+
+func (e *ndjsonCustomerEncoder) Customer(customer *models.ExportedCustomer) error {
+	return e.encoder.Encode(customer)
+}
+
+func (e *ndjsonCustomerEncoder) Flush() error {
+	return nil
+}
+
+type csvCustomerEncoder struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func newCSVCustomerEncoder(w io.Writer) *csvCustomerEncoder {
+	return &csvCustomerEncoder{writer: csv.NewWriter(w)}
+}
+
+func (e *csvCustomerEncoder) Row(row models.CustomerExportRow) error {
+	if !e.headerWritten {
+		e.headerWritten = true
+		if err := e.writer.Write(customerExportCSVHeader); err != nil {
+			return err
+		}
+	}
+
+	record := []string{
+		row.CustomerID.String(), row.Name, row.Email,
+		row.CreatedAt.UTC().Format(time.RFC3339), row.UpdatedAt.UTC().Format(time.RFC3339),
+		"", "", "", "", "", "", "", "",
+	}
+	if row.DeletedAt != nil {
+		record[12] = row.DeletedAt.UTC().Format(time.RFC3339)
+	}
+	if row.ProductID != nil {
+		item := exportedWishlistItem(row)
+		record[5] = strconv.Itoa(int(item.ProductID))
+		record[6] = item.Title
+		record[7] = item.Category
+		record[8] = item.Status
+		record[9] = strconv.Itoa(item.Quantity)
+		record[10] = item.AddedPrice.String()
+		record[11] = item.AddedAt.UTC().Format(time.RFC3339)
+	}
+	return e.writer.Write(record)
+}
+
+func (e *csvCustomerEncoder) Customer(*models.ExportedCustomer) error {
+	return nil
+}
+
+// Flush writes the header of an empty export too
+func (e *csvCustomerEncoder) Flush() error {
+	if !e.headerWritten {
+		e.headerWritten = true
+		if err := e.writer.Write(customerExportCSVHeader); err != nil {
+			return err
+		}
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func exportedCustomer(row models.CustomerExportRow) *models.ExportedCustomer {
+	return &models.ExportedCustomer{
+		ID:        row.CustomerID,
+		Name:      row.Name,
+		Email:     row.Email,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+		DeletedAt: row.DeletedAt,
+		Wishlist:  []models.ExportedWishlistItem{},
+	}
+}
+
+func exportedWishlistItem(row models.CustomerExportRow) models.ExportedWishlistItem {
+	item := models.ExportedWishlistItem{ProductID: *row.ProductID}
+	if row.Title != nil {
+		item.Title = *row.Title
+	}
+	if row.Category != nil {
+		item.Category = *row.Category
+	}
+	if row.Status != nil {
+		item.Status = *row.Status
+	}
+	if row.Quantity != nil {
+		item.Quantity = *row.Quantity
+	}
+	if row.AddedPriceAmount != nil && row.AddedPriceCurrency != nil {
+		item.AddedPrice = models.NewMoney(*row.AddedPriceAmount, *row.AddedPriceCurrency)
+	}
+	if row.AddedAt != nil {
+		item.AddedAt = *row.AddedAt
+	}
+	return item
+}
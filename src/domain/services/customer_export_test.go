@@ -0,0 +1,208 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func customerExportRow(customerID uuid.UUID, productID int32) models.CustomerExportRow {
+	row := models.CustomerExportRow{
+		CustomerID: customerID,
+		Name:       "Ana",
+		Email:      "ana@example.com",
+		CreatedAt:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		UpdatedAt:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	if productID == 0 {
+		return row
+	}
+	title, category, status, currency := "Mochila", "bags", "active", "BRL"
+	quantity, amount := 1, int64(10995)
+	addedAt := time.Date(2026, 2, 3, 4, 5, 6, 0, time.UTC)
+	row.ProductID = &productID
+	row.Title = &title
+	row.Category = &category
+	row.Status = &status
+	row.Quantity = &quantity
+	row.AddedPriceAmount = &amount
+	row.AddedPriceCurrency = &currency
+	row.AddedAt = &addedAt
+	return row
+}
+
+// streamBatches makes the repository mock hand the batches to the callback
+func streamBatches(exportRepo *mocks.CustomerExportQuerier, batches ...[]models.CustomerExportRow) {
+	exportRepo.On("StreamCustomers", mock.Anything, customerExportBatchSize, mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(2).(func([]models.CustomerExportRow) error)
+			for _, batch := range batches {
+				if err := fn(batch); err != nil {
+					return
+				}
+			}
+		}).Return(nil)
+}
+
+func TestExportCustomers_NDJSONGroupsRowsAcrossBatches(t *testing.T) {
+	exportRepo := new(mocks.CustomerExportQuerier)
+	service := NewCustomerExportService(exportRepo)
+
+	ana, bruno := uuid.New(), uuid.New()
+	streamBatches(exportRepo,
+		[]models.CustomerExportRow{customerExportRow(ana, 1), customerExportRow(ana, 2)},
+		[]models.CustomerExportRow{customerExportRow(ana, 3), customerExportRow(bruno, 0)},
+	)
+
+	var out bytes.Buffer
+	count, err := service.ExportCustomers(&out, models.CustomerExportOptions{Format: models.CustomerExportNDJSON})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	var customers []models.ExportedCustomer
+	scanner := bufio.NewScanner(&out)
+	for scanner.Scan() {
+		var customer models.ExportedCustomer
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &customer))
+		customers = append(customers, customer)
+	}
+	assert.Len(t, customers, 2)
+	assert.Equal(t, ana, customers[0].ID)
+	assert.Len(t, customers[0].Wishlist, 3)
+	assert.Equal(t, int32(3), customers[0].Wishlist[2].ProductID)
+	assert.Equal(t, bruno, customers[1].ID)
+	assert.Empty(t, customers[1].Wishlist)
+}
+
+func TestExportCustomers_CSVHasALinePerItem(t *testing.T) {
+	exportRepo := new(mocks.CustomerExportQuerier)
+	service := NewCustomerExportService(exportRepo)
+
+	ana, bruno := uuid.New(), uuid.New()
+	streamBatches(exportRepo, []models.CustomerExportRow{customerExportRow(ana, 1), customerExportRow(bruno, 0)})
+
+	var out bytes.Buffer
+	count, err := service.ExportCustomers(&out, models.CustomerExportOptions{Format: models.CustomerExportCSV})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	records, err := csv.NewReader(&out).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		customerExportCSVHeader,
+		{ana.String(), "Ana", "ana@example.com", "2026-01-02T03:04:05Z", "2026-01-02T03:04:05Z",
+			"1", "Mochila", "bags", "active", "1", "109.95", "2026-02-03T04:05:06Z", ""},
+		{bruno.String(), "Ana", "ana@example.com", "2026-01-02T03:04:05Z", "2026-01-02T03:04:05Z",
+			"", "", "", "", "", "", "", ""},
+	}, records)
+}
+
+func TestExportCustomers_EmptyCSVHasHeader(t *testing.T) {
+	exportRepo := new(mocks.CustomerExportQuerier)
+	service := NewCustomerExportService(exportRepo)
+	streamBatches(exportRepo)
+
+	var out bytes.Buffer
+	count, err := service.ExportCustomers(&out, models.CustomerExportOptions{Format: models.CustomerExportCSV})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.Equal(t, "customer_id,name,email,customer_created_at,customer_updated_at,product_id,title,category,"+
+		"status,quantity,added_price,added_at,deleted_at\n", out.String())
+}
+
+func TestExportCustomers_Gzip(t *testing.T) {
+	exportRepo := new(mocks.CustomerExportQuerier)
+	service := NewCustomerExportService(exportRepo)
+	streamBatches(exportRepo, []models.CustomerExportRow{customerExportRow(uuid.New(), 1)})
+
+	var out bytes.Buffer
+	_, err := service.ExportCustomers(&out, models.CustomerExportOptions{Format: models.CustomerExportNDJSON, Gzip: true})
+	assert.NoError(t, err)
+
+	reader, err := gzip.NewReader(&out)
+	assert.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	var customer models.ExportedCustomer
+	assert.NoError(t, json.Unmarshal(content, &customer))
+	assert.Len(t, customer.Wishlist, 1)
+}
+
+func TestExportCustomers_PassesUpdatedSince(t *testing.T) {
+	exportRepo := new(mocks.CustomerExportQuerier)
+	service := NewCustomerExportService(exportRepo)
+
+	since := time.Now().Add(-time.Hour)
+	exportRepo.On("StreamCustomers", &since, customerExportBatchSize, mock.Anything).Return(nil)
+
+	_, err := service.ExportCustomers(io.Discard, models.CustomerExportOptions{Format: models.CustomerExportNDJSON, UpdatedSince: &since})
+	assert.NoError(t, err)
+	exportRepo.AssertExpectations(t)
+}
+
+func TestExportCustomers_UpdatedSinceOlderThanRetention(t *testing.T) {
+	exportRepo := new(mocks.CustomerExportQuerier)
+	service := NewCustomerExportService(exportRepo)
+
+	since := time.Now().Add(-config.CHANGE_FEED_RETENTION - time.Hour)
+	var out bytes.Buffer
+	_, err := service.ExportCustomers(&out, models.CustomerExportOptions{Format: models.CustomerExportCSV, UpdatedSince: &since})
+
+	assert.IsType(t, &exceptions.GoneError{}, err)
+	assert.Empty(t, out.String())
+	exportRepo.AssertNotCalled(t, "StreamCustomers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExportCustomers_DeletedCustomer(t *testing.T) {
+	exportRepo := new(mocks.CustomerExportQuerier)
+	service := NewCustomerExportService(exportRepo)
+
+	bruno := uuid.New()
+	row := customerExportRow(bruno, 0)
+	deletedAt := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	row.Name, row.Email, row.DeletedAt = "", "", &deletedAt
+	streamBatches(exportRepo, []models.CustomerExportRow{row})
+
+	var out bytes.Buffer
+	_, err := service.ExportCustomers(&out, models.CustomerExportOptions{Format: models.CustomerExportNDJSON})
+	assert.NoError(t, err)
+	var customer models.ExportedCustomer
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &customer))
+	assert.Equal(t, bruno, customer.ID)
+	assert.Equal(t, &deletedAt, customer.DeletedAt)
+
+	out.Reset()
+	_, err = service.ExportCustomers(&out, models.CustomerExportOptions{Format: models.CustomerExportCSV})
+	assert.NoError(t, err)
+	records, err := csv.NewReader(&out).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-03-04T05:06:07Z", records[1][12])
+}
+
+func TestExportCustomers_RepositoryError(t *testing.T) {
+	exportRepo := new(mocks.CustomerExportQuerier)
+	service := NewCustomerExportService(exportRepo)
+	exportRepo.On("StreamCustomers", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("database is down"))
+
+	var out bytes.Buffer
+	_, err := service.ExportCustomers(&out, models.CustomerExportOptions{Format: models.CustomerExportCSV})
+
+	assert.EqualError(t, err, "database is down")
+	assert.Empty(t, out.String())
+}
@@ -0,0 +1,310 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+// customerImportCheckpoint is how many rows are processed between progress
+// saves, a job taken over after a crash redoes at most these rows
+const customerImportCheckpoint = 100
+
+type CustomerImportService struct {
+	ImportJobRepository querier.ImportJobQuerier
+	// CustomerRepository finds the customers a job taken over already created
+	CustomerRepository querier.CustomerQuerier
+	CustomerService    servicers.CustomerServicer
+	WishlistService    servicers.WishlistServicer
+	Validate           servicers.CustomerValidator
+}
+
+func NewCustomerImportService(importJobRepository querier.ImportJobQuerier,
+	customerRepository querier.CustomerQuerier,
+	customerService servicers.CustomerServicer,
+	wishlistService servicers.WishlistServicer,
+	validate servicers.CustomerValidator) servicers.CustomerImportServicer {
+	return &CustomerImportService{
+		ImportJobRepository: importJobRepository,
+		CustomerRepository:  customerRepository,
+		CustomerService:     customerService,
+		WishlistService:     wishlistService,
+		Validate:            validate,
+	}
+}
+
+// ImportCustomers checks the file and stores it as a pending job, the rows
+// are processed in the background by the customer import job
+func (s *CustomerImportService) ImportCustomers(filename string, file io.Reader) (*models.ImportJob, error) {
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := readCustomerImport(content)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, &exceptions.InvalidEntityError{Reason: "the file has no customers"}
+	}
+	if len(rows) > models.MaxCustomerImportRows {
+		return nil, &exceptions.InvalidEntityError{
+			Reason: fmt.Sprintf("the file has more than %d customers", models.MaxCustomerImportRows),
+		}
+	}
+
+	job := &models.ImportJob{
+		Type:      models.ImportJobCustomers,
+		Status:    models.ImportJobPending,
+		Filename:  filename,
+		TotalRows: len(rows),
+		File:      content,
+	}
+	if err := s.ImportJobRepository.Create(job); err != nil {
+		return nil, err
+	}
+	job.File = nil
+	return job, nil
+}
+
+func (s *CustomerImportService) GetJob(id string) (*models.ImportJob, error) {
+	job, err := s.ImportJobRepository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, &exceptions.NotFoundEntityError{Reason: "job not found"}
+	}
+	return job, nil
+}
+
+func (s *CustomerImportService) ListJobErrors(id string) ([]models.ImportJobError, error) {
+	if _, err := s.GetJob(id); err != nil {
+		return nil, err
+	}
+	return s.ImportJobRepository.ListErrors(id)
+}
+
+// ProcessPendingImports runs the claimable jobs one after the other until
+// none is left
+func (s *CustomerImportService) ProcessPendingImports() error {
+	for {
+		job, err := s.ImportJobRepository.ClaimNext(time.Now().Add(-config.CUSTOMER_IMPORT_STALE_TIME))
+		if err != nil || job == nil {
+			return err
+		}
+		if err := s.processImport(job); err != nil {
+			return fmt.Errorf("import job %s: %w", job.ID, err)
+		}
+	}
+}
+
+// processImport continues the job after its processed rows, saving the
+// progress every checkpoint. It stops when another worker took the job over.
+func (s *CustomerImportService) processImport(job *models.ImportJob) error {
+	rows, err := readCustomerImport(job.File)
+	if err != nil {
+		now := time.Now()
+		job.Status = models.ImportJobFailed
+		job.FinishedAt = &now
+		job.ErrorCount++
+		return s.saveProgress(job, []models.ImportJobError{{Error: err.Error()}})
+	}
+
+	// Repeated emails are found in the file, a customer of this job found
+	// by importRow can then only come from a row redone after a takeover.
+	firstRows := make(map[string]int, len(rows))
+	for i := len(rows) - 1; i >= 0; i-- {
+		firstRows[rows[i].Email] = i
+	}
+
+	var pending []models.ImportJobError
+	for job.ProcessedRows < len(rows) {
+		repeated := firstRows[rows[job.ProcessedRows].Email] != job.ProcessedRows
+		created, rowErrors := s.importRow(job, rows[job.ProcessedRows], repeated)
+		job.ProcessedRows++
+		if created {
+			job.CreatedRows++
+		} else {
+			job.FailedRows++
+		}
+		job.ErrorCount += len(rowErrors)
+		pending = append(pending, rowErrors...)
+
+		if job.ProcessedRows%customerImportCheckpoint == 0 && job.ProcessedRows < len(rows) {
+			if err := s.saveProgress(job, pending); err != nil {
+				if errors.Is(err, errImportLeaseLost) {
+					return nil
+				}
+				return err
+			}
+			pending = nil
+		}
+	}
+
+	now := time.Now()
+	job.Status = models.ImportJobCompleted
+	job.FinishedAt = &now
+	if err := s.saveProgress(job, pending); err != nil && !errors.Is(err, errImportLeaseLost) {
+		return err
+	}
+	return nil
+}
+
+var errImportLeaseLost = errors.New("import job was taken over by another worker")
+
+func (s *CustomerImportService) saveProgress(job *models.ImportJob, jobErrors []models.ImportJobError) error {
+	saved, err := s.ImportJobRepository.SaveProgress(job, jobErrors)
+	if err != nil {
+		return err
+	}
+	if !saved {
+		log.Printf("stopping import job %s: %s", job.ID, errImportLeaseLost)
+		return errImportLeaseLost
+	}
+	return nil
+}
+
+// importRow creates the customer the way the create customer request does
+// and wishlists the products, a product that fails doesn't undo the customer.
+// Rows redone after a takeover find their customer and wishlist the products
+// again, the ones already wishlisted are skipped.
+func (s *CustomerImportService) importRow(job *models.ImportJob, row models.CustomerImportRow, repeated bool) (bool, []models.ImportJobError) {
+	rowError := func(productID *int32, err error) models.ImportJobError {
+		return models.ImportJobError{Line: row.Line, Email: row.Email, ProductID: productID, Error: err.Error()}
+	}
+	if repeated {
+		return false, []models.ImportJobError{rowError(nil, &exceptions.EmailAlreadyRegisteredErr{
+			Reason: "this email is already registered",
+		})}
+	}
+
+	productIDs, err := parseImportProductIDs(row.ProductIDs)
+	if err != nil {
+		return false, []models.ImportJobError{rowError(nil, err)}
+	}
+	customer := &models.Customer{Name: row.Name, Email: row.Email, ImportJobID: &job.ID}
+	if err := s.Validate(customer); err != nil {
+		return false, []models.ImportJobError{rowError(nil, err)}
+	}
+	customer, err = s.createImportedCustomer(job, customer)
+	if err != nil {
+		return false, []models.ImportJobError{rowError(nil, err)}
+	}
+
+	var rowErrors []models.ImportJobError
+	customerID := customer.ID.String()
+	for _, productID := range productIDs {
+		_, err := s.WishlistService.WishlistProduct(productID, customerID, customerID, "")
+		var alreadyWishlisted *exceptions.AlreadyWishlistedErr
+		if err != nil && !errors.As(err, &alreadyWishlisted) {
+			rowErrors = append(rowErrors, rowError(&productID, err))
+		}
+	}
+	return true, rowErrors
+}
+
+// createImportedCustomer returns the customer the job created before a
+// takeover instead of reporting its email as registered
+func (s *CustomerImportService) createImportedCustomer(job *models.ImportJob, customer *models.Customer) (*models.Customer, error) {
+	err := s.CustomerService.CreateCustomer(customer)
+	var registeredErr *exceptions.EmailAlreadyRegisteredErr
+	if !errors.As(err, &registeredErr) {
+		return customer, err
+	}
+	existing, getErr := s.CustomerRepository.GetByEmail(customer.Email)
+	if getErr != nil {
+		return nil, getErr
+	}
+	if existing == nil || existing.ImportJobID == nil || *existing.ImportJobID != job.ID {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func parseImportProductIDs(value string) ([]int32, error) {
+	var productIDs []int32
+	for _, field := range strings.Split(value, "|") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(field, 10, 32)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid product id %q", field)
+		}
+		productIDs = append(productIDs, int32(id))
+	}
+	return productIDs, nil
+}
+
+// readCustomerImport reads the rows of a csv with a header, the name and
+// email columns are required and product_ids is optional
+func readCustomerImport(content []byte) ([]models.CustomerImportRow, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, &exceptions.InvalidEntityError{Reason: err.Error()}
+	}
+	nameColumn, emailColumn, productsColumn := -1, -1, -1
+	for i, column := range header {
+		switch strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))) {
+		case "name":
+			nameColumn = i
+		case "email":
+			emailColumn = i
+		case "product_ids":
+			productsColumn = i
+		}
+	}
+	if nameColumn < 0 || emailColumn < 0 {
+		return nil, &exceptions.InvalidEntityError{Reason: "the csv header needs the name and email columns"}
+	}
+
+	field := func(record []string, column int) string {
+		if column < 0 || column >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[column])
+	}
+	var rows []models.CustomerImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, &exceptions.InvalidEntityError{Reason: err.Error()}
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, models.CustomerImportRow{
+			Line:       line,
+			Name:       field(record, nameColumn),
+			Email:      field(record, emailColumn),
+			ProductIDs: field(record, productsColumn),
+		})
+	}
+	return rows, nil
+}
@@ -0,0 +1,29 @@
+package services
+
+import (
+	"time"
+
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/infrastructure/config"
+)
+
+// CustomerImportJob processes the uploaded customer imports
+type CustomerImportJob struct {
+	CustomerImportService servicers.CustomerImportServicer
+}
+
+func NewCustomerImportJob(customerImportService servicers.CustomerImportServicer) servicers.Job {
+	return &CustomerImportJob{CustomerImportService: customerImportService}
+}
+
+func (j *CustomerImportJob) Name() string {
+	return "customer-import"
+}
+
+func (j *CustomerImportJob) Interval() time.Duration {
+	return config.CUSTOMER_IMPORT_INTERVAL
+}
+
+func (j *CustomerImportJob) Run() error {
+	return j.CustomerImportService.ProcessPendingImports()
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func validateTestCustomer(customer *models.Customer) error {
+	if customer.Name == "" || !strings.Contains(customer.Email, "@") {
+		return errors.New("invalid customer")
+	}
+	return nil
+}
+
+func setupCustomerImportTest() (*CustomerImportService, *mocks.ImportJobQuerier, *mocks.CustomerServicer, *mocks.WishlistServicer) {
+	jobRepo := new(mocks.ImportJobQuerier)
+	customerService := new(mocks.CustomerServicer)
+	wishlistService := new(mocks.WishlistServicer)
+	service := NewCustomerImportService(jobRepo, new(mocks.CustomerQuerier), customerService, wishlistService,
+		validateTestCustomer)
+	return service.(*CustomerImportService), jobRepo, customerService, wishlistService
+}
+
+// savedProgress records a copy of the job on every SaveProgress
+func savedProgress(jobRepo *mocks.ImportJobQuerier) (*[]models.ImportJob, *[]models.ImportJobError) {
+	var saves []models.ImportJob
+	var jobErrors []models.ImportJobError
+	jobRepo.On("SaveProgress", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		saves = append(saves, *args.Get(0).(*models.ImportJob))
+		jobErrors = append(jobErrors, args.Get(1).([]models.ImportJobError)...)
+	}).Return(true, nil)
+	return &saves, &jobErrors
+}
+
+func TestImportCustomers_CreatesPendingJob(t *testing.T) {
+	service, jobRepo, _, _ := setupCustomerImportTest()
+
+	content := "\ufeffName,Email,Product_IDs\nAna,ana@ig.com,1|2\nBruno,bruno@ig.com,\n\n"
+	jobRepo.On("Create", mock.MatchedBy(func(job *models.ImportJob) bool {
+		return job.Status == models.ImportJobPending && job.Type == models.ImportJobCustomers &&
+			job.TotalRows == 2 && job.Filename == "partner.csv" && string(job.File) == content
+	})).Return(nil)
+
+	job, err := service.ImportCustomers("partner.csv", strings.NewReader(content))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, job.TotalRows)
+	assert.Nil(t, job.File)
+	jobRepo.AssertExpectations(t)
+}
+
+func TestImportCustomers_RejectsInvalidFiles(t *testing.T) {
+	service, jobRepo, _, _ := setupCustomerImportTest()
+
+	for _, content := range []string{"", "name,email\n", "name,phone\nAna,123\n", "name,email\n\"Ana,ana@ig.com\n"} {
+		_, err := service.ImportCustomers("partner.csv", strings.NewReader(content))
+		var invalidErr *exceptions.InvalidEntityError
+		assert.ErrorAs(t, err, &invalidErr, content)
+	}
+	jobRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestProcessPendingImports_ImportsRowsAndReportsErrors(t *testing.T) {
+	service, jobRepo, customerService, wishlistService := setupCustomerImportTest()
+
+	job := &models.ImportJob{BaseModel: models.BaseModel{ID: uuid.New()}, Status: models.ImportJobRunning, TotalRows: 5,
+		File: []byte("name,email,product_ids\nAna,ana@ig.com,1|2\n,nameless@ig.com,\nCarla,not-an-email,\n" +
+			"Davi,davi@ig.com,x\nEva,ana@ig.com,\n")}
+	jobRepo.On("ClaimNext", mock.Anything).Return(job, nil).Once()
+	jobRepo.On("ClaimNext", mock.Anything).Return(nil, nil).Once()
+	saves, jobErrors := savedProgress(jobRepo)
+
+	customerService.On("CreateCustomer", mock.MatchedBy(func(c *models.Customer) bool {
+		return c.Email == "ana@ig.com" && c.Name == "Ana"
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*models.Customer).ID = uuid.New()
+	}).Return(nil).Once()
+	wishlistService.On("WishlistProduct", int32(1), mock.Anything, mock.Anything, "").Return(&models.WishlistItem{}, nil)
+	wishlistService.On("WishlistProduct", int32(2), mock.Anything, mock.Anything, "").
+		Return(nil, &exceptions.NotFoundEntityError{Reason: "product not found"})
+
+	assert.NoError(t, service.ProcessPendingImports())
+
+	last := (*saves)[len(*saves)-1]
+	assert.Equal(t, models.ImportJobCompleted, last.Status)
+	assert.NotNil(t, last.FinishedAt)
+	assert.Equal(t, 5, last.ProcessedRows)
+	assert.Equal(t, 1, last.CreatedRows)
+	assert.Equal(t, 4, last.FailedRows)
+	assert.Equal(t, 5, last.ErrorCount)
+
+	lines := map[int]models.ImportJobError{}
+	for _, jobError := range *jobErrors {
+		lines[jobError.Line] = jobError
+	}
+	assert.Equal(t, int32(2), *lines[2].ProductID)
+	assert.Equal(t, "product not found", lines[2].Error)
+	assert.Equal(t, "invalid customer", lines[3].Error)
+	assert.Equal(t, "invalid customer", lines[4].Error)
+	assert.Equal(t, `invalid product id "x"`, lines[5].Error)
+	assert.Equal(t, "this email is already registered", lines[6].Error)
+	customerService.AssertExpectations(t)
+}
+
+func TestProcessPendingImports_RedoneRowsKeepTheirCustomer(t *testing.T) {
+	service, jobRepo, customerService, wishlistService := setupCustomerImportTest()
+	customerRepo := service.CustomerRepository.(*mocks.CustomerQuerier)
+
+	// The previous worker created Ana and wishlisted product 1 before the takeover
+	job := &models.ImportJob{BaseModel: models.BaseModel{ID: uuid.New()}, Status: models.ImportJobRunning, TotalRows: 2,
+		File: []byte("name,email,product_ids\nAna,ana@ig.com,1|2\nBruno,bruno@ig.com,\n")}
+	ana := &models.Customer{BaseModel: models.BaseModel{ID: uuid.New()}, Email: "ana@ig.com", ImportJobID: &job.ID}
+	jobRepo.On("ClaimNext", mock.Anything).Return(job, nil).Once()
+	jobRepo.On("ClaimNext", mock.Anything).Return(nil, nil).Once()
+	saves, jobErrors := savedProgress(jobRepo)
+
+	customerService.On("CreateCustomer", mock.MatchedBy(func(c *models.Customer) bool {
+		return c.Email == "ana@ig.com" && *c.ImportJobID == job.ID
+	})).Return(&exceptions.EmailAlreadyRegisteredErr{Reason: "this email is already registered"})
+	customerRepo.On("GetByEmail", "ana@ig.com").Return(ana, nil)
+	customerService.On("CreateCustomer", mock.MatchedBy(func(c *models.Customer) bool {
+		return c.Email == "bruno@ig.com"
+	})).Return(&exceptions.EmailAlreadyRegisteredErr{Reason: "this email is already registered"})
+	customerRepo.On("GetByEmail", "bruno@ig.com").Return(&models.Customer{Email: "bruno@ig.com"}, nil)
+	anaID := ana.ID.String()
+	wishlistService.On("WishlistProduct", int32(1), anaID, anaID, "").
+		Return(nil, &exceptions.AlreadyWishlistedErr{Reason: "product already in wishlist"})
+	wishlistService.On("WishlistProduct", int32(2), anaID, anaID, "").Return(&models.WishlistItem{}, nil)
+
+	assert.NoError(t, service.ProcessPendingImports())
+
+	last := (*saves)[len(*saves)-1]
+	assert.Equal(t, 1, last.CreatedRows)
+	assert.Equal(t, 1, last.FailedRows)
+	assert.Len(t, *jobErrors, 1)
+	assert.Equal(t, "bruno@ig.com", (*jobErrors)[0].Email)
+	wishlistService.AssertExpectations(t)
+}
+
+func TestProcessPendingImports_StopsWhenTheLeaseIsLost(t *testing.T) {
+	service, jobRepo, customerService, _ := setupCustomerImportTest()
+
+	var file strings.Builder
+	file.WriteString("name,email\n")
+	for i := 0; i < 250; i++ {
+		fmt.Fprintf(&file, "Customer %d,customer%d@ig.com\n", i, i)
+	}
+	job := &models.ImportJob{BaseModel: models.BaseModel{ID: uuid.New()}, Status: models.ImportJobRunning,
+		TotalRows: 250, File: []byte(file.String())}
+	jobRepo.On("ClaimNext", mock.Anything).Return(job, nil).Once()
+	jobRepo.On("ClaimNext", mock.Anything).Return(nil, nil).Once()
+	jobRepo.On("SaveProgress", mock.Anything, mock.Anything).Return(false, nil).Once()
+	customerService.On("CreateCustomer", mock.Anything).Return(nil)
+
+	assert.NoError(t, service.ProcessPendingImports())
+
+	customerService.AssertNumberOfCalls(t, "CreateCustomer", customerImportCheckpoint)
+	jobRepo.AssertNumberOfCalls(t, "SaveProgress", 1)
+}
+
+func TestProcessPendingImports_SavesProgressAndResumes(t *testing.T) {
+	service, jobRepo, customerService, _ := setupCustomerImportTest()
+
+	var file strings.Builder
+	file.WriteString("name,email\n")
+	for i := 0; i < 250; i++ {
+		fmt.Fprintf(&file, "Customer %d,customer%d@ig.com\n", i, i)
+	}
+	// A job taken over after a crash with the first 120 rows done
+	job := &models.ImportJob{BaseModel: models.BaseModel{ID: uuid.New()}, Status: models.ImportJobRunning,
+		TotalRows: 250, ProcessedRows: 120, CreatedRows: 120, File: []byte(file.String())}
+	jobRepo.On("ClaimNext", mock.Anything).Return(job, nil).Once()
+	jobRepo.On("ClaimNext", mock.Anything).Return(nil, nil).Once()
+	saves, _ := savedProgress(jobRepo)
+	customerService.On("CreateCustomer", mock.Anything).Return(nil)
+
+	assert.NoError(t, service.ProcessPendingImports())
+
+	customerService.AssertNumberOfCalls(t, "CreateCustomer", 130)
+	customerService.AssertNotCalled(t, "CreateCustomer", mock.MatchedBy(func(c *models.Customer) bool {
+		return c.Email == "customer119@ig.com"
+	}))
+	assert.Len(t, *saves, 2)
+	assert.Equal(t, 200, (*saves)[0].ProcessedRows)
+	assert.Equal(t, models.ImportJobRunning, (*saves)[0].Status)
+	assert.Equal(t, 250, (*saves)[1].CreatedRows)
+	assert.Equal(t, models.ImportJobCompleted, (*saves)[1].Status)
+}
+
+func TestProcessPendingImports_ClaimError(t *testing.T) {
+	service, jobRepo, _, _ := setupCustomerImportTest()
+	jobRepo.On("ClaimNext", mock.Anything).Return(nil, errors.New("database is down"))
+
+	assert.EqualError(t, service.ProcessPendingImports(), "database is down")
+}
+
+func TestListJobErrors_UnknownJob(t *testing.T) {
+	service, jobRepo, _, _ := setupCustomerImportTest()
+	jobID := uuid.New().String()
+	jobRepo.On("GetByID", jobID).Return(nil, nil)
+
+	_, err := service.ListJobErrors(jobID)
+
+	var notFoundErr *exceptions.NotFoundEntityError
+	assert.ErrorAs(t, err, &notFoundErr)
+	jobRepo.AssertNotCalled(t, "ListErrors", mock.Anything)
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+
 	"produtos-favoritos/src/domain/interfaces/services"
 	"produtos-favoritos/src/infrastructure/config"
 )
@@ -20,27 +23,53 @@ func NewFakeProductApiClientService(httpClient *http.Client) services.FakeProduc
 
 func (fp *FakeProductApiClientService) ListProducts() ([]byte, error) {
 	listProductsUrl := fmt.Sprintf("%s%s", config.PRODUCTS_BASE_URL, "/products")
-	request, _ := http.NewRequest("GET", listProductsUrl, nil)
-	response, err := fp.HTTP.Do(request)
-	if err != nil {
-		return nil, err
+	return fp.get(listProductsUrl)
+}
+
+func (fp *FakeProductApiClientService) GetProduct(productID int32) ([]byte, error) {
+	getProductUrl := fmt.Sprintf("%s%s%d", config.PRODUCTS_BASE_URL, "/products/", productID)
+	return fp.get(getProductUrl)
+}
+
+// QueryProducts lists products using the filters fakestore supports natively:
+// a category path, a result limit and the id sort direction (asc or desc).
+// Empty/zero values leave the corresponding filter out of the request.
+func (fp *FakeProductApiClientService) QueryProducts(category string, limit int, sort string) ([]byte, error) {
+	queryProductsUrl := fmt.Sprintf("%s%s", config.PRODUCTS_BASE_URL, "/products")
+	if category != "" {
+		queryProductsUrl = fmt.Sprintf("%s%s%s", queryProductsUrl, "/category/", url.PathEscape(category))
 	}
 
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
+	params := url.Values{}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	if sort != "" {
+		params.Set("sort", sort)
+	}
+	if len(params) > 0 {
+		queryProductsUrl = fmt.Sprintf("%s?%s", queryProductsUrl, params.Encode())
 	}
 
-	return body, err
+	return fp.get(queryProductsUrl)
 }
 
-func (fp *FakeProductApiClientService) GetProduct(productID int32) ([]byte, error) {
-	getProductUrl := fmt.Sprintf("%s%s%d", config.PRODUCTS_BASE_URL, "/products/", productID)
-	request, _ := http.NewRequest("GET", getProductUrl, nil)
+func (fp *FakeProductApiClientService) ListCategories() ([]byte, error) {
+	listCategoriesUrl := fmt.Sprintf("%s%s", config.PRODUCTS_BASE_URL, "/products/categories")
+	return fp.get(listCategoriesUrl)
+}
+
+func (fp *FakeProductApiClientService) get(requestUrl string) ([]byte, error) {
+	request, _ := http.NewRequest("GET", requestUrl, nil)
 	response, err := fp.HTTP.Do(request)
 	if err != nil {
 		return nil, err
 	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusInternalServerError {
+		return nil, fmt.Errorf("products api unavailable: status %d", response.StatusCode)
+	}
 
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
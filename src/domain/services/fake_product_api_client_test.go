@@ -114,6 +114,52 @@ func TestGetProduct_ReadBodyError(t *testing.T) {
 	assert.Nil(t, body)
 }
 
+// urlRecorder captures the requested url and answers with an empty list
+type urlRecorder struct {
+	url string
+}
+
+func (u *urlRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	u.url = req.URL.String()
+	return &http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(bytes.NewBufferString("[]")),
+	}, nil
+}
+
+func TestQueryProducts_BuildsNativeFilters(t *testing.T) {
+	config.PRODUCTS_BASE_URL = "http://fakeapi.test"
+	recorder := &urlRecorder{}
+
+	service := NewFakeProductApiClientService(&http.Client{Transport: recorder})
+	_, err := service.QueryProducts("men's clothing", 5, "desc")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "http://fakeapi.test/products/category/men%27s%20clothing?limit=5&sort=desc", recorder.url)
+}
+
+func TestQueryProducts_NoFilters(t *testing.T) {
+	config.PRODUCTS_BASE_URL = "http://fakeapi.test"
+	recorder := &urlRecorder{}
+
+	service := NewFakeProductApiClientService(&http.Client{Transport: recorder})
+	_, err := service.QueryProducts("", 0, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "http://fakeapi.test/products", recorder.url)
+}
+
+func TestListCategories_Success(t *testing.T) {
+	config.PRODUCTS_BASE_URL = "http://fakeapi.test"
+	client := makeHTTPClient(`["electronics","jewelery"]`, 200, nil)
+
+	service := NewFakeProductApiClientService(client)
+	body, err := service.ListCategories()
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `["electronics","jewelery"]`, string(body))
+}
+
 // errorReadCloser mocks Read error for response.Body
 type errorReadCloser struct{}
 
@@ -123,3 +169,14 @@ func (*errorReadCloser) Read(p []byte) (int, error) {
 func (*errorReadCloser) Close() error {
 	return nil
 }
+
+func TestGetProduct_ServerError(t *testing.T) {
+	config.PRODUCTS_BASE_URL = "http://fakeapi.test"
+	client := makeHTTPClient("<html>Bad Gateway</html>", 502, nil)
+
+	service := NewFakeProductApiClientService(client)
+	body, err := service.GetProduct(1)
+
+	assert.Error(t, err)
+	assert.Nil(t, body)
+}
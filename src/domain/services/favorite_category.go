@@ -0,0 +1,140 @@
+package services
+
+import (
+	"strings"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+type FavoriteCategoryService struct {
+	CustomerRepository         querier.CustomerQuerier
+	FavoriteCategoryRepository querier.FavoriteCategoryQuerier
+	ProductService             servicers.ProductServicer
+}
+
+func NewFavoriteCategoryService(customerRepository querier.CustomerQuerier,
+	favoriteCategoryRepository querier.FavoriteCategoryQuerier,
+	productService servicers.ProductServicer) servicers.FavoriteCategoryServicer {
+	return &FavoriteCategoryService{
+		CustomerRepository:         customerRepository,
+		FavoriteCategoryRepository: favoriteCategoryRepository,
+		ProductService:             productService,
+	}
+}
+
+func (fs *FavoriteCategoryService) ListCategories(customerID string) ([]models.FavoriteCategory, error) {
+	if _, err := fs.getCustomer(customerID); err != nil {
+		return nil, err
+	}
+	return fs.FavoriteCategoryRepository.List(customerID)
+}
+
+// FollowCategory adds a catalog category to the customer favorites, the name
+// is matched case insensitively and stored as the catalog spells it
+func (fs *FavoriteCategoryService) FollowCategory(customerID string, category string) (*models.FavoriteCategory, error) {
+	customer, err := fs.getCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := fs.ProductService.GetCategories()
+	if err != nil {
+		return nil, err
+	}
+	name, ok := catalogCategory(categories, category)
+	if !ok {
+		return nil, &exceptions.InvalidEntityError{
+			Reason: "unknown category, see /api/v1/products/categories",
+		}
+	}
+
+	now := time.Now()
+	favorite := &models.FavoriteCategory{
+		CustomerID: customer.ID,
+		Category:   name,
+		CheckedAt:  now,
+		CreatedAt:  now,
+	}
+	if err := fs.FavoriteCategoryRepository.Add(favorite); err != nil {
+		return nil, err
+	}
+	return favorite, nil
+}
+
+func (fs *FavoriteCategoryService) UnfollowCategory(customerID string, category string) error {
+	if _, err := fs.getCustomer(customerID); err != nil {
+		return err
+	}
+
+	favorites, err := fs.FavoriteCategoryRepository.List(customerID)
+	if err != nil {
+		return err
+	}
+	for _, favorite := range favorites {
+		if strings.EqualFold(favorite.Category, strings.TrimSpace(category)) {
+			_, err := fs.FavoriteCategoryRepository.Delete(customerID, favorite.Category)
+			return err
+		}
+	}
+	return &exceptions.NotFoundEntityError{
+		Reason: "category not in favorites",
+	}
+}
+
+// ListNewProducts returns the products that appeared in the favorite
+// categories since the last check, up to now. The checkpoint only moves when
+// the returned cursor is acknowledged, so a lost response loses nothing.
+func (fs *FavoriteCategoryService) ListNewProducts(customerID string) (*models.NewInFavoriteCategories, error) {
+	if _, err := fs.getCustomer(customerID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	products, err := fs.FavoriteCategoryRepository.ListNewProducts(customerID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.NewInFavoriteCategories{
+		Items:  products,
+		Cursor: now,
+	}, nil
+}
+
+// AcknowledgeNewProducts moves the checkpoint to the cursor of a listing. An
+// older cursor than the current checkpoint changes nothing.
+func (fs *FavoriteCategoryService) AcknowledgeNewProducts(customerID string, cursor time.Time) error {
+	if _, err := fs.getCustomer(customerID); err != nil {
+		return err
+	}
+	if cursor.After(time.Now()) {
+		return &exceptions.InvalidEntityError{
+			Reason: "the cursor can't be in the future",
+		}
+	}
+	return fs.FavoriteCategoryRepository.MarkChecked(customerID, cursor)
+}
+
+func (fs *FavoriteCategoryService) getCustomer(customerID string) (*models.Customer, error) {
+	customer, err := fs.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	return customer, nil
+}
+
+func catalogCategory(categories []string, name string) (string, bool) {
+	name = strings.TrimSpace(name)
+	for _, category := range categories {
+		if strings.EqualFold(category, name) {
+			return category, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func newFavoriteCategoryService() (*FavoriteCategoryService, *mocks.CustomerQuerier, *mocks.FavoriteCategoryQuerier, *mocks.ProductServicer) {
+	customerRepo := new(mocks.CustomerQuerier)
+	favoriteRepo := new(mocks.FavoriteCategoryQuerier)
+	productSvc := new(mocks.ProductServicer)
+	service := NewFavoriteCategoryService(customerRepo, favoriteRepo, productSvc).(*FavoriteCategoryService)
+	return service, customerRepo, favoriteRepo, productSvc
+}
+
+func TestFollowCategory_UsesCatalogSpelling(t *testing.T) {
+	service, customerRepo, favoriteRepo, productSvc := newFavoriteCategoryService()
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	productSvc.On("GetCategories").Return([]string{"electronics", "men's clothing"}, nil)
+	favoriteRepo.On("Add", mock.MatchedBy(func(favorite *models.FavoriteCategory) bool {
+		return favorite.CustomerID == customerID && favorite.Category == "men's clothing" && !favorite.CheckedAt.IsZero()
+	})).Return(nil)
+
+	favorite, err := service.FollowCategory(customerID.String(), "  Men's Clothing")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "men's clothing", favorite.Category)
+	favoriteRepo.AssertExpectations(t)
+}
+
+func TestFollowCategory_UnknownCategory(t *testing.T) {
+	service, customerRepo, favoriteRepo, productSvc := newFavoriteCategoryService()
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	productSvc.On("GetCategories").Return([]string{"electronics"}, nil)
+
+	_, err := service.FollowCategory(customerID.String(), "furniture")
+
+	assert.IsType(t, &exceptions.InvalidEntityError{}, err)
+	favoriteRepo.AssertNotCalled(t, "Add", mock.Anything)
+}
+
+func TestFollowCategory_CatalogUnavailable(t *testing.T) {
+	service, customerRepo, favoriteRepo, productSvc := newFavoriteCategoryService()
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	productSvc.On("GetCategories").Return(nil, errors.New("connection refused"))
+
+	_, err := service.FollowCategory(customerID.String(), "electronics")
+
+	assert.EqualError(t, err, "connection refused")
+	favoriteRepo.AssertNotCalled(t, "Add", mock.Anything)
+}
+
+func TestUnfollowCategory_NotFollowed(t *testing.T) {
+	service, customerRepo, favoriteRepo, _ := newFavoriteCategoryService()
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	favoriteRepo.On("List", customerID.String()).
+		Return([]models.FavoriteCategory{{CustomerID: customerID, Category: "jewelery"}}, nil)
+
+	err := service.UnfollowCategory(customerID.String(), "electronics")
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	favoriteRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestUnfollowCategory_Success(t *testing.T) {
+	service, customerRepo, favoriteRepo, _ := newFavoriteCategoryService()
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	favoriteRepo.On("List", customerID.String()).
+		Return([]models.FavoriteCategory{{CustomerID: customerID, Category: "jewelery"}}, nil)
+	favoriteRepo.On("Delete", customerID.String(), "jewelery").Return(true, nil)
+
+	err := service.UnfollowCategory(customerID.String(), "Jewelery")
+
+	assert.NoError(t, err)
+	favoriteRepo.AssertExpectations(t)
+}
+
+func TestListNewProducts_KeepsCheckpoint(t *testing.T) {
+	service, customerRepo, favoriteRepo, _ := newFavoriteCategoryService()
+
+	customerID := uuid.New()
+	products := []models.Product{*createProduct(7)}
+	var until time.Time
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	favoriteRepo.On("ListNewProducts", customerID.String(), mock.AnythingOfType("time.Time")).
+		Run(func(args mock.Arguments) { until = args.Get(1).(time.Time) }).
+		Return(products, nil)
+
+	news, err := service.ListNewProducts(customerID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, products, news.Items)
+	assert.Equal(t, until, news.Cursor)
+	favoriteRepo.AssertNotCalled(t, "MarkChecked", mock.Anything, mock.Anything)
+}
+
+func TestAcknowledgeNewProducts_MovesCheckpoint(t *testing.T) {
+	service, customerRepo, favoriteRepo, _ := newFavoriteCategoryService()
+
+	customerID := uuid.New()
+	cursor := time.Now().Add(-time.Minute)
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	favoriteRepo.On("MarkChecked", customerID.String(), cursor).Return(nil)
+
+	err := service.AcknowledgeNewProducts(customerID.String(), cursor)
+
+	assert.NoError(t, err)
+	favoriteRepo.AssertExpectations(t)
+}
+
+func TestAcknowledgeNewProducts_FutureCursor(t *testing.T) {
+	service, customerRepo, favoriteRepo, _ := newFavoriteCategoryService()
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+
+	err := service.AcknowledgeNewProducts(customerID.String(), time.Now().Add(time.Hour))
+
+	assert.IsType(t, &exceptions.InvalidEntityError{}, err)
+	favoriteRepo.AssertNotCalled(t, "MarkChecked", mock.Anything, mock.Anything)
+}
+
+func TestListNewProducts_CustomerNotFound(t *testing.T) {
+	service, customerRepo, favoriteRepo, _ := newFavoriteCategoryService()
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(nil, nil)
+
+	_, err := service.ListNewProducts(customerID.String())
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	favoriteRepo.AssertNotCalled(t, "ListNewProducts", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,284 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/rules"
+)
+
+// PolicyService checks the wishlist rules before the wishlist changes. Rules
+// come from the rules file, fixed for the life of the process, and from the
+// database, where they are managed by the admin endpoints. The enabled rules
+// are compiled once and reloaded when they are edited, or after the refresh
+// interval to pick up the edits made on other instances.
+type PolicyService struct {
+	RuleRepository  querier.WishlistRuleQuerier
+	configRules     []models.WishlistRule
+	refreshInterval time.Duration
+	compiler        *rules.Compiler
+
+	mu       sync.Mutex
+	active   []compiledRule
+	loadedAt time.Time
+}
+
+type compiledRule struct {
+	models.WishlistRule
+	expression *rules.Expression
+}
+
+func NewPolicyService(ruleRepository querier.WishlistRuleQuerier,
+	configRules []models.WishlistRule, refreshInterval time.Duration) (servicers.PolicyServicer, error) {
+	compiler, err := rules.NewCompiler(policyVariables)
+	if err != nil {
+		return nil, err
+	}
+	ps := &PolicyService{
+		RuleRepository:  ruleRepository,
+		refreshInterval: refreshInterval,
+		compiler:        compiler,
+	}
+	for _, rule := range configRules {
+		rule.Source = models.RuleSourceConfig
+		if _, err := ps.compile(&rule); err != nil {
+			return nil, fmt.Errorf("wishlist rule %q: %w", rule.Name, err)
+		}
+		ps.configRules = append(ps.configRules, rule)
+	}
+	return ps, nil
+}
+
+// LoadRulesFile reads the rules of the json file, no file means no rules.
+// Rules in the file are enabled unless they say otherwise.
+func LoadRulesFile(path string) ([]models.WishlistRule, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []struct {
+		models.WishlistRule
+		Enabled *bool `json:"enabled"`
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("invalid rules file %s: %w", path, err)
+	}
+	loaded := make([]models.WishlistRule, len(entries))
+	for i, entry := range entries {
+		loaded[i] = entry.WishlistRule
+		loaded[i].Enabled = entry.Enabled == nil || *entry.Enabled
+	}
+	return loaded, nil
+}
+
+// Check evaluates the enabled rules for the action and reports every broken one
+func (ps *PolicyService) Check(input models.PolicyInput) error {
+	active, err := ps.activeRules()
+	if err != nil {
+		return err
+	}
+
+	values := policyValues(input)
+	var violations []exceptions.PolicyViolation
+	for _, rule := range active {
+		if !rule.AppliesTo(input.Action) {
+			continue
+		}
+		ok, err := rule.expression.Eval(values)
+		if err != nil {
+			return fmt.Errorf("wishlist rule %q: %w", rule.Name, err)
+		}
+		if !ok {
+			violations = append(violations, exceptions.PolicyViolation{
+				Rule:        rule.Name,
+				Description: rule.Description,
+			})
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	names := make([]string, len(violations))
+	for i, violation := range violations {
+		names[i] = violation.Rule
+	}
+	return &exceptions.PolicyViolationError{
+		Reason:     "wishlist rules violated: " + strings.Join(names, ", "),
+		Violations: violations,
+	}
+}
+
+// ListRules returns the rules of the file followed by the ones in the database
+func (ps *PolicyService) ListRules() ([]models.WishlistRule, error) {
+	stored, err := ps.RuleRepository.List()
+	if err != nil {
+		return nil, err
+	}
+	for i := range stored {
+		stored[i].Source = models.RuleSourceDatabase
+	}
+	return append(append([]models.WishlistRule{}, ps.configRules...), stored...), nil
+}
+
+func (ps *PolicyService) SaveRule(rule *models.WishlistRule) (*models.WishlistRule, error) {
+	if ps.configRule(rule.Name) {
+		return nil, &exceptions.InvalidEntityError{
+			Reason: "rule is defined in the rules file",
+		}
+	}
+	if _, err := ps.compile(rule); err != nil {
+		return nil, &exceptions.InvalidEntityError{
+			Reason: err.Error(),
+		}
+	}
+
+	if err := ps.RuleRepository.Save(rule); err != nil {
+		return nil, err
+	}
+	ps.invalidate()
+	rule.Source = models.RuleSourceDatabase
+	return rule, nil
+}
+
+func (ps *PolicyService) DeleteRule(name string) error {
+	if ps.configRule(name) {
+		return &exceptions.InvalidEntityError{
+			Reason: "rule is defined in the rules file",
+		}
+	}
+	deleted, err := ps.RuleRepository.Delete(name)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return &exceptions.NotFoundEntityError{
+			Reason: "rule not found",
+		}
+	}
+	ps.invalidate()
+	return nil
+}
+
+// activeRules returns the enabled rules of the file and the database,
+// loading and compiling the database ones again when they may have changed
+func (ps *PolicyService) activeRules() ([]compiledRule, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if !ps.loadedAt.IsZero() && time.Since(ps.loadedAt) < ps.refreshInterval {
+		return ps.active, nil
+	}
+	stored, err := ps.RuleRepository.ListEnabled()
+	if err != nil {
+		return nil, err
+	}
+	active := []compiledRule{}
+	for _, rule := range append(append([]models.WishlistRule{}, ps.configRules...), stored...) {
+		if !rule.Enabled {
+			continue
+		}
+		expression, err := ps.compile(&rule)
+		if err != nil {
+			return nil, fmt.Errorf("wishlist rule %q: %w", rule.Name, err)
+		}
+		active = append(active, compiledRule{WishlistRule: rule, expression: expression})
+	}
+	ps.active = active
+	ps.loadedAt = time.Now()
+	return active, nil
+}
+
+// invalidate makes the next check load the rules of the database again
+func (ps *PolicyService) invalidate() {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.loadedAt = time.Time{}
+}
+
+func (ps *PolicyService) configRule(name string) bool {
+	for _, rule := range ps.configRules {
+		if rule.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// compile checks the rule can be evaluated for any mutation
+func (ps *PolicyService) compile(rule *models.WishlistRule) (*rules.Expression, error) {
+	if strings.TrimSpace(rule.Name) == "" {
+		return nil, fmt.Errorf("rule name is required")
+	}
+	for _, action := range rule.Actions {
+		if action != models.RuleActionAdd && action != models.RuleActionRemove {
+			return nil, fmt.Errorf("unknown action %q", action)
+		}
+	}
+	expression, err := ps.compiler.Compile(rule.Expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression: %w", err)
+	}
+	return expression, nil
+}
+
+// policyVariables are what the rule expressions can read, prices are in
+// major units of the catalog currency
+var policyVariables = rules.Variables{
+	"action":                  cel.StringType,
+	"actor.is_owner":          cel.BoolType,
+	"request.partner_key":     cel.StringType,
+	"customer.id":             cel.StringType,
+	"customer.name":           cel.StringType,
+	"customer.email":          cel.StringType,
+	"customer.wishlist_count": cel.IntType,
+	"product.id":              cel.IntType,
+	"product.title":           cel.StringType,
+	"product.category":        cel.StringType,
+	"product.price":           cel.DoubleType,
+	"product.rating":          cel.DoubleType,
+	"product.discontinued":    cel.BoolType,
+}
+
+// policyValues exposes the mutation to the rule expressions
+func policyValues(input models.PolicyInput) map[string]any {
+	customer := input.Customer
+	if customer == nil {
+		customer = &models.Customer{}
+	}
+	product := input.Product
+	if product == nil {
+		product = &models.Product{}
+	}
+	price, _ := product.Price.Rat().Float64()
+
+	return map[string]any{
+		"action":                  input.Action,
+		"actor.is_owner":          input.ActorID == "" || input.ActorID == customer.ID.String(),
+		"request.partner_key":     input.PartnerKey,
+		"customer.id":             customer.ID.String(),
+		"customer.name":           customer.Name,
+		"customer.email":          customer.Email,
+		"customer.wishlist_count": int64(len(customer.Wishlist)),
+		"product.id":              int64(product.ID),
+		"product.title":           product.Title,
+		"product.category":        product.Category,
+		"product.price":           price,
+		"product.rating":          float64(product.Rating.Rate),
+		"product.discontinued":    product.Discontinued,
+	}
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func newPolicyInput(action string, wishlistSize int, category string, partnerKey string) models.PolicyInput {
+	wishlist := make([]*models.Product, wishlistSize)
+	for i := range wishlist {
+		wishlist[i] = createProduct(int32(i + 100))
+	}
+	product := createProduct(1)
+	product.Category = category
+	product.Price = models.NewMoney(450, "USD")
+	return models.PolicyInput{
+		Action:     action,
+		Customer:   createCustomer(uuid.New(), wishlist),
+		Product:    product,
+		PartnerKey: partnerKey,
+	}
+}
+
+func TestPolicyCheck_ReportsEveryBrokenRule(t *testing.T) {
+	ruleRepo := new(mocks.WishlistRuleQuerier)
+	ruleRepo.On("ListEnabled").Return([]models.WishlistRule{
+		{Name: "min-price", Description: "Products under 5 can't be wishlisted",
+			Actions: []string{models.RuleActionAdd}, Expression: "product.price >= 5", Enabled: true},
+		{Name: "partner-categories", Expression: `!(request.partner_key == "acme" && product.category == "electronics")`,
+			Enabled: true},
+	}, nil)
+	service, err := NewPolicyService(ruleRepo, []models.WishlistRule{
+		{Name: "max-items", Expression: "customer.wishlist_count < 3", Actions: []string{models.RuleActionAdd}, Enabled: true},
+	}, time.Minute)
+	assert.NoError(t, err)
+
+	err = service.Check(newPolicyInput(models.RuleActionAdd, 3, "electronics", "acme"))
+
+	var violation *exceptions.PolicyViolationError
+	assert.ErrorAs(t, err, &violation)
+	assert.Equal(t, []exceptions.PolicyViolation{
+		{Rule: "max-items"},
+		{Rule: "min-price", Description: "Products under 5 can't be wishlisted"},
+		{Rule: "partner-categories"},
+	}, violation.Violations)
+}
+
+func TestPolicyCheck_RulesOfOtherActionsAreSkipped(t *testing.T) {
+	ruleRepo := new(mocks.WishlistRuleQuerier)
+	ruleRepo.On("ListEnabled").Return([]models.WishlistRule{}, nil)
+	service, err := NewPolicyService(ruleRepo, []models.WishlistRule{
+		{Name: "max-items", Expression: "customer.wishlist_count < 3", Actions: []string{models.RuleActionAdd}, Enabled: true},
+		{Name: "disabled", Expression: "false", Enabled: false},
+	}, time.Minute)
+	assert.NoError(t, err)
+
+	assert.NoError(t, service.Check(newPolicyInput(models.RuleActionRemove, 3, "books", "")))
+}
+
+func TestPolicyCheck_ReloadsRulesOnlyAfterEdits(t *testing.T) {
+	ruleRepo := new(mocks.WishlistRuleQuerier)
+	ruleRepo.On("ListEnabled").Return([]models.WishlistRule{
+		{Name: "min-price", Expression: "product.price >= 5", Enabled: true},
+	}, nil).Once()
+	service, err := NewPolicyService(ruleRepo, nil, time.Hour)
+	assert.NoError(t, err)
+
+	input := newPolicyInput(models.RuleActionAdd, 0, "books", "")
+	assert.Error(t, service.Check(input))
+	assert.Error(t, service.Check(input))
+	ruleRepo.AssertNumberOfCalls(t, "ListEnabled", 1)
+
+	ruleRepo.On("Delete", "min-price").Return(true, nil)
+	ruleRepo.On("ListEnabled").Return([]models.WishlistRule{}, nil).Once()
+	assert.NoError(t, service.DeleteRule("min-price"))
+
+	assert.NoError(t, service.Check(input))
+	ruleRepo.AssertNumberOfCalls(t, "ListEnabled", 2)
+}
+
+func TestNewPolicyService_InvalidConfigRule(t *testing.T) {
+	_, err := NewPolicyService(new(mocks.WishlistRuleQuerier), []models.WishlistRule{
+		{Name: "typo", Expression: "customer.wishlist_size < 100", Enabled: true},
+	}, time.Minute)
+
+	assert.ErrorContains(t, err, "undeclared reference to 'customer'")
+}
+
+func TestSaveRule_InvalidExpression(t *testing.T) {
+	ruleRepo := new(mocks.WishlistRuleQuerier)
+	service, _ := NewPolicyService(ruleRepo, nil, time.Minute)
+
+	_, err := service.SaveRule(&models.WishlistRule{Name: "min-price", Expression: `product.price >= "5"`, Enabled: true})
+
+	assert.IsType(t, &exceptions.InvalidEntityError{}, err)
+	ruleRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestSaveRule_ConfigRulesAreReadOnly(t *testing.T) {
+	ruleRepo := new(mocks.WishlistRuleQuerier)
+	service, _ := NewPolicyService(ruleRepo, []models.WishlistRule{
+		{Name: "max-items", Expression: "customer.wishlist_count < 100", Enabled: true},
+	}, time.Minute)
+
+	_, err := service.SaveRule(&models.WishlistRule{Name: "max-items", Expression: "true", Enabled: true})
+	assert.IsType(t, &exceptions.InvalidEntityError{}, err)
+
+	err = service.DeleteRule("max-items")
+	assert.IsType(t, &exceptions.InvalidEntityError{}, err)
+	ruleRepo.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func TestSaveRule_Success(t *testing.T) {
+	ruleRepo := new(mocks.WishlistRuleQuerier)
+	service, _ := NewPolicyService(ruleRepo, nil, time.Minute)
+	rule := &models.WishlistRule{Name: "min-price", Expression: "product.price >= 5", Enabled: true}
+	ruleRepo.On("Save", rule).Return(nil)
+
+	saved, err := service.SaveRule(rule)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.RuleSourceDatabase, saved.Source)
+	ruleRepo.AssertExpectations(t)
+}
+
+func TestLoadRulesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	err := os.WriteFile(path, []byte(`[
+		{"name": "max-items", "actions": ["add"], "expression": "customer.wishlist_count < 100"},
+		{"name": "off", "expression": "false", "enabled": false}
+	]`), 0o600)
+	assert.NoError(t, err)
+
+	loaded, err := LoadRulesFile(path)
+
+	assert.NoError(t, err)
+	assert.Len(t, loaded, 2)
+	assert.True(t, loaded[0].Enabled)
+	assert.Equal(t, []string{"add"}, loaded[0].Actions)
+	assert.False(t, loaded[1].Enabled)
+
+	none, err := LoadRulesFile("")
+	assert.NoError(t, err)
+	assert.Empty(t, none)
+}
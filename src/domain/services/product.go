@@ -1,18 +1,31 @@
 package services
 
 import (
+	"bytes"
 	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
 	"produtos-favoritos/src/domain/interfaces/services"
 	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
 )
 
 type ProductService struct {
 	fakeProductApiClient services.FakeProductApiClientServicer
+	productRepository    querier.ProductQuerier
+	changeRepository     querier.ProductChangeQuerier
 }
 
-func NewProductService(fakeProductApiClientServicer services.FakeProductApiClientServicer) services.ProductServicer {
+func NewProductService(fakeProductApiClientServicer services.FakeProductApiClientServicer,
+	productRepository querier.ProductQuerier,
+	changeRepository querier.ProductChangeQuerier) services.ProductServicer {
 	return &ProductService{
 		fakeProductApiClient: fakeProductApiClientServicer,
+		productRepository:    productRepository,
+		changeRepository:     changeRepository,
 	}
 }
 
@@ -37,6 +50,14 @@ func (ps *ProductService) GetProductByID(productID int32) (*models.Product, erro
 		return nil, err
 	}
 
+	// fakestore answers unknown ids with an empty 200 response
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "product not found",
+		}
+	}
+
 	var product models.Product
 	err = json.Unmarshal(body, &product)
 	if err != nil {
@@ -45,3 +66,188 @@ func (ps *ProductService) GetProductByID(productID int32) (*models.Product, erro
 
 	return &product, nil
 }
+
+func (ps *ProductService) ListProducts(filter models.ProductFilter) (*models.ProductPage, error) {
+	// fakestore has no offset, price, rating or text filters and only sorts by id, so its
+	// limit can only be used when there is nothing left to filter or reorder here.
+	// One extra product is requested to know whether there are more pages.
+	nativeLimit := 0
+	if filter.Limit > 0 && !needsLocalFiltering(filter) {
+		nativeLimit = filter.Offset + filter.Limit + 1
+	}
+	nativeSort := ""
+	if filter.SortBy == "" && filter.Order == models.SortDesc {
+		nativeSort = models.SortDesc
+	}
+
+	body, err := ps.fakeProductApiClient.QueryProducts(filter.Category, nativeLimit, nativeSort)
+	if err != nil {
+		return nil, err
+	}
+
+	var products []models.Product
+	err = json.Unmarshal(body, &products)
+	if err != nil {
+		return nil, err
+	}
+
+	products = filterProducts(products, filter)
+	sortProducts(products, filter)
+
+	return paginateProducts(products, filter, nativeLimit > 0), nil
+}
+
+func (ps *ProductService) GetCategories() ([]string, error) {
+	body, err := ps.fakeProductApiClient.ListCategories()
+	if err != nil {
+		return nil, err
+	}
+
+	var categories []string
+	err = json.Unmarshal(body, &categories)
+	if err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// SearchProducts runs a full-text search over the local mirror of the catalog,
+// fakestore has no search of its own.
+func (ps *ProductService) SearchProducts(search models.ProductSearch) (*models.ProductSearchPage, error) {
+	search.Query = strings.TrimSpace(search.Query)
+
+	items, facets, err := ps.productRepository.Search(search)
+	if err != nil {
+		return nil, err
+	}
+	if items == nil {
+		items = []models.ProductSearchResult{}
+	}
+	if facets == nil {
+		facets = []models.CategoryFacet{}
+	}
+
+	total := 0
+	for _, facet := range facets {
+		if search.Category == "" || facet.Category == search.Category {
+			total += facet.Count
+		}
+	}
+
+	return &models.ProductSearchPage{
+		Items:  items,
+		Facets: facets,
+		Pagination: models.Pagination{
+			Limit:   search.Limit,
+			Offset:  search.Offset,
+			Count:   len(items),
+			Total:   &total,
+			HasMore: search.Offset+len(items) < total,
+		},
+	}, nil
+}
+
+// GetProductChanges reads the changelog written by the catalog mirror
+func (ps *ProductService) GetProductChanges(since time.Time, limit int, offset int) (*models.ProductChangePage, error) {
+	// one extra change tells whether there are more pages
+	changes, err := ps.changeRepository.ListSince(since, limit+1, offset)
+	if err != nil {
+		return nil, err
+	}
+	if changes == nil {
+		changes = []models.ProductChange{}
+	}
+
+	hasMore := len(changes) > limit
+	if hasMore {
+		changes = changes[:limit]
+	}
+
+	return &models.ProductChangePage{
+		Items: changes,
+		Pagination: models.Pagination{
+			Limit:   limit,
+			Offset:  offset,
+			Count:   len(changes),
+			HasMore: hasMore,
+		},
+	}, nil
+}
+
+func needsLocalFiltering(filter models.ProductFilter) bool {
+	return filter.MinPrice != nil || filter.MaxPrice != nil || filter.MinRating != nil ||
+		filter.Query != "" || filter.SortBy != ""
+}
+
+func filterProducts(products []models.Product, filter models.ProductFilter) []models.Product {
+	query := strings.ToLower(strings.TrimSpace(filter.Query))
+
+	filtered := make([]models.Product, 0, len(products))
+	for _, p := range products {
+		if filter.MinPrice != nil && p.Price.Cmp(*filter.MinPrice) < 0 {
+			continue
+		}
+		if filter.MaxPrice != nil && p.Price.Cmp(*filter.MaxPrice) > 0 {
+			continue
+		}
+		if filter.MinRating != nil && p.Rating.Rate < *filter.MinRating {
+			continue
+		}
+		if query != "" &&
+			!strings.Contains(strings.ToLower(p.Title), query) &&
+			!strings.Contains(strings.ToLower(p.Description), query) {
+			continue
+		}
+		filtered = append(filtered, p)
+	}
+
+	return filtered
+}
+
+func sortProducts(products []models.Product, filter models.ProductFilter) {
+	var less func(a, b models.Product) bool
+	switch filter.SortBy {
+	case models.ProductSortPrice:
+		less = func(a, b models.Product) bool { return a.Price.Cmp(b.Price) < 0 }
+	case models.ProductSortTitle:
+		less = func(a, b models.Product) bool { return strings.ToLower(a.Title) < strings.ToLower(b.Title) }
+	case models.ProductSortRating:
+		less = func(a, b models.Product) bool { return a.Rating.Rate < b.Rating.Rate }
+	default:
+		return
+	}
+
+	sort.SliceStable(products, func(i, j int) bool {
+		if filter.Order == models.SortDesc {
+			return less(products[j], products[i])
+		}
+		return less(products[i], products[j])
+	})
+}
+
+// paginateProducts slices the requested page. When the upstream limit was used
+// the total size of the catalog is unknown and is left out of the metadata.
+func paginateProducts(products []models.Product, filter models.ProductFilter, limitedUpstream bool) *models.ProductPage {
+	start := min(filter.Offset, len(products))
+	end := len(products)
+	if filter.Limit > 0 {
+		end = min(start+filter.Limit, len(products))
+	}
+
+	pagination := models.Pagination{
+		Limit:   filter.Limit,
+		Offset:  filter.Offset,
+		Count:   end - start,
+		HasMore: end < len(products),
+	}
+	if !limitedUpstream {
+		total := len(products)
+		pagination.Total = &total
+	}
+
+	return &models.ProductPage{
+		Items:      products[start:end],
+		Pagination: pagination,
+	}
+}
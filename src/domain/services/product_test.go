@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"errors"
 	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
 	"produtos-favoritos/src/internals/mocks"
 
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -23,7 +25,7 @@ func TestGetProducts_Success(t *testing.T) {
 
 	mockClient.On("ListProducts").Return(body, nil)
 
-	service := NewProductService(mockClient)
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
 	result, err := service.GetProducts()
 
 	assert.NoError(t, err)
@@ -39,7 +41,7 @@ func TestGetProducts_ErrorFromAPI(t *testing.T) {
 
 	mockClient.On("ListProducts").Return([]byte(nil), errors.New("API error"))
 
-	service := NewProductService(mockClient)
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
 	result, err := service.GetProducts()
 
 	assert.Error(t, err)
@@ -52,7 +54,7 @@ func TestGetProducts_UnmarshalError(t *testing.T) {
 
 	mockClient.On("ListProducts").Return([]byte("invalid json"), nil)
 
-	service := NewProductService(mockClient)
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
 	result, err := service.GetProducts()
 
 	assert.Error(t, err)
@@ -68,7 +70,7 @@ func TestGetProductByID_Success(t *testing.T) {
 
 	mockClient.On("GetProduct", int32(1)).Return(body, nil)
 
-	service := NewProductService(mockClient)
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
 	result, err := service.GetProductByID(1)
 
 	assert.NoError(t, err)
@@ -84,7 +86,7 @@ func TestGetProductByID_ErrorFromAPI(t *testing.T) {
 
 	mockClient.On("GetProduct", int32(1)).Return([]byte(nil), errors.New("API error"))
 
-	service := NewProductService(mockClient)
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
 	result, err := service.GetProductByID(1)
 
 	assert.Error(t, err)
@@ -97,10 +99,234 @@ func TestGetProductByID_UnmarshalError(t *testing.T) {
 
 	mockClient.On("GetProduct", int32(1)).Return([]byte("not a product json"), nil)
 
-	service := NewProductService(mockClient)
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
 	result, err := service.GetProductByID(1)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	mockClient.AssertExpectations(t)
 }
+
+func TestGetProductByID_EmptyBodyIsNotFound(t *testing.T) {
+	mockClient := new(mocks.FakeProductApiClientServicer)
+
+	mockClient.On("GetProduct", int32(999)).Return([]byte(""), nil)
+
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
+	result, err := service.GetProductByID(999)
+
+	assert.Nil(t, result)
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	mockClient.AssertExpectations(t)
+}
+
+var catalog = []models.Product{
+	{ID: 1, Title: "Backpack", Price: models.NewMoney(10995, "USD"), Description: "Fits 15 inch laptops", Category: "men's clothing"},
+	{ID: 2, Title: "T-Shirt", Price: models.NewMoney(2230, "USD"), Description: "Slim fit", Category: "men's clothing"},
+	{ID: 3, Title: "Jacket", Price: models.NewMoney(5599, "USD"), Description: "Great outerwear", Category: "men's clothing"},
+	{ID: 4, Title: "Casual slim fit", Price: models.NewMoney(1599, "USD"), Description: "Color may vary", Category: "men's clothing"},
+}
+
+func TestListProducts_NativeFilters(t *testing.T) {
+	mockClient := new(mocks.FakeProductApiClientServicer)
+
+	body, _ := json.Marshal(catalog[:3])
+	// offset + limit + 1 to find out whether there is a next page
+	mockClient.On("QueryProducts", "men's clothing", 3, "desc").Return(body, nil)
+
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
+	page, err := service.ListProducts(models.ProductFilter{
+		Category: "men's clothing",
+		Order:    models.SortDesc,
+		Limit:    2,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 2)
+	assert.True(t, page.Pagination.HasMore)
+	assert.Nil(t, page.Pagination.Total)
+	mockClient.AssertExpectations(t)
+}
+
+func TestListProducts_LocalFiltersAndSort(t *testing.T) {
+	mockClient := new(mocks.FakeProductApiClientServicer)
+
+	body, _ := json.Marshal(catalog)
+	mockClient.On("QueryProducts", "", 0, "").Return(body, nil)
+
+	minPrice := models.NewMoney(2000, "USD")
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
+	page, err := service.ListProducts(models.ProductFilter{
+		MinPrice: &minPrice,
+		SortBy:   models.ProductSortPrice,
+		Order:    models.SortDesc,
+		Limit:    2,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 2)
+	assert.Equal(t, int32(1), page.Items[0].ID)
+	assert.Equal(t, int32(3), page.Items[1].ID)
+	assert.Equal(t, 3, *page.Pagination.Total)
+	assert.True(t, page.Pagination.HasMore)
+	mockClient.AssertExpectations(t)
+}
+
+func TestListProducts_TextQueryAndOffset(t *testing.T) {
+	mockClient := new(mocks.FakeProductApiClientServicer)
+
+	body, _ := json.Marshal(catalog)
+	mockClient.On("QueryProducts", "", 0, "").Return(body, nil)
+
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
+	page, err := service.ListProducts(models.ProductFilter{
+		Query:  "SLIM",
+		SortBy: models.ProductSortTitle,
+		Offset: 1,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, "T-Shirt", page.Items[0].Title)
+	assert.Equal(t, 2, *page.Pagination.Total)
+	assert.False(t, page.Pagination.HasMore)
+	mockClient.AssertExpectations(t)
+}
+
+func TestListProducts_ErrorFromAPI(t *testing.T) {
+	mockClient := new(mocks.FakeProductApiClientServicer)
+
+	mockClient.On("QueryProducts", "", 0, "").Return([]byte(nil), errors.New("API error"))
+
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
+	page, err := service.ListProducts(models.ProductFilter{})
+
+	assert.Error(t, err)
+	assert.Nil(t, page)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetCategories_Success(t *testing.T) {
+	mockClient := new(mocks.FakeProductApiClientServicer)
+
+	mockClient.On("ListCategories").Return([]byte(`["electronics","jewelery"]`), nil)
+
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
+	categories, err := service.GetCategories()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"electronics", "jewelery"}, categories)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetCategories_ErrorFromAPI(t *testing.T) {
+	mockClient := new(mocks.FakeProductApiClientServicer)
+
+	mockClient.On("ListCategories").Return([]byte(nil), errors.New("API error"))
+
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
+	categories, err := service.GetCategories()
+
+	assert.Error(t, err)
+	assert.Nil(t, categories)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetProductByID_MapsRating(t *testing.T) {
+	mockClient := new(mocks.FakeProductApiClientServicer)
+
+	mockClient.On("GetProduct", int32(1)).
+		Return([]byte(`{"id":1,"title":"Backpack","rating":{"rate":3.9,"count":120}}`), nil)
+
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
+	result, err := service.GetProductByID(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.Rating{Rate: 3.9, Count: 120}, result.Rating)
+	mockClient.AssertExpectations(t)
+}
+
+func TestListProducts_MinRatingAndSortByRating(t *testing.T) {
+	mockClient := new(mocks.FakeProductApiClientServicer)
+
+	rated := []models.Product{
+		{ID: 1, Title: "Backpack", Rating: models.Rating{Rate: 3.9, Count: 120}},
+		{ID: 2, Title: "T-Shirt", Rating: models.Rating{Rate: 4.1, Count: 259}},
+		{ID: 3, Title: "Jacket", Rating: models.Rating{Rate: 4.7, Count: 500}},
+	}
+	body, _ := json.Marshal(rated)
+	mockClient.On("QueryProducts", "", 0, "").Return(body, nil)
+
+	minRating := float32(4)
+	service := NewProductService(mockClient, new(mocks.ProductQuerier), new(mocks.ProductChangeQuerier))
+	page, err := service.ListProducts(models.ProductFilter{
+		MinRating: &minRating,
+		SortBy:    models.ProductSortRating,
+		Order:     models.SortDesc,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 2)
+	assert.Equal(t, int32(3), page.Items[0].ID)
+	assert.Equal(t, int32(2), page.Items[1].ID)
+	mockClient.AssertExpectations(t)
+}
+
+func TestSearchProducts_BuildsFacetsAndPagination(t *testing.T) {
+	mockRepo := new(mocks.ProductQuerier)
+
+	search := models.ProductSearch{Query: "jacket", Category: "men's clothing", Limit: 1}
+	results := []models.ProductSearchResult{
+		{Product: models.Product{ID: 3, Title: "Jacket"}, TitleHighlight: "<mark>Jacket</mark>"},
+	}
+	facets := []models.CategoryFacet{
+		{Category: "men's clothing", Count: 2},
+		{Category: "women's clothing", Count: 3},
+	}
+	mockRepo.On("Search", search).Return(results, facets, nil)
+
+	service := NewProductService(new(mocks.FakeProductApiClientServicer), mockRepo, new(mocks.ProductChangeQuerier))
+	page, err := service.SearchProducts(models.ProductSearch{Query: " jacket ", Category: "men's clothing", Limit: 1})
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 1)
+	assert.Len(t, page.Facets, 2)
+	assert.Equal(t, 2, *page.Pagination.Total)
+	assert.True(t, page.Pagination.HasMore)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSearchProducts_NoMatches(t *testing.T) {
+	mockRepo := new(mocks.ProductQuerier)
+
+	search := models.ProductSearch{Query: "zzz", Limit: 20}
+	mockRepo.On("Search", search).Return(nil, nil, nil)
+
+	service := NewProductService(new(mocks.FakeProductApiClientServicer), mockRepo, new(mocks.ProductChangeQuerier))
+	page, err := service.SearchProducts(search)
+
+	assert.NoError(t, err)
+	assert.Empty(t, page.Items)
+	assert.NotNil(t, page.Items)
+	assert.Equal(t, 0, *page.Pagination.Total)
+	assert.False(t, page.Pagination.HasMore)
+}
+
+func TestGetProductChanges_Pagination(t *testing.T) {
+	mockChanges := new(mocks.ProductChangeQuerier)
+
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	mockChanges.On("ListSince", since, 3, 0).Return([]models.ProductChange{
+		{ID: 1, ProductID: 1, Type: models.ProductChangeCreated},
+		{ID: 2, ProductID: 2, Type: models.ProductChangeCreated},
+		{ID: 3, ProductID: 3, Type: models.ProductChangeCreated},
+	}, nil)
+
+	service := NewProductService(new(mocks.FakeProductApiClientServicer), new(mocks.ProductQuerier), mockChanges)
+	page, err := service.GetProductChanges(since, 2, 0)
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 2)
+	assert.True(t, page.Pagination.HasMore)
+	mockChanges.AssertExpectations(t)
+}
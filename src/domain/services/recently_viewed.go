@@ -0,0 +1,142 @@
+package services
+
+import (
+	"expvar"
+	"log"
+	"sync"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+
+	"github.com/google/uuid"
+)
+
+// maxPendingViews bounds the memory used by the views waiting for a flush,
+// views of new products are refused past it until the next flush
+const maxPendingViews = 10000
+
+// droppedViews counts the views that never reached the database by reason,
+// served with the other runtime metrics on /api/v1/admin/metrics
+var droppedViews = expvar.NewMap("recently_viewed_dropped_views")
+
+type viewKey struct {
+	customerID uuid.UUID
+	productID  int32
+}
+
+// RecentlyViewedService keeps the product views in memory and the
+// RecentlyViewedFlushJob writes them in batches, recording a view only checks
+// the customer. Views of the same product are merged while pending.
+type RecentlyViewedService struct {
+	CustomerRepository    querier.CustomerQuerier
+	ProductViewRepository querier.ProductViewQuerier
+
+	mu      sync.Mutex
+	pending map[viewKey]time.Time
+}
+
+func NewRecentlyViewedService(customerRepository querier.CustomerQuerier,
+	productViewRepository querier.ProductViewQuerier) servicers.RecentlyViewedServicer {
+	return &RecentlyViewedService{
+		CustomerRepository:    customerRepository,
+		ProductViewRepository: productViewRepository,
+		pending:               map[viewKey]time.Time{},
+	}
+}
+
+func (rs *RecentlyViewedService) RecordView(customerID string, productID int32, viewedAt time.Time) error {
+	id, err := uuid.Parse(customerID)
+	if err != nil {
+		return &exceptions.BadRequestError{
+			Reason: "invalid customer ID",
+		}
+	}
+	if viewedAt.IsZero() || viewedAt.After(time.Now()) {
+		viewedAt = time.Now()
+	}
+
+	// The flush would drop the view, the caller is told while it still can
+	exists, err := rs.CustomerRepository.Exists(customerID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	key := viewKey{customerID: id, productID: productID}
+	last, ok := rs.pending[key]
+	if !ok && len(rs.pending) >= maxPendingViews {
+		droppedViews.Add("buffer_full", 1)
+		return &exceptions.UnavailableError{
+			Reason: "too many views waiting to be recorded, retry later",
+		}
+	}
+	if viewedAt.After(last) {
+		rs.pending[key] = viewedAt
+	}
+	return nil
+}
+
+// ListRecentlyViewed returns the customer history, most recent first. Views
+// still waiting for a flush show up after it.
+func (rs *RecentlyViewedService) ListRecentlyViewed(customerID string, limit int) ([]models.ProductView, error) {
+	customer, err := rs.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	if limit <= 0 || limit > config.RECENTLY_VIEWED_MAX_ITEMS {
+		limit = config.RECENTLY_VIEWED_MAX_ITEMS
+	}
+	return rs.ProductViewRepository.ListByCustomer(customerID, limit)
+}
+
+// Flush writes the pending views, they are put back when the write fails so
+// the next flush retries them
+func (rs *RecentlyViewedService) Flush() error {
+	rs.mu.Lock()
+	batch := rs.pending
+	rs.pending = map[viewKey]time.Time{}
+	rs.mu.Unlock()
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	views := make([]models.ProductView, 0, len(batch))
+	for key, viewedAt := range batch {
+		views = append(views, models.ProductView{
+			CustomerID: key.customerID,
+			ProductID:  key.productID,
+			ViewedAt:   viewedAt,
+		})
+	}
+
+	dropped, err := rs.ProductViewRepository.RecordViews(views, config.RECENTLY_VIEWED_MAX_ITEMS)
+	if err != nil {
+		rs.mu.Lock()
+		for key, viewedAt := range batch {
+			if last, ok := rs.pending[key]; !ok || viewedAt.After(last) {
+				rs.pending[key] = viewedAt
+			}
+		}
+		rs.mu.Unlock()
+		return err
+	}
+	if dropped > 0 {
+		log.Printf("recently viewed flush dropped %d views of unknown customers or products", dropped)
+		droppedViews.Add("unknown_customer_or_product", int64(dropped))
+	}
+	return nil
+}
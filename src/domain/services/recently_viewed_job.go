@@ -0,0 +1,30 @@
+package services
+
+import (
+	"time"
+
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/infrastructure/config"
+)
+
+// RecentlyViewedFlushJob writes the product views buffered by the
+// RecentlyViewedService
+type RecentlyViewedFlushJob struct {
+	RecentlyViewedService servicers.RecentlyViewedServicer
+}
+
+func NewRecentlyViewedFlushJob(recentlyViewedService servicers.RecentlyViewedServicer) servicers.Job {
+	return &RecentlyViewedFlushJob{RecentlyViewedService: recentlyViewedService}
+}
+
+func (j *RecentlyViewedFlushJob) Name() string {
+	return "recently-viewed-flush"
+}
+
+func (j *RecentlyViewedFlushJob) Interval() time.Duration {
+	return config.RECENTLY_VIEWED_FLUSH_INTERVAL
+}
+
+func (j *RecentlyViewedFlushJob) Run() error {
+	return j.RecentlyViewedService.Flush()
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func existingCustomers() *mocks.CustomerQuerier {
+	customerRepo := new(mocks.CustomerQuerier)
+	customerRepo.On("Exists", mock.Anything).Return(true, nil)
+	return customerRepo
+}
+
+func TestRecordView_MergesPendingViews(t *testing.T) {
+	viewRepo := new(mocks.ProductViewQuerier)
+	service := NewRecentlyViewedService(existingCustomers(), viewRepo)
+
+	customerID := uuid.New()
+	first := time.Now().Add(-time.Minute)
+	last := time.Now().Add(-time.Second)
+	assert.NoError(t, service.RecordView(customerID.String(), 1, last))
+	assert.NoError(t, service.RecordView(customerID.String(), 1, first))
+	assert.NoError(t, service.RecordView(customerID.String(), 2, first))
+
+	viewRepo.On("RecordViews", mock.MatchedBy(func(views []models.ProductView) bool {
+		byProduct := map[int32]time.Time{}
+		for _, view := range views {
+			byProduct[view.ProductID] = view.ViewedAt
+		}
+		return len(views) == 2 && byProduct[1].Equal(last) && byProduct[2].Equal(first)
+	}), config.RECENTLY_VIEWED_MAX_ITEMS).Return(0, nil).Once()
+
+	assert.NoError(t, service.Flush())
+	// The buffer is empty after a flush
+	assert.NoError(t, service.Flush())
+	viewRepo.AssertExpectations(t)
+}
+
+func TestRecordView_DefaultsToNow(t *testing.T) {
+	viewRepo := new(mocks.ProductViewQuerier)
+	service := NewRecentlyViewedService(existingCustomers(), viewRepo)
+
+	before := time.Now()
+	assert.NoError(t, service.RecordView(uuid.New().String(), 1, time.Time{}))
+
+	viewRepo.On("RecordViews", mock.MatchedBy(func(views []models.ProductView) bool {
+		return !views[0].ViewedAt.Before(before)
+	}), mock.Anything).Return(0, nil)
+
+	assert.NoError(t, service.Flush())
+	viewRepo.AssertExpectations(t)
+}
+
+func TestRecordView_UnknownCustomer(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	viewRepo := new(mocks.ProductViewQuerier)
+	service := NewRecentlyViewedService(customerRepo, viewRepo)
+
+	customerID := uuid.New()
+	customerRepo.On("Exists", customerID.String()).Return(false, nil)
+
+	err := service.RecordView(customerID.String(), 1, time.Now())
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	assert.NoError(t, service.Flush())
+	viewRepo.AssertNotCalled(t, "RecordViews", mock.Anything, mock.Anything)
+}
+
+func TestRecordView_FullBufferIsRefused(t *testing.T) {
+	service := NewRecentlyViewedService(existingCustomers(), new(mocks.ProductViewQuerier))
+
+	customerID := uuid.New().String()
+	for productID := range int32(maxPendingViews) {
+		assert.NoError(t, service.RecordView(customerID, productID, time.Now()))
+	}
+
+	err := service.RecordView(customerID, maxPendingViews, time.Now())
+	assert.IsType(t, &exceptions.UnavailableError{}, err)
+	// A product already pending is merged, it takes no room
+	assert.NoError(t, service.RecordView(customerID, 1, time.Now()))
+}
+
+func TestFlush_FailedBatchIsRetried(t *testing.T) {
+	viewRepo := new(mocks.ProductViewQuerier)
+	service := NewRecentlyViewedService(existingCustomers(), viewRepo)
+
+	assert.NoError(t, service.RecordView(uuid.New().String(), 1, time.Now()))
+
+	viewRepo.On("RecordViews", mock.Anything, mock.Anything).Return(0, errors.New("database is down")).Once()
+	assert.EqualError(t, service.Flush(), "database is down")
+
+	viewRepo.On("RecordViews", mock.MatchedBy(func(views []models.ProductView) bool {
+		return len(views) == 1 && views[0].ProductID == 1
+	}), mock.Anything).Return(0, nil).Once()
+	assert.NoError(t, service.Flush())
+	viewRepo.AssertExpectations(t)
+}
+
+func TestListRecentlyViewed_CapsTheLimit(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	viewRepo := new(mocks.ProductViewQuerier)
+	service := NewRecentlyViewedService(customerRepo, viewRepo)
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	viewRepo.On("ListByCustomer", customerID.String(), config.RECENTLY_VIEWED_MAX_ITEMS).
+		Return([]models.ProductView{{ProductID: 1, Product: createProduct(1)}}, nil)
+
+	views, err := service.ListRecentlyViewed(customerID.String(), config.RECENTLY_VIEWED_MAX_ITEMS+100)
+
+	assert.NoError(t, err)
+	assert.Len(t, views, 1)
+	viewRepo.AssertExpectations(t)
+}
+
+func TestRecentlyViewedFlushJob_Run(t *testing.T) {
+	viewRepo := new(mocks.ProductViewQuerier)
+	service := NewRecentlyViewedService(existingCustomers(), viewRepo)
+	job := NewRecentlyViewedFlushJob(service)
+
+	assert.NoError(t, service.RecordView(uuid.New().String(), 3, time.Now()))
+	viewRepo.On("RecordViews", mock.Anything, mock.Anything).Return(0, nil).Once()
+
+	assert.Equal(t, "recently-viewed-flush", job.Name())
+	assert.NoError(t, job.Run())
+	viewRepo.AssertExpectations(t)
+}
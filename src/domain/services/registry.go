@@ -0,0 +1,276 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+// registryTokenBytes is the size of the random token guests open the registry with
+const registryTokenBytes = 16
+
+type RegistryService struct {
+	CustomerRepository querier.CustomerQuerier
+	WishlistRepository querier.WishlistQuerier
+	RegistryRepository querier.RegistryQuerier
+}
+
+func NewRegistryService(customerRepository querier.CustomerQuerier,
+	wishlistRepository querier.WishlistQuerier,
+	registryRepository querier.RegistryQuerier) servicers.RegistryServicer {
+	return &RegistryService{
+		CustomerRepository: customerRepository,
+		WishlistRepository: wishlistRepository,
+		RegistryRepository: registryRepository,
+	}
+}
+
+// EnableRegistry turns the wishlist into a gift registry, enabling it again
+// only changes the title so the link shared with the guests keeps working.
+func (rs *RegistryService) EnableRegistry(customerID string, title string) (*models.WishlistRegistry, error) {
+	customer, err := rs.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+
+	registry, err := rs.RegistryRepository.GetByCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+	if registry == nil {
+		token, err := newRegistryToken()
+		if err != nil {
+			return nil, err
+		}
+		registry = &models.WishlistRegistry{CustomerID: customer.ID, Token: token}
+	}
+	registry.Title = title
+
+	if err := rs.RegistryRepository.Save(registry); err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
+
+// DisableRegistry turns the registry off and drops its reservations
+func (rs *RegistryService) DisableRegistry(customerID string) error {
+	if _, err := rs.getRegistry(customerID); err != nil {
+		return err
+	}
+	return rs.RegistryRepository.Delete(customerID)
+}
+
+// GetOwnerRegistry returns the registry without the reservations, the owner
+// doesn't get to know what was already bought.
+func (rs *RegistryService) GetOwnerRegistry(customerID string) (*models.Registry, error) {
+	registry, err := rs.getRegistry(customerID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.buildRegistry(registry, false)
+}
+
+// SetItemQuantity sets how many units of the item the owner wants
+func (rs *RegistryService) SetItemQuantity(customerID string, productID int32, quantity int) error {
+	if _, err := rs.getRegistry(customerID); err != nil {
+		return err
+	}
+	if _, err := rs.findItem(customerID, productID); err != nil {
+		return err
+	}
+	return rs.WishlistRepository.UpdateQuantity(customerID, productID, quantity)
+}
+
+// GetGuestRegistry returns the registry with how many units of each item
+// were reserved and are still available
+func (rs *RegistryService) GetGuestRegistry(token string) (*models.Registry, error) {
+	registry, err := rs.getRegistryByToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return rs.buildRegistry(registry, true)
+}
+
+// Reserve holds units of an item for the guest until the reservation
+// expires, unless the guest confirms it before.
+func (rs *RegistryService) Reserve(token string, request models.ReservationRequest) (*models.RegistryReservation, error) {
+	registry, err := rs.getRegistryByToken(token)
+	if err != nil {
+		return nil, err
+	}
+	customerID := registry.CustomerID.String()
+	if _, err := rs.findItem(customerID, request.ProductID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(config.REGISTRY_RESERVATION_TTL)
+	reservation := &models.RegistryReservation{
+		CustomerID: registry.CustomerID,
+		ProductID:  request.ProductID,
+		Quantity:   request.Quantity,
+		GuestName:  request.GuestName,
+		GuestEmail: request.GuestEmail,
+		Status:     models.ReservationPending,
+		ExpiresAt:  &expiresAt,
+	}
+	reserved, err := rs.RegistryRepository.Reserve(reservation, now)
+	if err != nil {
+		return nil, err
+	}
+	if !reserved {
+		return nil, &exceptions.BadRequestError{
+			Reason: "not enough units left to reserve",
+		}
+	}
+	return reservation, nil
+}
+
+// ConfirmReservation keeps the reserved units for good
+func (rs *RegistryService) ConfirmReservation(token string, reservationID string) (*models.RegistryReservation, error) {
+	reservation, err := rs.getReservation(token, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	if reservation.Status == models.ReservationConfirmed {
+		return reservation, nil
+	}
+
+	now := time.Now()
+	reservation.Status = models.ReservationConfirmed
+	reservation.ConfirmedAt = &now
+	reservation.ExpiresAt = nil
+	if err := rs.RegistryRepository.SaveReservation(reservation); err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// CancelReservation frees the reserved units
+func (rs *RegistryService) CancelReservation(token string, reservationID string) error {
+	reservation, err := rs.getReservation(token, reservationID)
+	if err != nil {
+		return err
+	}
+	return rs.RegistryRepository.DeleteReservation(reservation.ID.String())
+}
+
+func (rs *RegistryService) getRegistry(customerID string) (*models.WishlistRegistry, error) {
+	registry, err := rs.RegistryRepository.GetByCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+	if registry == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "registry not found",
+		}
+	}
+	return registry, nil
+}
+
+func (rs *RegistryService) getRegistryByToken(token string) (*models.WishlistRegistry, error) {
+	registry, err := rs.RegistryRepository.GetByToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if registry == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "registry not found",
+		}
+	}
+	return registry, nil
+}
+
+// getReservation returns a reservation of the registry that still holds its units
+func (rs *RegistryService) getReservation(token string, reservationID string) (*models.RegistryReservation, error) {
+	registry, err := rs.getRegistryByToken(token)
+	if err != nil {
+		return nil, err
+	}
+	reservation, err := rs.RegistryRepository.GetReservation(registry.CustomerID.String(), reservationID)
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil || !reservation.Active(time.Now()) {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "reservation not found or expired",
+		}
+	}
+	return reservation, nil
+}
+
+func (rs *RegistryService) findItem(customerID string, productID int32) (*models.WishlistItem, error) {
+	items, err := rs.WishlistRepository.ListByCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		if items[i].ProductID == productID {
+			return &items[i], nil
+		}
+	}
+	return nil, &exceptions.NotFoundEntityError{
+		Reason: "product not in registry",
+	}
+}
+
+func (rs *RegistryService) buildRegistry(registry *models.WishlistRegistry, guest bool) (*models.Registry, error) {
+	customerID := registry.CustomerID.String()
+	customer, err := rs.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	items, err := rs.WishlistRepository.ListByCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &models.Registry{
+		Title:     registry.Title,
+		OwnerName: customer.Name,
+		Items:     make([]models.RegistryItem, 0, len(items)),
+	}
+	var reserved map[int32]int
+	if guest {
+		reserved, err = rs.RegistryRepository.ReservedQuantities(customerID, time.Now())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		view.Token = registry.Token
+	}
+
+	for _, item := range items {
+		registryItem := models.RegistryItem{
+			ProductID: item.ProductID,
+			Product:   item.Product,
+			Quantity:  item.Quantity,
+		}
+		if guest {
+			taken := min(reserved[item.ProductID], item.Quantity)
+			available := item.Quantity - taken
+			registryItem.Reserved = &taken
+			registryItem.Available = &available
+		}
+		view.Items = append(view.Items, registryItem)
+	}
+	return view, nil
+}
+
+func newRegistryToken() (string, error) {
+	token := make([]byte, registryTokenBytes)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
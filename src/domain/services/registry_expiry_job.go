@@ -0,0 +1,40 @@
+package services
+
+import (
+	"log"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/infrastructure/config"
+)
+
+// RegistryExpiryJob drops the gift registry reservations that were not
+// confirmed in time. Expired reservations already stop holding their units,
+// the job only keeps the table from growing.
+type RegistryExpiryJob struct {
+	RegistryRepository querier.RegistryQuerier
+}
+
+func NewRegistryExpiryJob(registryRepository querier.RegistryQuerier) servicers.Job {
+	return &RegistryExpiryJob{RegistryRepository: registryRepository}
+}
+
+func (j *RegistryExpiryJob) Name() string {
+	return "registry-reservation-expiry"
+}
+
+func (j *RegistryExpiryJob) Interval() time.Duration {
+	return config.REGISTRY_EXPIRY_INTERVAL
+}
+
+func (j *RegistryExpiryJob) Run() error {
+	deleted, err := j.RegistryRepository.DeleteExpiredReservations(time.Now())
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		log.Printf("released %d expired registry reservations", deleted)
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+type registryMocks struct {
+	customerRepo *mocks.CustomerQuerier
+	wishlistRepo *mocks.WishlistQuerier
+	registryRepo *mocks.RegistryQuerier
+}
+
+func newRegistryService() (servicers.RegistryServicer, *registryMocks) {
+	m := &registryMocks{
+		customerRepo: new(mocks.CustomerQuerier),
+		wishlistRepo: new(mocks.WishlistQuerier),
+		registryRepo: new(mocks.RegistryQuerier),
+	}
+	return NewRegistryService(m.customerRepo, m.wishlistRepo, m.registryRepo), m
+}
+
+func (m *registryMocks) assertExpectations(t *testing.T) {
+	m.customerRepo.AssertExpectations(t)
+	m.wishlistRepo.AssertExpectations(t)
+	m.registryRepo.AssertExpectations(t)
+}
+
+func registryItems(customerID uuid.UUID) []models.WishlistItem {
+	return []models.WishlistItem{
+		{CustomerID: customerID, ProductID: 1, Quantity: 2, Product: createProduct(1)},
+		{CustomerID: customerID, ProductID: 2, Quantity: 1, Product: createProduct(2)},
+	}
+}
+
+func TestRegistryService_EnableCreatesToken(t *testing.T) {
+	service, m := newRegistryService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.registryRepo.On("GetByCustomer", customerID.String()).Return(nil, nil)
+	m.registryRepo.On("Save", mock.MatchedBy(func(r *models.WishlistRegistry) bool {
+		return r.CustomerID == customerID && len(r.Token) == 2*registryTokenBytes && r.Title == "Wedding"
+	})).Return(nil)
+
+	registry, err := service.EnableRegistry(customerID.String(), "Wedding")
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, registry.Token)
+	m.assertExpectations(t)
+}
+
+func TestRegistryService_EnableAgainKeepsToken(t *testing.T) {
+	service, m := newRegistryService()
+	customerID := uuid.New()
+	existing := &models.WishlistRegistry{CustomerID: customerID, Token: "abc", Title: "Birthday"}
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.registryRepo.On("GetByCustomer", customerID.String()).Return(existing, nil)
+	m.registryRepo.On("Save", existing).Return(nil)
+
+	registry, err := service.EnableRegistry(customerID.String(), "Wedding")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", registry.Token)
+	assert.Equal(t, "Wedding", registry.Title)
+	m.assertExpectations(t)
+}
+
+func TestRegistryService_OwnerViewHidesReservations(t *testing.T) {
+	service, m := newRegistryService()
+	customerID := uuid.New()
+
+	m.registryRepo.On("GetByCustomer", customerID.String()).
+		Return(&models.WishlistRegistry{CustomerID: customerID, Token: "abc"}, nil)
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(registryItems(customerID), nil)
+
+	registry, err := service.GetOwnerRegistry(customerID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", registry.Token)
+	assert.Len(t, registry.Items, 2)
+	for _, item := range registry.Items {
+		assert.Nil(t, item.Reserved)
+		assert.Nil(t, item.Available)
+	}
+	m.registryRepo.AssertNotCalled(t, "ReservedQuantities", mock.Anything, mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestRegistryService_GuestViewShowsAvailability(t *testing.T) {
+	service, m := newRegistryService()
+	customerID := uuid.New()
+
+	m.registryRepo.On("GetByToken", "abc").
+		Return(&models.WishlistRegistry{CustomerID: customerID, Token: "abc"}, nil)
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(registryItems(customerID), nil)
+	m.registryRepo.On("ReservedQuantities", customerID.String(), mock.Anything).Return(map[int32]int{1: 1, 2: 3}, nil)
+
+	registry, err := service.GetGuestRegistry("abc")
+
+	assert.NoError(t, err)
+	assert.Empty(t, registry.Token)
+	assert.Equal(t, 1, *registry.Items[0].Reserved)
+	assert.Equal(t, 1, *registry.Items[0].Available)
+	// More reserved than wanted after the owner lowered the quantity
+	assert.Equal(t, 1, *registry.Items[1].Reserved)
+	assert.Equal(t, 0, *registry.Items[1].Available)
+	m.assertExpectations(t)
+}
+
+func TestRegistryService_ReservePending(t *testing.T) {
+	service, m := newRegistryService()
+	customerID := uuid.New()
+
+	m.registryRepo.On("GetByToken", "abc").Return(&models.WishlistRegistry{CustomerID: customerID}, nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(registryItems(customerID), nil)
+	m.registryRepo.On("Reserve", mock.MatchedBy(func(r *models.RegistryReservation) bool {
+		return r.CustomerID == customerID && r.ProductID == 1 && r.Quantity == 2 &&
+			r.Status == models.ReservationPending && r.ExpiresAt != nil && r.ExpiresAt.After(time.Now())
+	}), mock.Anything).Return(true, nil)
+
+	reservation, err := service.Reserve("abc", models.ReservationRequest{ProductID: 1, Quantity: 2, GuestName: "Ana"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Ana", reservation.GuestName)
+	m.assertExpectations(t)
+}
+
+func TestRegistryService_ReserveNotEnoughLeft(t *testing.T) {
+	service, m := newRegistryService()
+	customerID := uuid.New()
+
+	m.registryRepo.On("GetByToken", "abc").Return(&models.WishlistRegistry{CustomerID: customerID}, nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(registryItems(customerID), nil)
+	m.registryRepo.On("Reserve", mock.Anything, mock.Anything).Return(false, nil)
+
+	_, err := service.Reserve("abc", models.ReservationRequest{ProductID: 2, Quantity: 1, GuestName: "Ana"})
+
+	assert.IsType(t, &exceptions.BadRequestError{}, err)
+	m.assertExpectations(t)
+}
+
+func TestRegistryService_ReserveUnknownRegistry(t *testing.T) {
+	service, m := newRegistryService()
+
+	m.registryRepo.On("GetByToken", "nope").Return(nil, nil)
+
+	_, err := service.Reserve("nope", models.ReservationRequest{ProductID: 1, Quantity: 1})
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	m.assertExpectations(t)
+}
+
+func TestRegistryService_ConfirmReservation(t *testing.T) {
+	service, m := newRegistryService()
+	customerID := uuid.New()
+	reservationID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+
+	m.registryRepo.On("GetByToken", "abc").Return(&models.WishlistRegistry{CustomerID: customerID}, nil)
+	m.registryRepo.On("GetReservation", customerID.String(), reservationID.String()).Return(&models.RegistryReservation{
+		BaseModel: models.BaseModel{ID: reservationID},
+		Status:    models.ReservationPending,
+		ExpiresAt: &expiresAt,
+	}, nil)
+	m.registryRepo.On("SaveReservation", mock.MatchedBy(func(r *models.RegistryReservation) bool {
+		return r.Status == models.ReservationConfirmed && r.ExpiresAt == nil && r.ConfirmedAt != nil
+	})).Return(nil)
+
+	reservation, err := service.ConfirmReservation("abc", reservationID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReservationConfirmed, reservation.Status)
+	m.assertExpectations(t)
+}
+
+func TestRegistryService_ConfirmExpiredReservation(t *testing.T) {
+	service, m := newRegistryService()
+	customerID := uuid.New()
+	reservationID := uuid.New().String()
+	expiredAt := time.Now().Add(-time.Minute)
+
+	m.registryRepo.On("GetByToken", "abc").Return(&models.WishlistRegistry{CustomerID: customerID}, nil)
+	m.registryRepo.On("GetReservation", customerID.String(), reservationID).Return(&models.RegistryReservation{
+		Status:    models.ReservationPending,
+		ExpiresAt: &expiredAt,
+	}, nil)
+
+	_, err := service.ConfirmReservation("abc", reservationID)
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	m.registryRepo.AssertNotCalled(t, "SaveReservation", mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestRegistryExpiryJob_DeletesExpired(t *testing.T) {
+	registryRepo := new(mocks.RegistryQuerier)
+	registryRepo.On("DeleteExpiredReservations", mock.Anything).Return(int64(3), nil)
+
+	job := NewRegistryExpiryJob(registryRepo)
+	err := job.Run()
+
+	assert.NoError(t, err)
+	registryRepo.AssertExpectations(t)
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+type ReminderService struct {
+	CustomerRepository querier.CustomerQuerier
+	ReminderRepository querier.ReminderQuerier
+}
+
+func NewReminderService(customerRepository querier.CustomerQuerier,
+	reminderRepository querier.ReminderQuerier) servicers.ReminderServicer {
+	return &ReminderService{
+		CustomerRepository: customerRepository,
+		ReminderRepository: reminderRepository,
+	}
+}
+
+// ListReminders returns the reminders the customer got, newest first
+func (rs *ReminderService) ListReminders(customerID string) ([]models.WishlistReminder, error) {
+	if _, err := rs.getCustomer(customerID); err != nil {
+		return nil, err
+	}
+	return rs.ReminderRepository.ListByCustomer(customerID)
+}
+
+func (rs *ReminderService) GetPreference(customerID string) (*models.ReminderPreference, error) {
+	customer, err := rs.getCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	preference, err := rs.ReminderRepository.GetPreference(customerID)
+	if err != nil {
+		return nil, err
+	}
+	if preference == nil {
+		preference = &models.ReminderPreference{CustomerID: customer.ID}
+	}
+	return preference, nil
+}
+
+func (rs *ReminderService) UpdatePreference(customerID string, staleItemsOptOut bool) (*models.ReminderPreference, error) {
+	customer, err := rs.getCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	preference := &models.ReminderPreference{
+		CustomerID:       customer.ID,
+		StaleItemsOptOut: staleItemsOptOut,
+	}
+	if err := rs.ReminderRepository.SavePreference(preference); err != nil {
+		return nil, err
+	}
+	return preference, nil
+}
+
+// ListPending returns the reminders the notification channels have to deliver
+func (rs *ReminderService) ListPending(limit int) ([]models.WishlistReminder, error) {
+	return rs.ReminderRepository.ListPending(limit)
+}
+
+func (rs *ReminderService) MarkDelivered(reminderID string) error {
+	marked, err := rs.ReminderRepository.MarkDelivered(reminderID, time.Now())
+	if err != nil {
+		return err
+	}
+	if !marked {
+		return &exceptions.NotFoundEntityError{
+			Reason: "pending reminder not found",
+		}
+	}
+	return nil
+}
+
+func (rs *ReminderService) getCustomer(customerID string) (*models.Customer, error) {
+	customer, err := rs.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	return customer, nil
+}
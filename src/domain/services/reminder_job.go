@@ -0,0 +1,52 @@
+package services
+
+import (
+	"log"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/infrastructure/config"
+)
+
+// reminderBatchSize bounds the reminders created by each insert
+const reminderBatchSize = 500
+
+// StaleItemReminderJob creates a reminder for the wishlist items older than
+// WISHLIST_REMINDER_AGE. The notification channels pick them up as pending
+// reminders and mark them delivered.
+type StaleItemReminderJob struct {
+	ReminderRepository querier.ReminderQuerier
+}
+
+func NewStaleItemReminderJob(reminderRepository querier.ReminderQuerier) servicers.Job {
+	return &StaleItemReminderJob{ReminderRepository: reminderRepository}
+}
+
+func (j *StaleItemReminderJob) Name() string {
+	return "wishlist-stale-reminders"
+}
+
+func (j *StaleItemReminderJob) Interval() time.Duration {
+	return config.WISHLIST_REMINDER_INTERVAL
+}
+
+func (j *StaleItemReminderJob) Run() error {
+	addedBefore := time.Now().Add(-config.WISHLIST_REMINDER_AGE)
+
+	var total int64
+	for {
+		created, err := j.ReminderRepository.CreateStaleReminders(addedBefore, reminderBatchSize)
+		if err != nil {
+			return err
+		}
+		total += created
+		if created < reminderBatchSize {
+			break
+		}
+	}
+	if total > 0 {
+		log.Printf("created %d stale wishlist item reminders", total)
+	}
+	return nil
+}
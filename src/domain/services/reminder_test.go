@@ -0,0 +1,87 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func TestGetPreference_DefaultsToReminders(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	reminderRepo := new(mocks.ReminderQuerier)
+	service := NewReminderService(customerRepo, reminderRepo)
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	reminderRepo.On("GetPreference", customerID.String()).Return(nil, nil)
+
+	preference, err := service.GetPreference(customerID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, customerID, preference.CustomerID)
+	assert.False(t, preference.StaleItemsOptOut)
+}
+
+func TestUpdatePreference_OptOut(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	reminderRepo := new(mocks.ReminderQuerier)
+	service := NewReminderService(customerRepo, reminderRepo)
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	reminderRepo.On("SavePreference", &models.ReminderPreference{CustomerID: customerID, StaleItemsOptOut: true}).Return(nil)
+
+	preference, err := service.UpdatePreference(customerID.String(), true)
+
+	assert.NoError(t, err)
+	assert.True(t, preference.StaleItemsOptOut)
+	reminderRepo.AssertExpectations(t)
+}
+
+func TestListReminders_CustomerNotFound(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	reminderRepo := new(mocks.ReminderQuerier)
+	service := NewReminderService(customerRepo, reminderRepo)
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(nil, nil)
+
+	_, err := service.ListReminders(customerID.String())
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	reminderRepo.AssertNotCalled(t, "ListByCustomer", mock.Anything)
+}
+
+func TestMarkDelivered_NotPending(t *testing.T) {
+	reminderRepo := new(mocks.ReminderQuerier)
+	service := NewReminderService(new(mocks.CustomerQuerier), reminderRepo)
+
+	reminderRepo.On("MarkDelivered", "reminder-id", mock.AnythingOfType("time.Time")).Return(false, nil)
+
+	err := service.MarkDelivered("reminder-id")
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+}
+
+func TestStaleItemReminderJob_RunsBatchesUntilDone(t *testing.T) {
+	reminderRepo := new(mocks.ReminderQuerier)
+	cutoff := mock.MatchedBy(func(addedBefore time.Time) bool {
+		age := time.Since(addedBefore)
+		return age >= config.WISHLIST_REMINDER_AGE && age < config.WISHLIST_REMINDER_AGE+time.Minute
+	})
+	reminderRepo.On("CreateStaleReminders", cutoff, reminderBatchSize).Return(int64(reminderBatchSize), nil).Once()
+	reminderRepo.On("CreateStaleReminders", cutoff, reminderBatchSize).Return(int64(12), nil).Once()
+
+	job := NewStaleItemReminderJob(reminderRepo)
+
+	assert.NoError(t, job.Run())
+	reminderRepo.AssertExpectations(t)
+}
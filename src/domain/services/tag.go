@@ -0,0 +1,130 @@
+package services
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+type TagService struct {
+	CustomerRepository querier.CustomerQuerier
+	TagRepository      querier.TagQuerier
+}
+
+func NewTagService(customerRepository querier.CustomerQuerier,
+	tagRepository querier.TagQuerier) servicers.TagServicer {
+	return &TagService{
+		CustomerRepository: customerRepository,
+		TagRepository:      tagRepository,
+	}
+}
+
+// ListTags returns the customer tags with how many items use each of them
+func (ts *TagService) ListTags(customerID string) ([]models.TagUsage, error) {
+	if _, err := ts.getCustomer(customerID); err != nil {
+		return nil, err
+	}
+	return ts.TagRepository.ListUsage(customerID)
+}
+
+// AttachTags puts the tags on the wishlist item and returns all its tags
+func (ts *TagService) AttachTags(customerID string, productID int32, names []string) ([]string, error) {
+	customer, err := ts.getCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+	if !inWishlist(customer, productID) {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "product not in wishlist",
+		}
+	}
+
+	tags, err := normalizeTags(names)
+	if err != nil {
+		return nil, err
+	}
+	if err := ts.TagRepository.Attach(customerID, productID, tags); err != nil {
+		return nil, err
+	}
+
+	byProduct, err := ts.TagRepository.TagsByProduct(customerID)
+	if err != nil {
+		return nil, err
+	}
+	return byProduct[productID], nil
+}
+
+func (ts *TagService) DetachTag(customerID string, productID int32, name string) error {
+	if _, err := ts.getCustomer(customerID); err != nil {
+		return err
+	}
+	detached, err := ts.TagRepository.Detach(customerID, productID, models.NormalizeTag(name))
+	if err != nil {
+		return err
+	}
+	if !detached {
+		return &exceptions.NotFoundEntityError{
+			Reason: "tag not on wishlist item",
+		}
+	}
+	return nil
+}
+
+// DeleteTag removes the tag from the customer and from all of its items
+func (ts *TagService) DeleteTag(customerID string, name string) error {
+	if _, err := ts.getCustomer(customerID); err != nil {
+		return err
+	}
+	deleted, err := ts.TagRepository.Delete(customerID, models.NormalizeTag(name))
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return &exceptions.NotFoundEntityError{
+			Reason: "tag not found",
+		}
+	}
+	return nil
+}
+
+func (ts *TagService) getCustomer(customerID string) (*models.Customer, error) {
+	customer, err := ts.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	return customer, nil
+}
+
+func inWishlist(customer *models.Customer, productID int32) bool {
+	for _, p := range customer.Wishlist {
+		if p.ID == productID {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeTags normalizes and deduplicates the tag names
+func normalizeTags(names []string) ([]string, error) {
+	seen := map[string]bool{}
+	tags := make([]string, 0, len(names))
+	for _, name := range names {
+		tag := models.NormalizeTag(name)
+		if tag == "" || utf8.RuneCountInString(tag) > models.MaxTagLength {
+			return nil, &exceptions.InvalidEntityError{
+				Reason: fmt.Sprintf("tags must have 1 to %d characters", models.MaxTagLength),
+			}
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
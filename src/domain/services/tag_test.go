@@ -0,0 +1,92 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func TestAttachTags_NormalizesAndDeduplicates(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	tagRepo := new(mocks.TagQuerier)
+	service := NewTagService(customerRepo, tagRepo)
+
+	customerID := uuid.New()
+	customer := createCustomer(customerID, []*models.Product{createProduct(1)})
+	customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	tagRepo.On("Attach", customerID.String(), int32(1), []string{"birthday ideas", "gift"}).Return(nil)
+	tagRepo.On("TagsByProduct", customerID.String()).
+		Return(map[int32][]string{1: {"birthday ideas", "gift", "kitchen"}}, nil)
+
+	tags, err := service.AttachTags(customerID.String(), 1, []string{" Birthday   Ideas", "gift", "GIFT"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"birthday ideas", "gift", "kitchen"}, tags)
+	customerRepo.AssertExpectations(t)
+	tagRepo.AssertExpectations(t)
+}
+
+func TestAttachTags_ProductNotInWishlist(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	tagRepo := new(mocks.TagQuerier)
+	service := NewTagService(customerRepo, tagRepo)
+
+	customerID := uuid.New()
+	customer := createCustomer(customerID, []*models.Product{createProduct(1)})
+	customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+
+	_, err := service.AttachTags(customerID.String(), 2, []string{"gift"})
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	tagRepo.AssertNotCalled(t, "Attach", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAttachTags_InvalidName(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	tagRepo := new(mocks.TagQuerier)
+	service := NewTagService(customerRepo, tagRepo)
+
+	customerID := uuid.New()
+	customer := createCustomer(customerID, []*models.Product{createProduct(1)})
+	customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+
+	_, err := service.AttachTags(customerID.String(), 1, []string{"   "})
+
+	assert.IsType(t, &exceptions.InvalidEntityError{}, err)
+	tagRepo.AssertNotCalled(t, "Attach", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeleteTag_NotFound(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	tagRepo := new(mocks.TagQuerier)
+	service := NewTagService(customerRepo, tagRepo)
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	tagRepo.On("Delete", customerID.String(), "gift").Return(false, nil)
+
+	err := service.DeleteTag(customerID.String(), "Gift")
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	tagRepo.AssertExpectations(t)
+}
+
+func TestListTags_CustomerNotFound(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	tagRepo := new(mocks.TagQuerier)
+	service := NewTagService(customerRepo, tagRepo)
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(nil, nil)
+
+	_, err := service.ListTags(customerID.String())
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	tagRepo.AssertNotCalled(t, "ListUsage", mock.Anything)
+}
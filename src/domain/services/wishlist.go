@@ -1,61 +1,149 @@
 package services
 
 import (
+	"errors"
+	"log"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
 	querier "produtos-favoritos/src/domain/interfaces/repositories"
 	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
 	"produtos-favoritos/src/internals/exceptions"
 )
 
+// productFetchConcurrency bounds the parallel calls to the products api
+const productFetchConcurrency = 8
+
 type WishlistService struct {
 	CustomerRepository querier.CustomerQuerier
+	WishlistRepository querier.WishlistQuerier
+	ProductRepository  querier.ProductQuerier
 	ProductService     servicers.ProductServicer
+	// CollaboratorRepository holds who the wishlist is shared with
+	CollaboratorRepository querier.WishlistCollaboratorQuerier
+	TagRepository          querier.TagQuerier
+	// TrashRepository keeps the removed items for a while, see WishlistTrashService
+	TrashRepository querier.WishlistTrashQuerier
+	// PolicyService checks the wishlist rules before adding or removing items
+	PolicyService servicers.PolicyServicer
 }
 
 func NewWishlistService(customerRepository querier.CustomerQuerier,
-	productService servicers.ProductServicer) servicers.WishlistServicer {
-	return &WishlistService{customerRepository, productService}
+	wishlistRepository querier.WishlistQuerier,
+	productRepository querier.ProductQuerier,
+	productService servicers.ProductServicer,
+	collaboratorRepository querier.WishlistCollaboratorQuerier,
+	tagRepository querier.TagQuerier,
+	trashRepository querier.WishlistTrashQuerier,
+	policyService servicers.PolicyServicer) servicers.WishlistServicer {
+	return &WishlistService{
+		CustomerRepository:     customerRepository,
+		WishlistRepository:     wishlistRepository,
+		ProductRepository:      productRepository,
+		ProductService:         productService,
+		CollaboratorRepository: collaboratorRepository,
+		TagRepository:          tagRepository,
+		TrashRepository:        trashRepository,
+		PolicyService:          policyService,
+	}
 }
 
-func (ws *WishlistService) WishlistProduct(productID int32, customerID string) error {
+// WishlistProduct adds the product to the customer wishlist on behalf of the
+// actor, the owner or an editor of the shared wishlist, when the wishlist
+// rules allow it.
+func (ws *WishlistService) WishlistProduct(productID int32, customerID string, actorID string, partnerKey string) (*models.WishlistItem, error) {
 	customer, err := ws.CustomerRepository.GetByID(customerID)
-	if err != nil {
-		return &exceptions.NotFoundEntityError{
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
 			Reason: "customer not found",
 		}
 	}
-
-	product, err := ws.ProductService.GetProductByID(productID)
+	actor, err := ws.authorize(customer, actorID, models.CollaboratorEditor)
 	if err != nil {
-		return &exceptions.NotFoundEntityError{
-			Reason: "product not found",
-		}
+		return nil, err
 	}
 
 	// Check if product already in wishlist
 	for _, p := range customer.Wishlist {
-		if p.ID == product.ID {
-			return &exceptions.AlreadyWishlistedErr{
+		if p.ID == productID {
+			return nil, &exceptions.AlreadyWishlistedErr{
 				Reason: "product already in wishlist",
 			}
 		}
 	}
 
-	// Add product to wishlist
-	customer.Wishlist = append(customer.Wishlist, product)
+	item := &models.WishlistItem{
+		CustomerID: customer.ID,
+		ProductID:  productID,
+		Status:     models.WishlistItemConfirmed,
+		AddedBy:    &actor,
+	}
 
-	// Save updated customer
-	ws.CustomerRepository.Update(customer)
+	product, err := ws.ProductService.GetProductByID(productID)
+	var notFoundErr *exceptions.NotFoundEntityError
+	switch {
+	case errors.As(err, &notFoundErr):
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "product not found",
+		}
+	case err != nil:
+		// Products api is unavailable, the item is kept as pending until the
+		// validation job can check the product against the catalog again.
+		product, err = ws.lastKnownProduct(productID)
+		if err != nil {
+			return nil, err
+		}
+		item.Status = models.WishlistItemPending
+		item.PartnerKey = partnerKey
+	default:
+		if err := ws.ProductRepository.Upsert(product); err != nil {
+			return nil, err
+		}
+	}
 
-	return err
+	// The rules of pending items are checked by the validation job, the
+	// product data stored locally can't be trusted to decide them.
+	if item.Status == models.WishlistItemConfirmed {
+		err = ws.PolicyService.Check(models.PolicyInput{
+			Action:     models.RuleActionAdd,
+			Customer:   customer,
+			Product:    product,
+			ActorID:    actor.String(),
+			PartnerKey: partnerKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	item.AddedPrice = product.Price
+	if err := ws.WishlistRepository.Add(item); err != nil {
+		return nil, err
+	}
+	item.Product = product
+
+	return item, nil
 }
 
-func (ws *WishlistService) RemoveProductFromWishlist(customerID string, productID int32) error {
+// RemoveProductFromWishlist moves the item to the trash, where it can be
+// restored from until the retention ends.
+func (ws *WishlistService) RemoveProductFromWishlist(customerID string, productID int32, actorID string, partnerKey string) error {
 	customer, err := ws.CustomerRepository.GetByID(customerID)
-	if err != nil {
+	if err != nil || customer == nil {
 		return &exceptions.NotFoundEntityError{
 			Reason: "customer not found",
 		}
 	}
+	actor, err := ws.authorize(customer, actorID, models.CollaboratorEditor)
+	if err != nil {
+		return err
+	}
 
 	// Check if product exists in wishlist
 	index := -1
@@ -71,12 +159,349 @@ func (ws *WishlistService) RemoveProductFromWishlist(customerID string, productI
 		}
 	}
 
-	_, err = ws.ProductService.GetProductByID(productID)
+	err = ws.PolicyService.Check(models.PolicyInput{
+		Action:     models.RuleActionRemove,
+		Customer:   customer,
+		Product:    customer.Wishlist[index],
+		ActorID:    actor.String(),
+		PartnerKey: partnerKey,
+	})
 	if err != nil {
-		return &exceptions.NotFoundEntityError{
-			Reason: "product not found",
+		return err
+	}
+
+	if config.WISHLIST_TRASH_RETENTION <= 0 {
+		return ws.CustomerRepository.RemoveProductFromWishlist(customerID, productID)
+	}
+	expiresAt := time.Now().Add(config.WISHLIST_TRASH_RETENTION)
+	return ws.TrashRepository.Trash(customerID, productID, &actor, expiresAt)
+}
+
+// GetWishlist returns the wishlist items with the current catalog data. When
+// the products api can't be reached the locally stored data is returned and
+// the wishlist is flagged as stale. Viewers of a shared wishlist can see it too.
+// The filter keeps only the items with the tags.
+func (ws *WishlistService) GetWishlist(customerID string, actorID string, filter models.WishlistFilter) (*models.Wishlist, error) {
+	customer, err := ws.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	if _, err := ws.authorize(customer, actorID, models.CollaboratorViewer); err != nil {
+		return nil, err
+	}
+
+	items, err := ws.WishlistRepository.ListByCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+	items, err = ws.tagItems(customerID, items, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlist := &models.Wishlist{
+		CustomerID: customer.ID,
+		Items:      items,
+	}
+	if len(items) > 0 {
+		wishlist.Stale = !ws.refreshProducts(customerID, wishlist.Items)
+	}
+	markAvailability(wishlist.Items)
+
+	return wishlist, nil
+}
+
+// MarkWishlisted flags the products in the customer wishlist and lists their
+// collections, with one query for the whole page. An unknown customer has
+// nothing wishlisted.
+func (ws *WishlistService) MarkWishlisted(customerID string, products ...*models.Product) error {
+	productIDs := make([]int32, len(products))
+	for i, product := range products {
+		productIDs[i] = product.ID
+	}
+
+	memberships, err := ws.WishlistRepository.ListMemberships(customerID, productIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		collections, wishlisted := memberships[product.ID]
+		product.Wishlisted = &wishlisted
+		product.Collections = collections
+	}
+	return nil
+}
+
+// authorize checks the actor can access the customer wishlist with the role
+// and returns its id, see authorizeWishlistActor
+func (ws *WishlistService) authorize(customer *models.Customer, actorID string, role string) (uuid.UUID, error) {
+	return authorizeWishlistActor(ws.CollaboratorRepository, customer, actorID, role)
+}
+
+// authorizeWishlistActor checks the actor can access the customer wishlist
+// with the role and returns its id. The owner can do anything, other
+// customers need an accepted invitation, with the editor role to change the
+// items.
+func authorizeWishlistActor(collaboratorRepository querier.WishlistCollaboratorQuerier, customer *models.Customer,
+	actorID string, role string) (uuid.UUID, error) {
+	if actorID == "" {
+		return uuid.Nil, &exceptions.ForbiddenError{
+			Reason: "the acting customer is required",
+		}
+	}
+	if actorID == customer.ID.String() {
+		return customer.ID, nil
+	}
+
+	collaborator, err := collaboratorRepository.Get(customer.ID.String(), actorID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if collaborator == nil || !collaborator.CanView() {
+		return uuid.Nil, &exceptions.ForbiddenError{
+			Reason: "wishlist is not shared with this customer",
+		}
+	}
+	if role == models.CollaboratorEditor && !collaborator.CanEdit() {
+		return uuid.Nil, &exceptions.ForbiddenError{
+			Reason: "only editors can change this wishlist",
+		}
+	}
+	return collaborator.CollaboratorID, nil
+}
+
+// tagItems sets the tags of the items and drops the ones out of the filter
+func (ws *WishlistService) tagItems(customerID string, items []models.WishlistItem, filter models.WishlistFilter) ([]models.WishlistItem, error) {
+	tags, err := ws.TagRepository.TagsByProduct(customerID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].Tags = tags[items[i].ProductID]
+		if items[i].Tags == nil {
+			items[i].Tags = []string{}
 		}
 	}
+	if len(filter.Tags) == 0 {
+		return items, nil
+	}
+
+	names := make([]string, len(filter.Tags))
+	for i, tag := range filter.Tags {
+		names[i] = models.NormalizeTag(tag)
+	}
+	productIDs, err := ws.TagRepository.ProductIDsByTags(customerID, names, filter.MatchAll)
+	if err != nil {
+		return nil, err
+	}
+	matched := make(map[int32]bool, len(productIDs))
+	for _, productID := range productIDs {
+		matched[productID] = true
+	}
 
-	return ws.CustomerRepository.RemoveProductFromWishlist(customerID, productID)
+	filtered := make([]models.WishlistItem, 0, len(productIDs))
+	for _, item := range items {
+		if matched[item.ProductID] {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+// refreshProducts replaces the stored product data of the items with the
+// current catalog, reporting false when the products api is unavailable.
+func (ws *WishlistService) refreshProducts(customerID string, items []models.WishlistItem) bool {
+	productIDs := make([]int32, len(items))
+	for i, item := range items {
+		productIDs[i] = item.ProductID
+	}
+	products, errs := fetchProducts(ws.ProductService, productIDs)
+
+	fresh := true
+	for _, err := range errs {
+		// products gone from the catalog keep their stored data
+		var notFoundErr *exceptions.NotFoundEntityError
+		if !errors.As(err, &notFoundErr) {
+			fresh = false
+		}
+	}
+	if !fresh {
+		log.Printf("serving stale wishlist for customer %s: products api unavailable", customerID)
+	}
+
+	for i := range items {
+		product, ok := products[items[i].ProductID]
+		if !ok {
+			continue
+		}
+		items[i].Product = product
+		if err := ws.ProductRepository.Upsert(product); err != nil {
+			log.Printf("could not refresh product %d: %v", product.ID, err)
+		}
+	}
+
+	return fresh
+}
+
+// GetWishlistSummary aggregates the wishlist value with the current catalog
+// prices. Products that can't be fetched are counted with their stored data
+// and the summary is flagged as stale.
+func (ws *WishlistService) GetWishlistSummary(customerID string, actorID string) (*models.WishlistSummary, error) {
+	customer, err := ws.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	if _, err := ws.authorize(customer, actorID, models.CollaboratorViewer); err != nil {
+		return nil, err
+	}
+
+	items, err := ws.WishlistRepository.ListByCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	productIDs := make([]int32, len(items))
+	for i, item := range items {
+		productIDs[i] = item.ProductID
+	}
+	products, errs := fetchProducts(ws.ProductService, productIDs)
+
+	summary := &models.WishlistSummary{
+		CustomerID:   customer.ID,
+		ItemCount:    len(items),
+		CurrentTotal: models.NewMoney(0, models.DefaultCurrency),
+		AddedTotal:   models.NewMoney(0, models.DefaultCurrency),
+		Categories:   []models.WishlistCategorySummary{},
+		Stale:        len(errs) > 0,
+	}
+
+	categories := map[string]*models.WishlistCategorySummary{}
+	// Products without any review are left out of the average
+	var ratingSum float64
+	for _, item := range items {
+		product, ok := products[item.ProductID]
+		if !ok {
+			if item.Product == nil {
+				continue
+			}
+			product = item.Product
+		}
+
+		current := product.Price
+		added := item.AddedPrice
+		if added.IsZero() {
+			added = current
+		}
+
+		summary.CurrentTotal = summary.CurrentTotal.Add(current)
+		summary.AddedTotal = summary.AddedTotal.Add(added)
+
+		category, ok := categories[product.Category]
+		if !ok {
+			category = &models.WishlistCategorySummary{
+				Category:     product.Category,
+				CurrentTotal: models.NewMoney(0, models.DefaultCurrency),
+				AddedTotal:   models.NewMoney(0, models.DefaultCurrency),
+			}
+			categories[product.Category] = category
+		}
+		category.ItemCount++
+		category.CurrentTotal = category.CurrentTotal.Add(current)
+		category.AddedTotal = category.AddedTotal.Add(added)
+
+		summaryItem := &models.WishlistSummaryItem{
+			ProductID:    product.ID,
+			Title:        product.Title,
+			CurrentPrice: current,
+			AddedPrice:   added,
+		}
+		if summary.Cheapest == nil || current.Cmp(summary.Cheapest.CurrentPrice) < 0 {
+			summary.Cheapest = summaryItem
+		}
+		if summary.MostExpensive == nil || current.Cmp(summary.MostExpensive.CurrentPrice) > 0 {
+			summary.MostExpensive = summaryItem
+		}
+
+		if product.Rating.Count > 0 {
+			ratingSum += float64(product.Rating.Rate)
+			summary.RatedCount++
+		}
+	}
+
+	summary.Difference = summary.CurrentTotal.Sub(summary.AddedTotal)
+	if summary.RatedCount > 0 {
+		summary.AverageRating = float32(math.Round(ratingSum/float64(summary.RatedCount)*100) / 100)
+	}
+	for _, category := range categories {
+		summary.Categories = append(summary.Categories, *category)
+	}
+	sort.Slice(summary.Categories, func(i, j int) bool {
+		return summary.Categories[i].Category < summary.Categories[j].Category
+	})
+
+	return summary, nil
+}
+
+// fetchProducts gets the current catalog data of the products in a single
+// pass over a bounded pool of workers, the failures are returned by product.
+func fetchProducts(productService servicers.ProductServicer, productIDs []int32) (map[int32]*models.Product, map[int32]error) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	products := make(map[int32]*models.Product, len(productIDs))
+	errs := map[int32]error{}
+
+	queue := make(chan int32)
+	for range min(productFetchConcurrency, len(productIDs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for productID := range queue {
+				product, err := productService.GetProductByID(productID)
+
+				mu.Lock()
+				if err != nil {
+					log.Printf("could not fetch product %d: %v", productID, err)
+					errs[productID] = err
+				} else {
+					products[productID] = product
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, productID := range productIDs {
+		queue <- productID
+	}
+	close(queue)
+	wg.Wait()
+
+	return products, errs
+}
+
+// lastKnownProduct returns the locally stored product, creating a
+// placeholder when the product was never seen so the item can be queued.
+func (ws *WishlistService) lastKnownProduct(productID int32) (*models.Product, error) {
+	product, err := ws.ProductRepository.GetByID(productID)
+	if err != nil {
+		return nil, err
+	}
+	if product != nil {
+		return product, nil
+	}
+
+	if err := ws.ProductRepository.AddPlaceholder(productID); err != nil {
+		return nil, err
+	}
+	return &models.Product{ID: productID, Placeholder: true}, nil
+}
+
+func markAvailability(items []models.WishlistItem) {
+	for i := range items {
+		items[i].Available = items[i].Product == nil || !items[i].Product.Discontinued
+	}
 }
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+type WishlistCollaborationService struct {
+	CustomerRepository     querier.CustomerQuerier
+	CollaboratorRepository querier.WishlistCollaboratorQuerier
+}
+
+func NewWishlistCollaborationService(customerRepository querier.CustomerQuerier,
+	collaboratorRepository querier.WishlistCollaboratorQuerier) servicers.WishlistCollaborationServicer {
+	return &WishlistCollaborationService{
+		CustomerRepository:     customerRepository,
+		CollaboratorRepository: collaboratorRepository,
+	}
+}
+
+// Invite shares the owner wishlist with another customer, only the owner
+// can invite. Inviting someone again changes the role, a declined invitation
+// is sent again as pending.
+func (s *WishlistCollaborationService) Invite(ownerID string, actorID string, invite models.WishlistInvite) (*models.WishlistCollaborator, error) {
+	owner, err := s.CustomerRepository.GetByID(ownerID)
+	if err != nil || owner == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	if actorID != owner.ID.String() {
+		return nil, &exceptions.ForbiddenError{
+			Reason: "only the owner can share the wishlist",
+		}
+	}
+
+	var invited *models.Customer
+	if invite.CustomerID != "" {
+		invited, err = s.CustomerRepository.GetByID(invite.CustomerID)
+	} else {
+		invited, err = s.CustomerRepository.GetByEmail(invite.Email)
+	}
+	if err != nil || invited == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "invited customer not found",
+		}
+	}
+	if invited.ID == owner.ID {
+		return nil, &exceptions.BadRequestError{
+			Reason: "a customer can't be invited to their own wishlist",
+		}
+	}
+
+	collaborator, err := s.CollaboratorRepository.Get(owner.ID.String(), invited.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if collaborator == nil {
+		collaborator = &models.WishlistCollaborator{
+			OwnerID:        owner.ID,
+			CollaboratorID: invited.ID,
+			Status:         models.InvitationPending,
+		}
+	}
+	if collaborator.Status == models.InvitationDeclined {
+		collaborator.Status = models.InvitationPending
+		collaborator.RespondedAt = nil
+	}
+	collaborator.Role = invite.Role
+
+	if err := s.CollaboratorRepository.Save(collaborator); err != nil {
+		return nil, err
+	}
+	collaborator.Collaborator = invited
+	return collaborator, nil
+}
+
+func (s *WishlistCollaborationService) ListCollaborators(ownerID string) ([]models.WishlistCollaborator, error) {
+	owner, err := s.CustomerRepository.GetByID(ownerID)
+	if err != nil || owner == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	return s.CollaboratorRepository.ListByOwner(ownerID)
+}
+
+// RemoveCollaborator stops sharing the wishlist, the items the collaborator
+// added stay in it. Only the owner can remove collaborators.
+func (s *WishlistCollaborationService) RemoveCollaborator(ownerID string, actorID string, collaboratorID string) error {
+	if actorID != ownerID {
+		return &exceptions.ForbiddenError{
+			Reason: "only the owner can stop sharing the wishlist",
+		}
+	}
+	collaborator, err := s.CollaboratorRepository.Get(ownerID, collaboratorID)
+	if err != nil {
+		return err
+	}
+	if collaborator == nil {
+		return &exceptions.NotFoundEntityError{
+			Reason: "collaborator not found",
+		}
+	}
+	return s.CollaboratorRepository.Remove(ownerID, collaboratorID)
+}
+
+// ListInvitations returns the wishlists shared with the customer
+func (s *WishlistCollaborationService) ListInvitations(customerID string) ([]models.WishlistCollaborator, error) {
+	customer, err := s.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	return s.CollaboratorRepository.ListByCollaborator(customerID)
+}
+
+// RespondInvitation accepts or declines a pending invitation to the owner
+// wishlist, only the invited customer can answer it
+func (s *WishlistCollaborationService) RespondInvitation(customerID string, actorID string, ownerID string, accept bool) (*models.WishlistCollaborator, error) {
+	if actorID != customerID {
+		return nil, &exceptions.ForbiddenError{
+			Reason: "only the invited customer can answer the invitation",
+		}
+	}
+	collaborator, err := s.CollaboratorRepository.Get(ownerID, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if collaborator == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "invitation not found",
+		}
+	}
+	if collaborator.Status != models.InvitationPending {
+		return nil, &exceptions.BadRequestError{
+			Reason: "invitation was already " + collaborator.Status,
+		}
+	}
+
+	collaborator.Status = models.InvitationDeclined
+	if accept {
+		collaborator.Status = models.InvitationAccepted
+	}
+	now := time.Now()
+	collaborator.RespondedAt = &now
+
+	if err := s.CollaboratorRepository.Save(collaborator); err != nil {
+		return nil, err
+	}
+	return collaborator, nil
+}
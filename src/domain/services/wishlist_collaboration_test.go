@@ -0,0 +1,186 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func newWishlistCollaborationService() (*WishlistCollaborationService, *mocks.CustomerQuerier, *mocks.WishlistCollaboratorQuerier) {
+	customerRepo := new(mocks.CustomerQuerier)
+	collabRepo := new(mocks.WishlistCollaboratorQuerier)
+	service := NewWishlistCollaborationService(customerRepo, collabRepo).(*WishlistCollaborationService)
+	return service, customerRepo, collabRepo
+}
+
+func TestWishlistCollaboration_InviteByEmail(t *testing.T) {
+	service, customerRepo, collabRepo := newWishlistCollaborationService()
+	ownerID := uuid.New()
+	invited := createCustomer(uuid.New(), nil)
+
+	customerRepo.On("GetByID", ownerID.String()).Return(createCustomer(ownerID, nil), nil)
+	customerRepo.On("GetByEmail", "partner@test.com").Return(invited, nil)
+	collabRepo.On("Get", ownerID.String(), invited.ID.String()).Return(nil, nil)
+	collabRepo.On("Save", mock.MatchedBy(func(c *models.WishlistCollaborator) bool {
+		return c.OwnerID == ownerID && c.CollaboratorID == invited.ID &&
+			c.Role == models.CollaboratorEditor && c.Status == models.InvitationPending
+	})).Return(nil)
+
+	collaborator, err := service.Invite(ownerID.String(), ownerID.String(), models.WishlistInvite{
+		Email: "partner@test.com",
+		Role:  models.CollaboratorEditor,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, invited, collaborator.Collaborator)
+	customerRepo.AssertExpectations(t)
+	collabRepo.AssertExpectations(t)
+}
+
+func TestWishlistCollaboration_InviteDeclinedAgain(t *testing.T) {
+	service, customerRepo, collabRepo := newWishlistCollaborationService()
+	ownerID := uuid.New()
+	invitedID := uuid.New()
+
+	customerRepo.On("GetByID", ownerID.String()).Return(createCustomer(ownerID, nil), nil)
+	customerRepo.On("GetByID", invitedID.String()).Return(createCustomer(invitedID, nil), nil)
+	collabRepo.On("Get", ownerID.String(), invitedID.String()).Return(&models.WishlistCollaborator{
+		OwnerID:        ownerID,
+		CollaboratorID: invitedID,
+		Role:           models.CollaboratorEditor,
+		Status:         models.InvitationDeclined,
+	}, nil)
+	collabRepo.On("Save", mock.Anything).Return(nil)
+
+	collaborator, err := service.Invite(ownerID.String(), ownerID.String(), models.WishlistInvite{
+		CustomerID: invitedID.String(),
+		Role:       models.CollaboratorViewer,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.InvitationPending, collaborator.Status)
+	assert.Equal(t, models.CollaboratorViewer, collaborator.Role)
+	collabRepo.AssertExpectations(t)
+}
+
+func TestWishlistCollaboration_InviteSelf(t *testing.T) {
+	service, customerRepo, collabRepo := newWishlistCollaborationService()
+	ownerID := uuid.New()
+	owner := createCustomer(ownerID, nil)
+
+	customerRepo.On("GetByID", ownerID.String()).Return(owner, nil)
+
+	_, err := service.Invite(ownerID.String(), ownerID.String(), models.WishlistInvite{
+		CustomerID: ownerID.String(),
+		Role:       models.CollaboratorEditor,
+	})
+
+	assert.IsType(t, &exceptions.BadRequestError{}, err)
+	collabRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestWishlistCollaboration_InviteUnknownCustomer(t *testing.T) {
+	service, customerRepo, _ := newWishlistCollaborationService()
+	ownerID := uuid.New()
+
+	customerRepo.On("GetByID", ownerID.String()).Return(createCustomer(ownerID, nil), nil)
+	customerRepo.On("GetByEmail", "nobody@test.com").Return(nil, nil)
+
+	_, err := service.Invite(ownerID.String(), ownerID.String(), models.WishlistInvite{
+		Email: "nobody@test.com",
+		Role:  models.CollaboratorViewer,
+	})
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+}
+
+func TestWishlistCollaboration_OnlyTheOwnerInvites(t *testing.T) {
+	service, customerRepo, collabRepo := newWishlistCollaborationService()
+	ownerID := uuid.New()
+
+	customerRepo.On("GetByID", ownerID.String()).Return(createCustomer(ownerID, nil), nil)
+
+	_, err := service.Invite(ownerID.String(), uuid.New().String(), models.WishlistInvite{
+		Email: "partner@test.com",
+		Role:  models.CollaboratorEditor,
+	})
+
+	assert.IsType(t, &exceptions.ForbiddenError{}, err)
+	collabRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestWishlistCollaboration_OnlyTheOwnerRemoves(t *testing.T) {
+	service, _, collabRepo := newWishlistCollaborationService()
+	ownerID := uuid.New().String()
+	collaboratorID := uuid.New().String()
+
+	err := service.RemoveCollaborator(ownerID, collaboratorID, collaboratorID)
+
+	assert.IsType(t, &exceptions.ForbiddenError{}, err)
+	collabRepo.AssertNotCalled(t, "Remove", mock.Anything, mock.Anything)
+}
+
+func TestWishlistCollaboration_OnlyTheInvitedCustomerAccepts(t *testing.T) {
+	service, _, collabRepo := newWishlistCollaborationService()
+	ownerID := uuid.New().String()
+	customerID := uuid.New().String()
+
+	_, err := service.RespondInvitation(customerID, ownerID, ownerID, true)
+
+	assert.IsType(t, &exceptions.ForbiddenError{}, err)
+	collabRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	collabRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestWishlistCollaboration_AcceptInvitation(t *testing.T) {
+	service, _, collabRepo := newWishlistCollaborationService()
+	ownerID := uuid.New().String()
+	customerID := uuid.New().String()
+
+	collabRepo.On("Get", ownerID, customerID).Return(&models.WishlistCollaborator{
+		Role:   models.CollaboratorEditor,
+		Status: models.InvitationPending,
+	}, nil)
+	collabRepo.On("Save", mock.Anything).Return(nil)
+
+	collaborator, err := service.RespondInvitation(customerID, customerID, ownerID, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.InvitationAccepted, collaborator.Status)
+	assert.NotNil(t, collaborator.RespondedAt)
+	assert.True(t, collaborator.CanEdit())
+	collabRepo.AssertExpectations(t)
+}
+
+func TestWishlistCollaboration_RespondAnsweredInvitation(t *testing.T) {
+	service, _, collabRepo := newWishlistCollaborationService()
+	ownerID := uuid.New().String()
+	customerID := uuid.New().String()
+
+	collabRepo.On("Get", ownerID, customerID).Return(&models.WishlistCollaborator{
+		Status: models.InvitationDeclined,
+	}, nil)
+
+	_, err := service.RespondInvitation(customerID, customerID, ownerID, true)
+
+	assert.IsType(t, &exceptions.BadRequestError{}, err)
+	collabRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestWishlistCollaboration_RespondMissingInvitation(t *testing.T) {
+	service, _, collabRepo := newWishlistCollaborationService()
+	ownerID := uuid.New().String()
+	customerID := uuid.New().String()
+
+	collabRepo.On("Get", ownerID, customerID).Return(nil, nil)
+
+	_, err := service.RespondInvitation(customerID, customerID, ownerID, false)
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+}
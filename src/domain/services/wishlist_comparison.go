@@ -0,0 +1,144 @@
+package services
+
+import (
+	"fmt"
+	"math"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+// CompareProducts builds the attribute matrix of wishlisted products, in the
+// requested order. Lower prices, higher ratings and longer descriptions win.
+func (ws *WishlistService) CompareProducts(customerID string, actorID string, productIDs []int32) (*models.ProductComparison, error) {
+	customer, err := ws.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	if _, err := ws.authorize(customer, actorID, models.CollaboratorViewer); err != nil {
+		return nil, err
+	}
+
+	items, err := ws.WishlistRepository.ListByCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+	wishlisted := make(map[int32]models.WishlistItem, len(items))
+	for _, item := range items {
+		wishlisted[item.ProductID] = item
+	}
+	for _, productID := range productIDs {
+		if _, ok := wishlisted[productID]; !ok {
+			return nil, &exceptions.NotFoundEntityError{
+				Reason: fmt.Sprintf("product %d not in wishlist", productID),
+			}
+		}
+	}
+
+	fetched, errs := fetchProducts(ws.ProductService, productIDs)
+	products := make([]models.Product, 0, len(productIDs))
+	for _, productID := range productIDs {
+		product, ok := fetched[productID]
+		if !ok {
+			product = wishlisted[productID].Product
+		}
+		if product == nil {
+			product = &models.Product{ID: productID}
+		}
+		products = append(products, *product)
+	}
+
+	comparison := &models.ProductComparison{
+		CustomerID: customer.ID,
+		Products:   make([]models.ComparedProduct, len(products)),
+		Stale:      len(errs) > 0,
+	}
+	for i, p := range products {
+		comparison.Products[i] = models.ComparedProduct{ProductID: p.ID, Title: p.Title, Image: p.Image}
+	}
+
+	comparison.Attributes = []models.ComparisonAttribute{
+		compareNumbers(models.ComparisonPrice, products, false, func(p models.Product) (any, float64) {
+			return p.Price, float64(p.Price.Amount)
+		}),
+		compareNumbers(models.ComparisonRating, products, true, func(p models.Product) (any, float64) {
+			return p.Rating.Rate, float64(p.Rating.Rate)
+		}),
+		compareCategories(products),
+		compareNumbers(models.ComparisonDescriptionLength, products, true, func(p models.Product) (any, float64) {
+			length := len([]rune(p.Description))
+			return length, float64(length)
+		}),
+	}
+
+	return comparison, nil
+}
+
+// compareNumbers fills a numeric attribute row, value returns the value shown
+// and the number used to rank it.
+func compareNumbers(name string, products []models.Product, higherIsBetter bool,
+	value func(models.Product) (any, float64)) models.ComparisonAttribute {
+	attribute := models.ComparisonAttribute{Name: name, Values: make([]any, len(products)), Best: []int32{}}
+
+	lowest, highest := math.Inf(1), math.Inf(-1)
+	var lowestValue, highestValue any
+	numbers := make([]float64, len(products))
+	for i, p := range products {
+		shown, number := value(p)
+		attribute.Values[i] = shown
+		numbers[i] = number
+		if number < lowest {
+			lowest, lowestValue = number, shown
+		}
+		if number > highest {
+			highest, highestValue = number, shown
+		}
+	}
+
+	attribute.AllEqual = lowest == highest
+	attribute.Difference = difference(highestValue, lowestValue)
+	if attribute.AllEqual {
+		return attribute
+	}
+
+	best := lowest
+	if higherIsBetter {
+		best = highest
+	}
+	for i, number := range numbers {
+		if number == best {
+			attribute.Best = append(attribute.Best, products[i].ID)
+		}
+	}
+	return attribute
+}
+
+func compareCategories(products []models.Product) models.ComparisonAttribute {
+	attribute := models.ComparisonAttribute{
+		Name:     models.ComparisonCategory,
+		Values:   make([]any, len(products)),
+		Best:     []int32{},
+		AllEqual: true,
+	}
+	for i, p := range products {
+		attribute.Values[i] = p.Category
+		if p.Category != products[0].Category {
+			attribute.AllEqual = false
+		}
+	}
+	return attribute
+}
+
+func difference(highest any, lowest any) any {
+	switch h := highest.(type) {
+	case models.Money:
+		return h.Sub(lowest.(models.Money))
+	case float32:
+		return float32(math.Round(float64(h-lowest.(float32))*100) / 100)
+	case int:
+		return h - lowest.(int)
+	}
+	return nil
+}
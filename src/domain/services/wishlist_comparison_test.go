@@ -0,0 +1,90 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+func TestCompareProducts_Matrix(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	items := []models.WishlistItem{{ProductID: 1}, {ProductID: 2}, {ProductID: 3}}
+	backpack := &models.Product{ID: 1, Title: "Backpack", Category: "bags", Description: "Fits 15 inch laptops",
+		Price: models.NewMoney(10995, "USD"), Rating: models.Rating{Rate: 3.9, Count: 120}}
+	shirt := &models.Product{ID: 2, Title: "T-Shirt", Category: "clothing", Description: "Slim fit",
+		Price: models.NewMoney(2230, "USD"), Rating: models.Rating{Rate: 4.7, Count: 259}}
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(items, nil)
+	m.productSvc.On("GetProductByID", int32(2)).Return(shirt, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(backpack, nil)
+
+	comparison, err := service.CompareProducts(customerID.String(), customerID.String(), []int32{2, 1})
+
+	assert.NoError(t, err)
+	assert.False(t, comparison.Stale)
+	assert.Equal(t, int32(2), comparison.Products[0].ProductID)
+	assert.Equal(t, int32(1), comparison.Products[1].ProductID)
+
+	price := comparison.Attributes[0]
+	assert.Equal(t, models.ComparisonPrice, price.Name)
+	assert.Equal(t, []any{shirt.Price, backpack.Price}, price.Values)
+	assert.Equal(t, []int32{2}, price.Best)
+	assert.Equal(t, models.NewMoney(8765, "USD"), price.Difference)
+
+	rating := comparison.Attributes[1]
+	assert.Equal(t, []int32{2}, rating.Best)
+	assert.Equal(t, float32(0.8), rating.Difference)
+
+	category := comparison.Attributes[2]
+	assert.Equal(t, []any{"clothing", "bags"}, category.Values)
+	assert.False(t, category.AllEqual)
+	assert.Empty(t, category.Best)
+
+	description := comparison.Attributes[3]
+	assert.Equal(t, []int32{1}, description.Best)
+	assert.Equal(t, 12, description.Difference)
+	m.assertExpectations(t)
+}
+
+func TestCompareProducts_EqualValuesHaveNoBest(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	stored := &models.Product{ID: 2, Price: models.NewMoney(1000, "USD")}
+	items := []models.WishlistItem{{ProductID: 1}, {ProductID: 2, Product: stored}}
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(items, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(&models.Product{ID: 1, Price: models.NewMoney(1000, "USD")}, nil)
+	m.productSvc.On("GetProductByID", int32(2)).Return(nil, errors.New("connection refused"))
+
+	comparison, err := service.CompareProducts(customerID.String(), customerID.String(), []int32{1, 2})
+
+	assert.NoError(t, err)
+	assert.True(t, comparison.Stale)
+	assert.True(t, comparison.Attributes[0].AllEqual)
+	assert.Empty(t, comparison.Attributes[0].Best)
+}
+
+func TestCompareProducts_ProductNotInWishlist(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return([]models.WishlistItem{{ProductID: 1}}, nil)
+
+	comparison, err := service.CompareProducts(customerID.String(), customerID.String(), []int32{1, 9})
+
+	assert.Nil(t, comparison)
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	assert.Contains(t, err.Error(), "product 9")
+	m.productSvc.AssertNotCalled(t, "GetProductByID")
+}
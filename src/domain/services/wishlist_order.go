@@ -0,0 +1,142 @@
+package services
+
+import (
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+// MoveItem moves the item to the index in the wishlist order. Only the moved
+// item gets a new rank, between its new neighbours, unless the ranks ran out
+// of room and the whole list is spread evenly again.
+func (ws *WishlistService) MoveItem(customerID string, productID int32, position int, actorID string) (*models.Wishlist, error) {
+	customer, items, err := ws.orderedItems(customerID, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	index := -1
+	for i, item := range items {
+		if item.ProductID == productID {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "product not in wishlist",
+		}
+	}
+
+	moved := items[index]
+	items = append(items[:index], items[index+1:]...)
+	position = max(0, min(position, len(items)))
+	items = append(items[:position], append([]models.WishlistItem{moved}, items[position:]...)...)
+
+	before, after := "", ""
+	if position > 0 {
+		before = items[position-1].Position
+	}
+	if position < len(items)-1 {
+		after = items[position+1].Position
+	}
+	rank := models.RankBetween(before, after)
+
+	positions := map[int32]string{moved.ProductID: rank}
+	if !ranked(items, position) || len(rank) > models.MaxRankLength {
+		positions = evenPositions(items)
+	}
+	if err := ws.WishlistRepository.UpdatePositions(customerID, positions); err != nil {
+		return nil, err
+	}
+	return orderedWishlist(customer, items, positions), nil
+}
+
+// ReorderItems sets the whole wishlist order, productIDs must list every
+// item exactly once.
+func (ws *WishlistService) ReorderItems(customerID string, productIDs []int32, actorID string) (*models.Wishlist, error) {
+	customer, items, err := ws.orderedItems(customerID, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	byProduct := make(map[int32]models.WishlistItem, len(items))
+	for _, item := range items {
+		byProduct[item.ProductID] = item
+	}
+	if len(productIDs) != len(items) {
+		return nil, invalidOrderError()
+	}
+	ordered := make([]models.WishlistItem, 0, len(productIDs))
+	for _, productID := range productIDs {
+		item, ok := byProduct[productID]
+		if !ok {
+			return nil, invalidOrderError()
+		}
+		delete(byProduct, productID)
+		ordered = append(ordered, item)
+	}
+
+	positions := evenPositions(ordered)
+	if err := ws.WishlistRepository.UpdatePositions(customerID, positions); err != nil {
+		return nil, err
+	}
+	return orderedWishlist(customer, ordered, positions), nil
+}
+
+// orderedItems returns the wishlist items in their current order once the
+// actor is allowed to change them
+func (ws *WishlistService) orderedItems(customerID string, actorID string) (*models.Customer, []models.WishlistItem, error) {
+	customer, err := ws.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	if _, err := ws.authorize(customer, actorID, models.CollaboratorEditor); err != nil {
+		return nil, nil, err
+	}
+
+	items, err := ws.WishlistRepository.ListByCustomer(customerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return customer, items, nil
+}
+
+// ranked reports whether the neighbours of the index have ranks to place
+// an item between. Items from before ordering existed have none and items
+// appended at the same time can share a rank.
+func ranked(items []models.WishlistItem, index int) bool {
+	if index > 0 && items[index-1].Position == "" {
+		return false
+	}
+	if index < len(items)-1 && items[index+1].Position == "" {
+		return false
+	}
+	return index == 0 || index == len(items)-1 || items[index-1].Position < items[index+1].Position
+}
+
+func evenPositions(items []models.WishlistItem) map[int32]string {
+	ranks := models.EvenRanks(len(items))
+	positions := make(map[int32]string, len(items))
+	for i, item := range items {
+		positions[item.ProductID] = ranks[i]
+	}
+	return positions
+}
+
+func orderedWishlist(customer *models.Customer, items []models.WishlistItem, positions map[int32]string) *models.Wishlist {
+	for i := range items {
+		if position, ok := positions[items[i].ProductID]; ok {
+			items[i].Position = position
+		}
+	}
+	markAvailability(items)
+	return &models.Wishlist{CustomerID: customer.ID, Items: items}
+}
+
+func invalidOrderError() error {
+	return &exceptions.InvalidEntityError{
+		Reason: "the order must list every wishlist item exactly once",
+	}
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+func orderedItems(customerID uuid.UUID, positions ...string) []models.WishlistItem {
+	items := make([]models.WishlistItem, len(positions))
+	for i, position := range positions {
+		items[i] = models.WishlistItem{CustomerID: customerID, ProductID: int32(i + 1), Position: position}
+	}
+	return items
+}
+
+func productOrder(wishlist *models.Wishlist) []int32 {
+	order := make([]int32, len(wishlist.Items))
+	for i, item := range wishlist.Items {
+		order[i] = item.ProductID
+	}
+	return order
+}
+
+func TestMoveItem_UpdatesOnlyTheMovedItem(t *testing.T) {
+	service, m := newWishlistService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).
+		Return(orderedItems(customerID, "a", "i", "r"), nil)
+	m.wishlistRepo.On("UpdatePositions", customerID.String(), mock.MatchedBy(func(positions map[int32]string) bool {
+		rank, ok := positions[3]
+		return len(positions) == 1 && ok && rank > "a" && rank < "i"
+	})).Return(nil)
+
+	wishlist, err := service.MoveItem(customerID.String(), 3, 1, customerID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{1, 3, 2}, productOrder(wishlist))
+	m.assertExpectations(t)
+}
+
+func TestMoveItem_ToTheTop(t *testing.T) {
+	service, m := newWishlistService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).
+		Return(orderedItems(customerID, "a", "i", "r"), nil)
+	m.wishlistRepo.On("UpdatePositions", customerID.String(), mock.Anything).Return(nil)
+
+	wishlist, err := service.MoveItem(customerID.String(), 3, 0, customerID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{3, 1, 2}, productOrder(wishlist))
+	assert.Less(t, wishlist.Items[0].Position, "a")
+	m.assertExpectations(t)
+}
+
+func TestMoveItem_PastTheEnd(t *testing.T) {
+	service, m := newWishlistService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).
+		Return(orderedItems(customerID, "a", "i", "r"), nil)
+	m.wishlistRepo.On("UpdatePositions", customerID.String(), mock.Anything).Return(nil)
+
+	wishlist, err := service.MoveItem(customerID.String(), 1, 99, customerID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{2, 3, 1}, productOrder(wishlist))
+	assert.Greater(t, wishlist.Items[2].Position, "r")
+	m.assertExpectations(t)
+}
+
+func TestMoveItem_RebalancesUnrankedItems(t *testing.T) {
+	service, m := newWishlistService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).
+		Return(orderedItems(customerID, "i", "i", ""), nil)
+	m.wishlistRepo.On("UpdatePositions", customerID.String(), mock.MatchedBy(func(positions map[int32]string) bool {
+		return len(positions) == 3 && positions[1] < positions[3] && positions[3] < positions[2]
+	})).Return(nil)
+
+	wishlist, err := service.MoveItem(customerID.String(), 3, 1, customerID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{1, 3, 2}, productOrder(wishlist))
+	m.assertExpectations(t)
+}
+
+func TestMoveItem_NotInWishlist(t *testing.T) {
+	service, m := newWishlistService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(orderedItems(customerID, "a"), nil)
+
+	_, err := service.MoveItem(customerID.String(), 9, 0, customerID.String())
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	m.wishlistRepo.AssertNotCalled(t, "UpdatePositions", mock.Anything, mock.Anything)
+}
+
+func TestReorderItems_SetsEvenRanks(t *testing.T) {
+	service, m := newWishlistService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).
+		Return(orderedItems(customerID, "a", "b", "c"), nil)
+	m.wishlistRepo.On("UpdatePositions", customerID.String(), mock.MatchedBy(func(positions map[int32]string) bool {
+		return len(positions) == 3 && positions[3] < positions[1] && positions[1] < positions[2]
+	})).Return(nil)
+
+	wishlist, err := service.ReorderItems(customerID.String(), []int32{3, 1, 2}, customerID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{3, 1, 2}, productOrder(wishlist))
+	m.assertExpectations(t)
+}
+
+func TestReorderItems_MustListEveryItemOnce(t *testing.T) {
+	service, m := newWishlistService()
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).
+		Return(orderedItems(customerID, "a", "b", "c"), nil)
+
+	for _, order := range [][]int32{{1, 2}, {1, 2, 2}, {1, 2, 9}} {
+		_, err := service.ReorderItems(customerID.String(), order, customerID.String())
+		assert.IsType(t, &exceptions.InvalidEntityError{}, err, "%v", order)
+	}
+	m.wishlistRepo.AssertNotCalled(t, "UpdatePositions", mock.Anything, mock.Anything)
+}
+
+func TestReorderItems_ViewerForbidden(t *testing.T) {
+	service, m := newWishlistService()
+	customerID := uuid.New()
+	viewerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.collabRepo.On("Get", customerID.String(), viewerID.String()).Return(&models.WishlistCollaborator{
+		Role:   models.CollaboratorViewer,
+		Status: models.InvitationAccepted,
+	}, nil)
+
+	_, err := service.ReorderItems(customerID.String(), []int32{1}, viewerID.String())
+
+	assert.IsType(t, &exceptions.ForbiddenError{}, err)
+	m.assertExpectations(t)
+}
@@ -9,11 +9,50 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	servicers "produtos-favoritos/src/domain/interfaces/services"
 	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
 	"produtos-favoritos/src/internals/exceptions"
 	"produtos-favoritos/src/internals/mocks"
 )
 
+type wishlistMocks struct {
+	customerRepo *mocks.CustomerQuerier
+	wishlistRepo *mocks.WishlistQuerier
+	productRepo  *mocks.ProductQuerier
+	productSvc   *mocks.ProductServicer
+	collabRepo   *mocks.WishlistCollaboratorQuerier
+	tagRepo      *mocks.TagQuerier
+	trashRepo    *mocks.WishlistTrashQuerier
+	policySvc    *mocks.PolicyServicer
+}
+
+func newWishlistService() (servicers.WishlistServicer, *wishlistMocks) {
+	m := &wishlistMocks{
+		customerRepo: new(mocks.CustomerQuerier),
+		wishlistRepo: new(mocks.WishlistQuerier),
+		productRepo:  new(mocks.ProductQuerier),
+		productSvc:   new(mocks.ProductServicer),
+		collabRepo:   new(mocks.WishlistCollaboratorQuerier),
+		tagRepo:      new(mocks.TagQuerier),
+		trashRepo:    new(mocks.WishlistTrashQuerier),
+		policySvc:    new(mocks.PolicyServicer),
+	}
+	service := NewWishlistService(m.customerRepo, m.wishlistRepo, m.productRepo, m.productSvc, m.collabRepo, m.tagRepo, m.trashRepo, m.policySvc)
+	return service, m
+}
+
+func (m *wishlistMocks) assertExpectations(t *testing.T) {
+	m.customerRepo.AssertExpectations(t)
+	m.wishlistRepo.AssertExpectations(t)
+	m.productRepo.AssertExpectations(t)
+	m.productSvc.AssertExpectations(t)
+	m.collabRepo.AssertExpectations(t)
+	m.tagRepo.AssertExpectations(t)
+	m.trashRepo.AssertExpectations(t)
+	m.policySvc.AssertExpectations(t)
+}
+
 func createCustomer(id uuid.UUID, wishlist []*models.Product) *models.Customer {
 	return &models.Customer{
 		BaseModel: models.BaseModel{ID: id, CreatedAt: time.Now(), UpdatedAt: time.Now()},
@@ -31,8 +70,8 @@ func createProduct(id int32) *models.Product {
 }
 
 func TestWishlistProduct_Success(t *testing.T) {
-	customerRepo := new(mocks.CustomerQuerier)
-	productSvc := new(mocks.ProductServicer)
+	service, m := newWishlistService()
+	m.policySvc.On("Check", mock.Anything).Return(nil)
 
 	customerID := uuid.New()
 	productID := int32(1)
@@ -40,121 +79,553 @@ func TestWishlistProduct_Success(t *testing.T) {
 	customer := createCustomer(customerID, []*models.Product{})
 	product := createProduct(productID)
 
-	customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
-	productSvc.On("GetProductByID", productID).Return(product, nil)
-	customerRepo.On("Update", mock.Anything).Maybe().Return(customer, nil)
-
-	service := NewWishlistService(customerRepo, productSvc)
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.productSvc.On("GetProductByID", productID).Return(product, nil)
+	m.productRepo.On("Upsert", product).Return(nil)
+	m.wishlistRepo.On("Add", mock.MatchedBy(func(item *models.WishlistItem) bool {
+		return item.CustomerID == customerID && item.ProductID == productID &&
+			item.Status == models.WishlistItemConfirmed
+	})).Return(nil)
 
-	err := service.WishlistProduct(productID, customerID.String())
+	item, err := service.WishlistProduct(productID, customerID.String(), customerID.String(), "")
 
 	assert.NoError(t, err)
-	assert.Len(t, customer.Wishlist, 1)
-	assert.Equal(t, productID, customer.Wishlist[0].ID)
-	customerRepo.AssertExpectations(t)
-	productSvc.AssertExpectations(t)
+	assert.Equal(t, models.WishlistItemConfirmed, item.Status)
+	assert.Equal(t, product, item.Product)
+	m.assertExpectations(t)
 }
 
 func TestWishlistProduct_AlreadyWishlisted(t *testing.T) {
-	customerRepo := new(mocks.CustomerQuerier)
-	productSvc := new(mocks.ProductServicer)
+	service, m := newWishlistService()
 
 	customerID := uuid.New()
 	productID := int32(1)
 	product := createProduct(productID)
 	customer := createCustomer(customerID, []*models.Product{product})
 
-	customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
-	productSvc.On("GetProductByID", productID).Return(product, nil)
-
-	service := NewWishlistService(customerRepo, productSvc)
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
 
-	err := service.WishlistProduct(productID, customerID.String())
+	_, err := service.WishlistProduct(productID, customerID.String(), customerID.String(), "")
 
 	assert.Error(t, err)
 	assert.IsType(t, &exceptions.AlreadyWishlistedErr{}, err)
+	m.assertExpectations(t)
 }
 
 func TestWishlistProduct_CustomerNotFound(t *testing.T) {
-	customerRepo := new(mocks.CustomerQuerier)
-	productSvc := new(mocks.ProductServicer)
+	service, m := newWishlistService()
 
 	customerID := uuid.New()
-	customerRepo.On("GetByID", customerID.String()).Return(nil, errors.New("not found"))
+	m.customerRepo.On("GetByID", customerID.String()).Return(nil, errors.New("not found"))
 
-	service := NewWishlistService(customerRepo, productSvc)
-
-	err := service.WishlistProduct(1, customerID.String())
+	_, err := service.WishlistProduct(1, customerID.String(), customerID.String(), "")
 
 	assert.Error(t, err)
 	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
 }
 
 func TestWishlistProduct_ProductNotFound(t *testing.T) {
-	customerRepo := new(mocks.CustomerQuerier)
-	productSvc := new(mocks.ProductServicer)
+	service, m := newWishlistService()
 
 	customerID := uuid.New()
 	customer := createCustomer(customerID, []*models.Product{})
-	customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
-	productSvc.On("GetProductByID", int32(1)).Return(nil, errors.New("not found"))
-
-	service := NewWishlistService(customerRepo, productSvc)
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(nil, &exceptions.NotFoundEntityError{Reason: "product not found"})
 
-	err := service.WishlistProduct(1, customerID.String())
+	_, err := service.WishlistProduct(1, customerID.String(), customerID.String(), "")
 
 	assert.Error(t, err)
 	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	m.wishlistRepo.AssertNotCalled(t, "Add", mock.Anything)
+}
+
+func TestWishlistProduct_ApiDownQueuesPendingWithLastKnownData(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	productID := int32(1)
+	customer := createCustomer(customerID, []*models.Product{})
+	stored := createProduct(productID)
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.productSvc.On("GetProductByID", productID).Return(nil, errors.New("connection refused"))
+	m.productRepo.On("GetByID", productID).Return(stored, nil)
+	m.wishlistRepo.On("Add", mock.MatchedBy(func(item *models.WishlistItem) bool {
+		return item.Status == models.WishlistItemPending && item.PartnerKey == "acme"
+	})).Return(nil)
+
+	item, err := service.WishlistProduct(productID, customerID.String(), customerID.String(), "acme")
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.WishlistItemPending, item.Status)
+	assert.Equal(t, stored, item.Product)
+	// the rules are checked by the validation job once the product is known
+	m.policySvc.AssertNotCalled(t, "Check", mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestWishlistProduct_ApiDownWithoutLocalDataStoresPlaceholder(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	productID := int32(1)
+	customer := createCustomer(customerID, []*models.Product{})
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.productSvc.On("GetProductByID", productID).Return(nil, errors.New("connection refused"))
+	m.productRepo.On("GetByID", productID).Return(nil, nil)
+	m.productRepo.On("AddPlaceholder", productID).Return(nil)
+	m.wishlistRepo.On("Add", mock.Anything).Return(nil)
+
+	item, err := service.WishlistProduct(productID, customerID.String(), customerID.String(), "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.WishlistItemPending, item.Status)
+	assert.True(t, item.Product.Placeholder)
+	m.assertExpectations(t)
+}
+
+func TestWishlistProduct_EditorRecordsAddedBy(t *testing.T) {
+	service, m := newWishlistService()
+	m.policySvc.On("Check", mock.Anything).Return(nil)
+
+	customerID := uuid.New()
+	editorID := uuid.New()
+	product := createProduct(1)
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.collabRepo.On("Get", customerID.String(), editorID.String()).Return(&models.WishlistCollaborator{
+		OwnerID:        customerID,
+		CollaboratorID: editorID,
+		Role:           models.CollaboratorEditor,
+		Status:         models.InvitationAccepted,
+	}, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(product, nil)
+	m.productRepo.On("Upsert", product).Return(nil)
+	m.wishlistRepo.On("Add", mock.MatchedBy(func(item *models.WishlistItem) bool {
+		return item.CustomerID == customerID && item.AddedBy != nil && *item.AddedBy == editorID
+	})).Return(nil)
+
+	item, err := service.WishlistProduct(1, customerID.String(), editorID.String(), "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, editorID, *item.AddedBy)
+	m.assertExpectations(t)
+}
+
+func TestWishlistProduct_ViewerForbidden(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	viewerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.collabRepo.On("Get", customerID.String(), viewerID.String()).Return(&models.WishlistCollaborator{
+		Role:   models.CollaboratorViewer,
+		Status: models.InvitationAccepted,
+	}, nil)
+
+	_, err := service.WishlistProduct(1, customerID.String(), viewerID.String(), "")
+
+	assert.IsType(t, &exceptions.ForbiddenError{}, err)
+	m.wishlistRepo.AssertNotCalled(t, "Add", mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestRemoveProductFromWishlist_PendingInvitationForbidden(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	invitedID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.collabRepo.On("Get", customerID.String(), invitedID.String()).Return(&models.WishlistCollaborator{
+		Role:   models.CollaboratorEditor,
+		Status: models.InvitationPending,
+	}, nil)
+
+	err := service.RemoveProductFromWishlist(customerID.String(), 1, invitedID.String(), "")
+
+	assert.IsType(t, &exceptions.ForbiddenError{}, err)
+	m.trashRepo.AssertNotCalled(t, "Trash", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestGetWishlist_NotSharedForbidden(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	strangerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.collabRepo.On("Get", customerID.String(), strangerID.String()).Return(nil, nil)
+
+	_, err := service.GetWishlist(customerID.String(), strangerID.String(), models.WishlistFilter{})
+
+	assert.IsType(t, &exceptions.ForbiddenError{}, err)
+	m.assertExpectations(t)
+}
+
+func TestRemoveProductFromWishlist_MissingActorForbidden(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+
+	err := service.RemoveProductFromWishlist(customerID.String(), 1, "", "")
+
+	assert.IsType(t, &exceptions.ForbiddenError{}, err)
+	m.collabRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	m.trashRepo.AssertNotCalled(t, "Trash", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.assertExpectations(t)
 }
 
 func TestRemoveProductFromWishlist_Success(t *testing.T) {
-	customerRepo := new(mocks.CustomerQuerier)
-	productSvc := new(mocks.ProductServicer)
+	service, m := newWishlistService()
+	m.policySvc.On("Check", mock.Anything).Return(nil)
 
 	customerID := uuid.New()
 	productID := int32(1)
 	product := createProduct(productID)
 	customer := createCustomer(customerID, []*models.Product{product})
 
-	customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
-	productSvc.On("GetProductByID", productID).Return(product, nil)
-	customerRepo.On("RemoveProductFromWishlist", customerID.String(), productID).Return(nil)
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.trashRepo.On("Trash", customerID.String(), productID, &customerID, mock.MatchedBy(func(expiresAt time.Time) bool {
+		return expiresAt.After(time.Now().Add(config.WISHLIST_TRASH_RETENTION - time.Minute))
+	})).Return(nil)
+
+	err := service.RemoveProductFromWishlist(customerID.String(), productID, customerID.String(), "")
+
+	assert.NoError(t, err)
+	// Removing does not depend on the products api
+	m.productSvc.AssertNotCalled(t, "GetProductByID", mock.Anything)
+	m.assertExpectations(t)
+}
 
-	service := NewWishlistService(customerRepo, productSvc)
+func TestRemoveProductFromWishlist_NoRetentionDeletes(t *testing.T) {
+	service, m := newWishlistService()
+	m.policySvc.On("Check", mock.Anything).Return(nil)
+	retention := config.WISHLIST_TRASH_RETENTION
+	config.WISHLIST_TRASH_RETENTION = 0
+	defer func() { config.WISHLIST_TRASH_RETENTION = retention }()
 
-	err := service.RemoveProductFromWishlist(customerID.String(), productID)
+	customerID := uuid.New()
+	customer := createCustomer(customerID, []*models.Product{createProduct(1)})
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.customerRepo.On("RemoveProductFromWishlist", customerID.String(), int32(1)).Return(nil)
+
+	err := service.RemoveProductFromWishlist(customerID.String(), 1, customerID.String(), "")
 
 	assert.NoError(t, err)
+	m.trashRepo.AssertNotCalled(t, "Trash", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.assertExpectations(t)
 }
 
 func TestRemoveProductFromWishlist_CustomerNotFound(t *testing.T) {
-	customerRepo := new(mocks.CustomerQuerier)
-	productSvc := new(mocks.ProductServicer)
+	service, m := newWishlistService()
 
 	customerID := uuid.New()
-	customerRepo.On("GetByID", customerID.String()).Return(nil, errors.New("not found"))
-
-	service := NewWishlistService(customerRepo, productSvc)
+	m.customerRepo.On("GetByID", customerID.String()).Return(nil, errors.New("not found"))
 
-	err := service.RemoveProductFromWishlist(customerID.String(), 1)
+	err := service.RemoveProductFromWishlist(customerID.String(), 1, customerID.String(), "")
 
 	assert.Error(t, err)
 	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
 }
 
 func TestRemoveProductFromWishlist_ProductNotInWishlist(t *testing.T) {
-	customerRepo := new(mocks.CustomerQuerier)
-	productSvc := new(mocks.ProductServicer)
+	service, m := newWishlistService()
 
 	customerID := uuid.New()
 	customer := createCustomer(customerID, []*models.Product{})
 
-	customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
 
-	service := NewWishlistService(customerRepo, productSvc)
-
-	err := service.RemoveProductFromWishlist(customerID.String(), 1)
+	err := service.RemoveProductFromWishlist(customerID.String(), 1, customerID.String(), "")
 
 	assert.Error(t, err)
 	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
 }
+
+func TestGetWishlist_RefreshesProducts(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	customer := createCustomer(customerID, []*models.Product{createProduct(1)})
+	items := []models.WishlistItem{{CustomerID: customerID, ProductID: 1, Product: createProduct(1)}}
+	current := models.Product{ID: 1, Title: "Renamed Product", Price: models.NewMoney(1250, "USD")}
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(items, nil)
+	m.tagRepo.On("TagsByProduct", customerID.String()).Return(map[int32][]string{}, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(&current, nil)
+	m.productRepo.On("Upsert", &current).Return(nil)
+
+	wishlist, err := service.GetWishlist(customerID.String(), customerID.String(), models.WishlistFilter{})
+
+	assert.NoError(t, err)
+	assert.False(t, wishlist.Stale)
+	// only the wishlisted products are fetched, not the whole catalog
+	m.productSvc.AssertNotCalled(t, "GetProducts")
+	assert.Len(t, wishlist.Items, 1)
+	assert.Equal(t, "Renamed Product", wishlist.Items[0].Product.Title)
+	assert.True(t, wishlist.Items[0].Available)
+	m.assertExpectations(t)
+}
+
+func TestGetWishlist_ApiDownServesStaleData(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	customer := createCustomer(customerID, []*models.Product{createProduct(1)})
+	items := []models.WishlistItem{{CustomerID: customerID, ProductID: 1, Product: createProduct(1)}}
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(items, nil)
+	m.tagRepo.On("TagsByProduct", customerID.String()).Return(map[int32][]string{}, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(nil, errors.New("connection refused"))
+
+	wishlist, err := service.GetWishlist(customerID.String(), customerID.String(), models.WishlistFilter{})
+
+	assert.NoError(t, err)
+	assert.True(t, wishlist.Stale)
+	assert.Equal(t, "Test Product", wishlist.Items[0].Product.Title)
+	m.assertExpectations(t)
+}
+
+func TestGetWishlist_CustomerNotFound(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	m.customerRepo.On("GetByID", customerID.String()).Return(nil, nil)
+
+	wishlist, err := service.GetWishlist(customerID.String(), customerID.String(), models.WishlistFilter{})
+
+	assert.Nil(t, wishlist)
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+}
+
+func TestGetWishlistSummary_AverageRating(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	rated := createProduct(1)
+	rated.Rating = models.Rating{Rate: 3.9, Count: 120}
+	otherRated := createProduct(2)
+	otherRated.Rating = models.Rating{Rate: 4.6, Count: 400}
+	unrated := createProduct(3)
+	customer := createCustomer(customerID, []*models.Product{rated, otherRated, unrated})
+	items := []models.WishlistItem{{ProductID: 1}, {ProductID: 2}, {ProductID: 3}}
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(items, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(rated, nil)
+	m.productSvc.On("GetProductByID", int32(2)).Return(otherRated, nil)
+	m.productSvc.On("GetProductByID", int32(3)).Return(unrated, nil)
+
+	summary, err := service.GetWishlistSummary(customerID.String(), customerID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, customerID, summary.CustomerID)
+	assert.Equal(t, 3, summary.ItemCount)
+	assert.Equal(t, 2, summary.RatedCount)
+	assert.Equal(t, float32(4.25), summary.AverageRating)
+	m.assertExpectations(t)
+}
+
+func TestGetWishlistSummary_TotalsAndCategories(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	customer := createCustomer(customerID, nil)
+	items := []models.WishlistItem{
+		{ProductID: 1, AddedPrice: models.NewMoney(12000, "USD")},
+		{ProductID: 2, AddedPrice: models.NewMoney(2000, "USD")},
+		// added while the products api was down, without a known price
+		{ProductID: 3},
+	}
+	backpack := &models.Product{ID: 1, Title: "Backpack", Category: "bags", Price: models.NewMoney(10995, "USD")}
+	shirt := &models.Product{ID: 2, Title: "T-Shirt", Category: "clothing", Price: models.NewMoney(2230, "USD")}
+	jacket := &models.Product{ID: 3, Title: "Jacket", Category: "clothing", Price: models.NewMoney(5599, "USD")}
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(items, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(backpack, nil)
+	m.productSvc.On("GetProductByID", int32(2)).Return(shirt, nil)
+	m.productSvc.On("GetProductByID", int32(3)).Return(jacket, nil)
+
+	summary, err := service.GetWishlistSummary(customerID.String(), customerID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.NewMoney(18824, "USD"), summary.CurrentTotal)
+	assert.Equal(t, models.NewMoney(19599, "USD"), summary.AddedTotal)
+	assert.Equal(t, models.NewMoney(-775, "USD"), summary.Difference)
+	assert.Equal(t, []models.WishlistCategorySummary{
+		{Category: "bags", ItemCount: 1, CurrentTotal: models.NewMoney(10995, "USD"), AddedTotal: models.NewMoney(12000, "USD")},
+		{Category: "clothing", ItemCount: 2, CurrentTotal: models.NewMoney(7829, "USD"), AddedTotal: models.NewMoney(7599, "USD")},
+	}, summary.Categories)
+	assert.Equal(t, int32(2), summary.Cheapest.ProductID)
+	assert.Equal(t, int32(1), summary.MostExpensive.ProductID)
+	assert.False(t, summary.Stale)
+	m.assertExpectations(t)
+}
+
+func TestGetWishlistSummary_UsesStoredDataWhenUnavailable(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	customer := createCustomer(customerID, nil)
+	stored := &models.Product{ID: 1, Title: "Backpack", Category: "bags", Price: models.NewMoney(10995, "USD")}
+	items := []models.WishlistItem{{ProductID: 1, Product: stored, AddedPrice: models.NewMoney(10995, "USD")}}
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(items, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(nil, errors.New("connection refused"))
+
+	summary, err := service.GetWishlistSummary(customerID.String(), customerID.String())
+
+	assert.NoError(t, err)
+	assert.True(t, summary.Stale)
+	assert.Equal(t, models.NewMoney(10995, "USD"), summary.CurrentTotal)
+	assert.Equal(t, models.NewMoney(0, "USD"), summary.Difference)
+	m.assertExpectations(t)
+}
+
+func TestGetWishlistSummary_Empty(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return([]models.WishlistItem{}, nil)
+
+	summary, err := service.GetWishlistSummary(customerID.String(), customerID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, summary.ItemCount)
+	assert.Empty(t, summary.Categories)
+	assert.Nil(t, summary.Cheapest)
+	assert.True(t, summary.CurrentTotal.IsZero())
+}
+
+func TestGetWishlistSummary_CustomerNotFound(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	m.customerRepo.On("GetByID", customerID.String()).Return(nil, errors.New("not found"))
+
+	summary, err := service.GetWishlistSummary(customerID.String(), customerID.String())
+
+	assert.Nil(t, summary)
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+}
+
+func TestGetWishlistSummary_NotShared(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID, strangerID := uuid.New(), uuid.New()
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.collabRepo.On("Get", customerID.String(), strangerID.String()).Return(nil, nil)
+
+	summary, err := service.GetWishlistSummary(customerID.String(), strangerID.String())
+
+	assert.Nil(t, summary)
+	assert.IsType(t, &exceptions.ForbiddenError{}, err)
+	m.wishlistRepo.AssertNotCalled(t, "ListByCustomer", mock.Anything)
+}
+
+func TestGetWishlist_DiscontinuedProductIsUnavailable(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	customer := createCustomer(customerID, []*models.Product{createProduct(1)})
+	discontinued := createProduct(1)
+	discontinued.Discontinued = true
+	items := []models.WishlistItem{{CustomerID: customerID, ProductID: 1, Product: discontinued}}
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(items, nil)
+	m.tagRepo.On("TagsByProduct", customerID.String()).Return(map[int32][]string{}, nil)
+	// Deleted upstream, so it is not part of the catalog anymore
+	m.productSvc.On("GetProductByID", int32(1)).Return(nil, &exceptions.NotFoundEntityError{Reason: "product not found"})
+
+	wishlist, err := service.GetWishlist(customerID.String(), customerID.String(), models.WishlistFilter{})
+
+	assert.NoError(t, err)
+	assert.False(t, wishlist.Stale)
+	assert.False(t, wishlist.Items[0].Available)
+	m.assertExpectations(t)
+}
+
+func TestGetWishlist_FiltersByTags(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	customer := createCustomer(customerID, []*models.Product{createProduct(1), createProduct(2)})
+	items := []models.WishlistItem{
+		{CustomerID: customerID, ProductID: 1, Product: createProduct(1)},
+		{CustomerID: customerID, ProductID: 2, Product: createProduct(2)},
+	}
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(items, nil)
+	m.tagRepo.On("TagsByProduct", customerID.String()).
+		Return(map[int32][]string{1: {"gift", "kitchen"}, 2: {"gift"}}, nil)
+	m.tagRepo.On("ProductIDsByTags", customerID.String(), []string{"gift", "kitchen"}, true).Return([]int32{1}, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(createProduct(1), nil).Maybe()
+	m.productSvc.On("GetProductByID", int32(2)).Return(createProduct(2), nil).Maybe()
+	m.productRepo.On("Upsert", mock.Anything).Return(nil).Maybe()
+
+	filter := models.WishlistFilter{Tags: []string{"Gift", "kitchen"}, MatchAll: true}
+	wishlist, err := service.GetWishlist(customerID.String(), customerID.String(), filter)
+
+	assert.NoError(t, err)
+	assert.Len(t, wishlist.Items, 1)
+	assert.Equal(t, int32(1), wishlist.Items[0].ProductID)
+	assert.Equal(t, []string{"gift", "kitchen"}, wishlist.Items[0].Tags)
+	m.assertExpectations(t)
+}
+
+func TestWishlistProduct_RefusedByPolicy(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	product := createProduct(1)
+	product.Category = "electronics"
+	violation := &exceptions.PolicyViolationError{
+		Reason:     "wishlist rules violated: no-electronics",
+		Violations: []exceptions.PolicyViolation{{Rule: "no-electronics"}},
+	}
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(product, nil)
+	m.productRepo.On("Upsert", product).Return(nil)
+	m.policySvc.On("Check", mock.MatchedBy(func(input models.PolicyInput) bool {
+		return input.Action == models.RuleActionAdd && input.Product == product && input.PartnerKey == "acme"
+	})).Return(violation)
+
+	_, err := service.WishlistProduct(1, customerID.String(), customerID.String(), "acme")
+
+	assert.Equal(t, violation, err)
+	m.wishlistRepo.AssertNotCalled(t, "Add", mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestMarkWishlisted(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New().String()
+	inWishlist, notInWishlist := createProduct(1), createProduct(2)
+	m.wishlistRepo.On("ListMemberships", customerID, []int32{1, 2}).
+		Return(map[int32][]string{1: {"gift"}}, nil)
+
+	err := service.MarkWishlisted(customerID, inWishlist, notInWishlist)
+
+	assert.NoError(t, err)
+	assert.True(t, *inWishlist.Wishlisted)
+	assert.Equal(t, []string{"gift"}, inWishlist.Collections)
+	assert.False(t, *notInWishlist.Wishlisted)
+	assert.Nil(t, notInWishlist.Collections)
+	m.assertExpectations(t)
+}
@@ -0,0 +1,282 @@
+package services
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+// wishlistImportEntry is a line of an import file before it is validated
+type wishlistImportEntry struct {
+	line      int
+	productID string
+	tags      []string
+}
+
+// ExportWishlist returns the wishlist items with the current catalog data,
+// in the wishlist order
+func (ws *WishlistService) ExportWishlist(customerID string, actorID string) ([]models.WishlistExportItem, error) {
+	wishlist, err := ws.GetWishlist(customerID, actorID, models.WishlistFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]models.WishlistExportItem, 0, len(wishlist.Items))
+	for _, item := range wishlist.Items {
+		exported := models.WishlistExportItem{
+			ProductID:  item.ProductID,
+			AddedPrice: item.AddedPrice,
+			AddedAt:    item.CreatedAt,
+			Tags:       item.Tags,
+		}
+		if item.Product != nil {
+			exported.Title = item.Product.Title
+			exported.Category = item.Product.Category
+			exported.Price = item.Product.Price
+		}
+		if exported.Tags == nil {
+			exported.Tags = []string{}
+		}
+		items = append(items, exported)
+	}
+	return items, nil
+}
+
+// ImportWishlist adds the products of a csv or json file, a csv needs a
+// product_id column and the other columns of the export are optional. Every
+// product is checked against the catalog and the wishlist rules, products
+// already in the wishlist or repeated in the file are skipped, and the
+// report tells the outcome of each line. A dry run only validates.
+func (ws *WishlistService) ImportWishlist(customerID string, actorID string, format string, file io.Reader,
+	dryRun bool, partnerKey string) (*models.WishlistImportReport, error) {
+	customer, err := ws.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	actor, err := ws.authorize(customer, actorID, models.CollaboratorEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []wishlistImportEntry
+	switch format {
+	case models.WishlistFormatJSON:
+		entries, err = readJSONImport(file)
+	default:
+		entries, err = readCSVImport(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, &exceptions.InvalidEntityError{Reason: "the file has no products"}
+	}
+	if len(entries) > models.MaxWishlistImportLines {
+		return nil, &exceptions.InvalidEntityError{
+			Reason: fmt.Sprintf("the file has more than %d products", models.MaxWishlistImportLines),
+		}
+	}
+
+	report := &models.WishlistImportReport{DryRun: dryRun, Lines: make([]models.WishlistImportLine, len(entries))}
+	wishlisted := make(map[int32]bool, len(customer.Wishlist))
+	for _, p := range customer.Wishlist {
+		wishlisted[p.ID] = true
+	}
+
+	// The products are fetched together, the lines are then applied in order
+	productIDs := make([]int32, len(entries))
+	var toFetch []int32
+	seen := map[int32]bool{}
+	for i, entry := range entries {
+		report.Lines[i] = models.WishlistImportLine{Line: entry.line}
+		id, err := strconv.ParseInt(strings.TrimSpace(entry.productID), 10, 32)
+		if err != nil || id < 1 {
+			report.Lines[i].Status = models.ImportLineInvalid
+			report.Lines[i].Message = "invalid product_id"
+			continue
+		}
+		productIDs[i] = int32(id)
+		report.Lines[i].ProductID = int32(id)
+		if !wishlisted[int32(id)] && !seen[int32(id)] {
+			seen[int32(id)] = true
+			toFetch = append(toFetch, int32(id))
+		}
+	}
+	products, fetchErrs := fetchProducts(ws.ProductService, toFetch)
+
+	for i, entry := range entries {
+		line := &report.Lines[i]
+		if line.Status == "" {
+			ws.importLine(line, customer, actor, products, fetchErrs, entry, wishlisted, dryRun, partnerKey)
+		}
+		switch line.Status {
+		case models.ImportLineAdded:
+			report.Added++
+		case models.ImportLineDuplicate:
+			report.Skipped++
+		default:
+			report.Failed++
+		}
+	}
+	return report, nil
+}
+
+// importLine validates and, unless it's a dry run, adds a single product.
+// Added products join the wishlist so later lines see them as duplicates and
+// the rules see the wishlist growing.
+func (ws *WishlistService) importLine(line *models.WishlistImportLine, customer *models.Customer, actor uuid.UUID,
+	products map[int32]*models.Product, fetchErrs map[int32]error, entry wishlistImportEntry,
+	wishlisted map[int32]bool, dryRun bool, partnerKey string) {
+	productID := line.ProductID
+	if wishlisted[productID] {
+		line.Status = models.ImportLineDuplicate
+		line.Message = "product already in wishlist"
+		return
+	}
+
+	var notFoundErr *exceptions.NotFoundEntityError
+	if err := fetchErrs[productID]; err != nil {
+		if errors.As(err, &notFoundErr) {
+			line.Status = models.ImportLineNotFound
+			line.Message = "product not found"
+		} else {
+			line.Status = models.ImportLineFailed
+			line.Message = "the catalog is unavailable, try again later"
+		}
+		return
+	}
+	product := products[productID]
+
+	tags, err := normalizeTags(entry.tags)
+	if err != nil {
+		line.Status = models.ImportLineInvalid
+		line.Message = err.Error()
+		return
+	}
+
+	err = ws.PolicyService.Check(models.PolicyInput{
+		Action:     models.RuleActionAdd,
+		Customer:   customer,
+		Product:    product,
+		ActorID:    actor.String(),
+		PartnerKey: partnerKey,
+	})
+	var violationErr *exceptions.PolicyViolationError
+	if errors.As(err, &violationErr) {
+		line.Status = models.ImportLineRefused
+		line.Message = violationErr.Error()
+		return
+	}
+	if err == nil && !dryRun {
+		err = ws.addImportedItem(customer, actor, product, tags)
+	}
+	if err != nil {
+		line.Status = models.ImportLineFailed
+		line.Message = err.Error()
+		return
+	}
+
+	line.Status = models.ImportLineAdded
+	wishlisted[productID] = true
+	customer.Wishlist = append(customer.Wishlist, product)
+}
+
+func (ws *WishlistService) addImportedItem(customer *models.Customer, actor uuid.UUID, product *models.Product, tags []string) error {
+	if err := ws.ProductRepository.Upsert(product); err != nil {
+		return err
+	}
+	err := ws.WishlistRepository.Add(&models.WishlistItem{
+		CustomerID: customer.ID,
+		ProductID:  product.ID,
+		Status:     models.WishlistItemConfirmed,
+		AddedBy:    &actor,
+		AddedPrice: product.Price,
+	})
+	if err != nil {
+		return err
+	}
+	if len(tags) > 0 {
+		return ws.TagRepository.Attach(customer.ID.String(), product.ID, tags)
+	}
+	return nil
+}
+
+func readCSVImport(file io.Reader) ([]wishlistImportEntry, error) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, &exceptions.InvalidEntityError{Reason: err.Error()}
+	}
+	productColumn, tagsColumn := -1, -1
+	for i, column := range header {
+		switch strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))) {
+		case "product_id":
+			productColumn = i
+		case "tags":
+			tagsColumn = i
+		}
+	}
+	if productColumn < 0 {
+		return nil, &exceptions.InvalidEntityError{Reason: "the csv header has no product_id column"}
+	}
+
+	var entries []wishlistImportEntry
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, &exceptions.InvalidEntityError{Reason: err.Error()}
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		// the reader skips blank lines and quoted fields can span lines, the
+		// line numbers come from the reader
+		line, _ := reader.FieldPos(0)
+		entry := wishlistImportEntry{line: line}
+		if productColumn < len(record) {
+			entry.productID = record[productColumn]
+		}
+		if tagsColumn >= 0 && tagsColumn < len(record) && strings.TrimSpace(record[tagsColumn]) != "" {
+			entry.tags = strings.Split(record[tagsColumn], "|")
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func readJSONImport(file io.Reader) ([]wishlistImportEntry, error) {
+	var items []struct {
+		ProductID json.Number `json:"product_id"`
+		Tags      []string    `json:"tags"`
+	}
+	if err := json.NewDecoder(file).Decode(&items); err != nil {
+		return nil, &exceptions.InvalidEntityError{Reason: "the file is not a json array of wishlist items"}
+	}
+
+	entries := make([]wishlistImportEntry, len(items))
+	for i, item := range items {
+		entries[i] = wishlistImportEntry{line: i + 1, productID: item.ProductID.String(), tags: item.Tags}
+	}
+	return entries, nil
+}
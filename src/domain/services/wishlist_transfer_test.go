@@ -0,0 +1,140 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+func TestExportWishlist(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	customer := createCustomer(customerID, []*models.Product{createProduct(1)})
+	current := models.Product{ID: 1, Title: "Backpack", Category: "bags", Price: models.NewMoney(10995, "USD")}
+	items := []models.WishlistItem{{CustomerID: customerID, ProductID: 1, AddedPrice: models.NewMoney(9995, "USD")}}
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.wishlistRepo.On("ListByCustomer", customerID.String()).Return(items, nil)
+	m.tagRepo.On("TagsByProduct", customerID.String()).Return(map[int32][]string{1: {"gift"}}, nil)
+	m.productSvc.On("GetProductByID", int32(1)).Return(&current, nil)
+	m.productRepo.On("Upsert", &current).Return(nil)
+
+	exported, err := service.ExportWishlist(customerID.String(), customerID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.WishlistExportItem{{
+		ProductID:  1,
+		Title:      "Backpack",
+		Category:   "bags",
+		Price:      models.NewMoney(10995, "USD"),
+		AddedPrice: models.NewMoney(9995, "USD"),
+		Tags:       []string{"gift"},
+	}}, exported)
+}
+
+func TestImportWishlist_ReportsEveryLine(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	customer := createCustomer(customerID, []*models.Product{createProduct(1)})
+	refused := createProduct(4)
+	refused.Category = "electronics"
+
+	m.customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+	m.productSvc.On("GetProductByID", int32(2)).Return(createProduct(2), nil)
+	m.productSvc.On("GetProductByID", int32(3)).Return(nil, &exceptions.NotFoundEntityError{Reason: "product not found"})
+	m.productSvc.On("GetProductByID", int32(4)).Return(refused, nil)
+	m.productSvc.On("GetProductByID", int32(5)).Return(nil, errors.New("connection refused"))
+	m.policySvc.On("Check", mock.MatchedBy(func(input models.PolicyInput) bool { return input.Product.ID == 4 })).
+		Return(&exceptions.PolicyViolationError{Reason: "wishlist rules violated: no-electronics"})
+	m.policySvc.On("Check", mock.Anything).Return(nil)
+	m.productRepo.On("Upsert", createProduct(2)).Return(nil)
+	m.wishlistRepo.On("Add", mock.MatchedBy(func(item *models.WishlistItem) bool {
+		return item.ProductID == 2 && *item.AddedBy == customerID
+	})).Return(nil).Once()
+	m.tagRepo.On("Attach", customerID.String(), int32(2), []string{"gift", "kitchen"}).Return(nil)
+
+	file := strings.NewReader("product_id,title,tags\n" +
+		"1,Already there,\n" +
+		"2,New,Gift|kitchen\n" +
+		"2,Repeated,\n" +
+		"abc,Invalid,\n" +
+		"3,Missing,\n" +
+		"4,Refused,\n" +
+		"5,Unavailable,\n")
+	report, err := service.ImportWishlist(customerID.String(), customerID.String(), models.WishlistFormatCSV, file, false, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.WishlistImportLine{
+		{Line: 2, ProductID: 1, Status: models.ImportLineDuplicate, Message: "product already in wishlist"},
+		{Line: 3, ProductID: 2, Status: models.ImportLineAdded},
+		{Line: 4, ProductID: 2, Status: models.ImportLineDuplicate, Message: "product already in wishlist"},
+		{Line: 5, Status: models.ImportLineInvalid, Message: "invalid product_id"},
+		{Line: 6, ProductID: 3, Status: models.ImportLineNotFound, Message: "product not found"},
+		{Line: 7, ProductID: 4, Status: models.ImportLineRefused, Message: "wishlist rules violated: no-electronics"},
+		{Line: 8, ProductID: 5, Status: models.ImportLineFailed, Message: "the catalog is unavailable, try again later"},
+	}, report.Lines)
+	assert.Equal(t, 1, report.Added)
+	assert.Equal(t, 2, report.Skipped)
+	assert.Equal(t, 4, report.Failed)
+	m.assertExpectations(t)
+}
+
+func TestImportWishlist_LineNumbersSkipBlankAndMultilineRows(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+
+	file := strings.NewReader("product_id,title\n" +
+		"\n" +
+		"abc,\"Two\nlines\"\n" +
+		"xyz,Invalid\n")
+	report, err := service.ImportWishlist(customerID.String(), customerID.String(), models.WishlistFormatCSV, file, true, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.WishlistImportLine{
+		{Line: 3, Status: models.ImportLineInvalid, Message: "invalid product_id"},
+		{Line: 5, Status: models.ImportLineInvalid, Message: "invalid product_id"},
+	}, report.Lines)
+}
+
+func TestImportWishlist_DryRunSavesNothing(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	m.productSvc.On("GetProductByID", int32(2)).Return(createProduct(2), nil)
+	m.policySvc.On("Check", mock.Anything).Return(nil)
+
+	file := strings.NewReader(`[{"product_id": 2, "tags": ["gift"]}]`)
+	report, err := service.ImportWishlist(customerID.String(), customerID.String(), models.WishlistFormatJSON, file, true, "")
+
+	assert.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 1, report.Added)
+	m.wishlistRepo.AssertNotCalled(t, "Add", mock.Anything)
+	m.productRepo.AssertNotCalled(t, "Upsert", mock.Anything)
+	m.tagRepo.AssertNotCalled(t, "Attach", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImportWishlist_MissingProductColumn(t *testing.T) {
+	service, m := newWishlistService()
+
+	customerID := uuid.New()
+	m.customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+
+	_, err := service.ImportWishlist(customerID.String(), customerID.String(), models.WishlistFormatCSV,
+		strings.NewReader("id,title\n1,Backpack\n"), false, "")
+
+	assert.IsType(t, &exceptions.InvalidEntityError{}, err)
+	m.productSvc.AssertNotCalled(t, "GetProductByID", mock.Anything)
+}
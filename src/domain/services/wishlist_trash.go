@@ -0,0 +1,112 @@
+package services
+
+import (
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+// WishlistTrashService lets the customer see and restore the items removed
+// from the wishlist while they are in the trash
+type WishlistTrashService struct {
+	CustomerRepository     querier.CustomerQuerier
+	TrashRepository        querier.WishlistTrashQuerier
+	CollaboratorRepository querier.WishlistCollaboratorQuerier
+	PolicyService          servicers.PolicyServicer
+}
+
+func NewWishlistTrashService(customerRepository querier.CustomerQuerier,
+	trashRepository querier.WishlistTrashQuerier,
+	collaboratorRepository querier.WishlistCollaboratorQuerier,
+	policyService servicers.PolicyServicer) servicers.WishlistTrashServicer {
+	return &WishlistTrashService{
+		CustomerRepository:     customerRepository,
+		TrashRepository:        trashRepository,
+		CollaboratorRepository: collaboratorRepository,
+		PolicyService:          policyService,
+	}
+}
+
+// ListTrash returns the removed items to the owner and the collaborators
+func (ts *WishlistTrashService) ListTrash(customerID string, actorID string) ([]models.TrashedWishlistItem, error) {
+	customer, err := ts.getCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := authorizeWishlistActor(ts.CollaboratorRepository, customer, actorID, models.CollaboratorViewer); err != nil {
+		return nil, err
+	}
+	return ts.TrashRepository.ListByCustomer(customerID, time.Now())
+}
+
+// RestoreItem puts the item back in the wishlist as it was when removed, the
+// actor has to be the owner or an editor. Restoring adds the item again, so
+// the rules for adding apply.
+func (ts *WishlistTrashService) RestoreItem(customerID string, productID int32, actorID string, partnerKey string) (*models.WishlistItem, error) {
+	customer, err := ts.getCustomer(customerID)
+	if err != nil {
+		return nil, err
+	}
+	actor, err := authorizeWishlistActor(ts.CollaboratorRepository, customer, actorID, models.CollaboratorEditor)
+	if err != nil {
+		return nil, err
+	}
+	if inWishlist(customer, productID) {
+		return nil, &exceptions.AlreadyWishlistedErr{
+			Reason: "product already in wishlist",
+		}
+	}
+
+	entry, err := ts.TrashRepository.Get(customerID, productID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "product not in trash",
+		}
+	}
+
+	err = ts.PolicyService.Check(models.PolicyInput{
+		Action:     models.RuleActionAdd,
+		Customer:   customer,
+		Product:    entry.Product,
+		ActorID:    actor.String(),
+		PartnerKey: partnerKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ts.TrashRepository.Restore(entry); err != nil {
+		return nil, err
+	}
+	return entry.ToWishlistItem(), nil
+}
+
+// EmptyTrash deletes the removed items for good, the actor has to be the
+// owner or an editor
+func (ts *WishlistTrashService) EmptyTrash(customerID string, actorID string) error {
+	customer, err := ts.getCustomer(customerID)
+	if err != nil {
+		return err
+	}
+	if _, err := authorizeWishlistActor(ts.CollaboratorRepository, customer, actorID, models.CollaboratorEditor); err != nil {
+		return err
+	}
+	_, err = ts.TrashRepository.Empty(customerID)
+	return err
+}
+
+func (ts *WishlistTrashService) getCustomer(customerID string) (*models.Customer, error) {
+	customer, err := ts.CustomerRepository.GetByID(customerID)
+	if err != nil || customer == nil {
+		return nil, &exceptions.NotFoundEntityError{
+			Reason: "customer not found",
+		}
+	}
+	return customer, nil
+}
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"log"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/infrastructure/config"
+)
+
+// WishlistTrashCleanupJob purges the trashed wishlist items past their
+// retention. Expired entries are already hidden, the job frees the rows.
+type WishlistTrashCleanupJob struct {
+	TrashRepository querier.WishlistTrashQuerier
+}
+
+func NewWishlistTrashCleanupJob(trashRepository querier.WishlistTrashQuerier) servicers.Job {
+	return &WishlistTrashCleanupJob{TrashRepository: trashRepository}
+}
+
+func (j *WishlistTrashCleanupJob) Name() string {
+	return "wishlist-trash-cleanup"
+}
+
+func (j *WishlistTrashCleanupJob) Interval() time.Duration {
+	return config.WISHLIST_TRASH_CLEANUP_INTERVAL
+}
+
+func (j *WishlistTrashCleanupJob) Run() error {
+	deleted, err := j.TrashRepository.DeleteExpired(time.Now())
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		log.Printf("purged %d expired wishlist trash items", deleted)
+	}
+	return nil
+}
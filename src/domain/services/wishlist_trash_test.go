@@ -0,0 +1,130 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func TestRestoreItem_KeepsOriginalData(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	trashRepo := new(mocks.WishlistTrashQuerier)
+	policySvc := new(mocks.PolicyServicer)
+	service := NewWishlistTrashService(customerRepo, trashRepo, new(mocks.WishlistCollaboratorQuerier), policySvc)
+	customerID := uuid.New()
+	policySvc.On("Check", mock.MatchedBy(func(input models.PolicyInput) bool {
+		return input.ActorID == customerID.String()
+	})).Return(nil)
+	addedAt := time.Now().Add(-90 * 24 * time.Hour)
+	entry := &models.TrashedWishlistItem{
+		CustomerID: customerID,
+		ProductID:  1,
+		Status:     models.WishlistItemConfirmed,
+		AddedAt:    addedAt,
+		AddedPrice: models.NewMoney(1999, "USD"),
+		Quantity:   2,
+		Position:   "i",
+		Tags:       []string{"gift"},
+	}
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	trashRepo.On("Get", customerID.String(), int32(1), mock.Anything).Return(entry, nil)
+	trashRepo.On("Restore", entry).Return(nil)
+
+	item, err := service.RestoreItem(customerID.String(), 1, customerID.String(), "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, addedAt, item.CreatedAt)
+	assert.Equal(t, int64(1999), item.AddedPrice.Amount)
+	assert.Equal(t, 2, item.Quantity)
+	assert.Equal(t, []string{"gift"}, item.Tags)
+	trashRepo.AssertExpectations(t)
+}
+
+func TestRestoreItem_NotInTrash(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	trashRepo := new(mocks.WishlistTrashQuerier)
+	policySvc := new(mocks.PolicyServicer)
+	service := NewWishlistTrashService(customerRepo, trashRepo, new(mocks.WishlistCollaboratorQuerier), policySvc)
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	trashRepo.On("Get", customerID.String(), int32(1), mock.Anything).Return(nil, nil)
+
+	_, err := service.RestoreItem(customerID.String(), 1, customerID.String(), "")
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	trashRepo.AssertNotCalled(t, "Restore", mock.Anything)
+}
+
+func TestRestoreItem_AlreadyWishlistedAgain(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	trashRepo := new(mocks.WishlistTrashQuerier)
+	policySvc := new(mocks.PolicyServicer)
+	service := NewWishlistTrashService(customerRepo, trashRepo, new(mocks.WishlistCollaboratorQuerier), policySvc)
+
+	customerID := uuid.New()
+	customer := createCustomer(customerID, []*models.Product{createProduct(1)})
+	customerRepo.On("GetByID", customerID.String()).Return(customer, nil)
+
+	_, err := service.RestoreItem(customerID.String(), 1, customerID.String(), "")
+
+	assert.IsType(t, &exceptions.AlreadyWishlistedErr{}, err)
+	trashRepo.AssertNotCalled(t, "Restore", mock.Anything)
+}
+
+func TestRestoreItem_ViewerCantRestore(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	trashRepo := new(mocks.WishlistTrashQuerier)
+	collabRepo := new(mocks.WishlistCollaboratorQuerier)
+	policySvc := new(mocks.PolicyServicer)
+	service := NewWishlistTrashService(customerRepo, trashRepo, collabRepo, policySvc)
+
+	customerID, viewerID := uuid.New(), uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	collabRepo.On("Get", customerID.String(), viewerID.String()).Return(&models.WishlistCollaborator{
+		Role:   models.CollaboratorViewer,
+		Status: models.InvitationAccepted,
+	}, nil)
+
+	_, err := service.RestoreItem(customerID.String(), 1, viewerID.String(), "")
+	assert.IsType(t, &exceptions.ForbiddenError{}, err)
+	err = service.EmptyTrash(customerID.String(), viewerID.String())
+	assert.IsType(t, &exceptions.ForbiddenError{}, err)
+
+	trashRepo.AssertNotCalled(t, "Restore", mock.Anything)
+	trashRepo.AssertNotCalled(t, "Empty", mock.Anything)
+	policySvc.AssertNotCalled(t, "Check", mock.Anything)
+}
+
+func TestEmptyTrash_CustomerNotFound(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	trashRepo := new(mocks.WishlistTrashQuerier)
+	policySvc := new(mocks.PolicyServicer)
+	service := NewWishlistTrashService(customerRepo, trashRepo, new(mocks.WishlistCollaboratorQuerier), policySvc)
+
+	customerID := uuid.New()
+	customerRepo.On("GetByID", customerID.String()).Return(nil, nil)
+
+	err := service.EmptyTrash(customerID.String(), customerID.String())
+
+	assert.IsType(t, &exceptions.NotFoundEntityError{}, err)
+	trashRepo.AssertNotCalled(t, "Empty", mock.Anything)
+}
+
+func TestWishlistTrashCleanupJob_Run(t *testing.T) {
+	trashRepo := new(mocks.WishlistTrashQuerier)
+	trashRepo.On("DeleteExpired", mock.AnythingOfType("time.Time")).Return(int64(3), nil)
+
+	job := NewWishlistTrashCleanupJob(trashRepo)
+
+	assert.NoError(t, job.Run())
+	assert.Equal(t, "wishlist-trash-cleanup", job.Name())
+	trashRepo.AssertExpectations(t)
+}
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	querier "produtos-favoritos/src/domain/interfaces/repositories"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/infrastructure/config"
+	"produtos-favoritos/src/internals/exceptions"
+)
+
+const wishlistValidationBatchSize = 100
+
+// WishlistValidationJob confirms or drops the wishlist items that were added
+// while the products api was unavailable. The wishlist rules are checked
+// here, once the product is known.
+type WishlistValidationJob struct {
+	CustomerRepository querier.CustomerQuerier
+	WishlistRepository querier.WishlistQuerier
+	ProductRepository  querier.ProductQuerier
+	ProductService     servicers.ProductServicer
+	PolicyService      servicers.PolicyServicer
+}
+
+func NewWishlistValidationJob(customerRepository querier.CustomerQuerier,
+	wishlistRepository querier.WishlistQuerier,
+	productRepository querier.ProductQuerier,
+	productService servicers.ProductServicer,
+	policyService servicers.PolicyServicer) servicers.Job {
+	return &WishlistValidationJob{
+		CustomerRepository: customerRepository,
+		WishlistRepository: wishlistRepository,
+		ProductRepository:  productRepository,
+		ProductService:     productService,
+		PolicyService:      policyService,
+	}
+}
+
+func (j *WishlistValidationJob) Name() string {
+	return "wishlist-validation"
+}
+
+func (j *WishlistValidationJob) Interval() time.Duration {
+	return config.WISHLIST_VALIDATION_INTERVAL
+}
+
+func (j *WishlistValidationJob) Run() error {
+	items, err := j.WishlistRepository.ListByStatus(models.WishlistItemPending, wishlistValidationBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		customerID := item.CustomerID.String()
+
+		product, err := j.ProductService.GetProductByID(item.ProductID)
+		var notFoundErr *exceptions.NotFoundEntityError
+		if errors.As(err, &notFoundErr) {
+			log.Printf("rejecting pending product %d of customer %s: product not found", item.ProductID, customerID)
+			if err := j.WishlistRepository.Remove(customerID, item.ProductID); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			// Still unavailable, the item is retried on the next run and
+			// the rest of the batch goes on
+			log.Printf("skipping pending product %d of customer %s: %v", item.ProductID, customerID, err)
+			continue
+		}
+
+		if err := j.ProductRepository.Upsert(product); err != nil {
+			return err
+		}
+		allowed, err := j.checkRules(item, product)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			if err := j.WishlistRepository.Remove(customerID, item.ProductID); err != nil {
+				return err
+			}
+			continue
+		}
+		// items queued without any stored product data get their price now
+		if item.AddedPrice.IsZero() {
+			if err := j.WishlistRepository.UpdateAddedPrice(customerID, item.ProductID, product.Price); err != nil {
+				return err
+			}
+		}
+		if err := j.WishlistRepository.UpdateStatus(customerID, item.ProductID, models.WishlistItemConfirmed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRules runs the add rules the item skipped when it was queued, against
+// the wishlist without the item itself
+func (j *WishlistValidationJob) checkRules(item models.WishlistItem, product *models.Product) (bool, error) {
+	customer, err := j.CustomerRepository.GetByID(item.CustomerID.String())
+	if err != nil {
+		return false, err
+	}
+	if customer == nil {
+		return false, nil
+	}
+	wishlist := make([]*models.Product, 0, len(customer.Wishlist))
+	for _, wished := range customer.Wishlist {
+		if wished.ID != item.ProductID {
+			wishlist = append(wishlist, wished)
+		}
+	}
+	customer.Wishlist = wishlist
+
+	actorID := customer.ID.String()
+	if item.AddedBy != nil {
+		actorID = item.AddedBy.String()
+	}
+	err = j.PolicyService.Check(models.PolicyInput{
+		Action:     models.RuleActionAdd,
+		Customer:   customer,
+		Product:    product,
+		ActorID:    actorID,
+		PartnerKey: item.PartnerKey,
+	})
+	var violationErr *exceptions.PolicyViolationError
+	if errors.As(err, &violationErr) {
+		log.Printf("rejecting pending product %d of customer %s: %s", item.ProductID, customer.ID, violationErr.Reason)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
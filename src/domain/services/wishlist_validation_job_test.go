@@ -0,0 +1,108 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"produtos-favoritos/src/domain/models"
+	"produtos-favoritos/src/internals/exceptions"
+	"produtos-favoritos/src/internals/mocks"
+)
+
+func TestWishlistValidationJob_ConfirmsAndRejects(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	wishlistRepo := new(mocks.WishlistQuerier)
+	productRepo := new(mocks.ProductQuerier)
+	productSvc := new(mocks.ProductServicer)
+	policySvc := new(mocks.PolicyServicer)
+
+	customerID := uuid.New()
+	pending := []models.WishlistItem{
+		{CustomerID: customerID, ProductID: 1, Status: models.WishlistItemPending},
+		{CustomerID: customerID, ProductID: 404, Status: models.WishlistItemPending},
+	}
+	product := createProduct(1)
+	product.Price = models.NewMoney(1099, "USD")
+
+	wishlistRepo.On("ListByStatus", models.WishlistItemPending, wishlistValidationBatchSize).Return(pending, nil)
+	productSvc.On("GetProductByID", int32(1)).Return(product, nil)
+	productRepo.On("Upsert", product).Return(nil)
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, []*models.Product{product}), nil)
+	policySvc.On("Check", mock.MatchedBy(func(input models.PolicyInput) bool {
+		return input.Action == models.RuleActionAdd && input.Product == product && len(input.Customer.Wishlist) == 0
+	})).Return(nil)
+	wishlistRepo.On("UpdateAddedPrice", customerID.String(), int32(1), product.Price).Return(nil)
+	wishlistRepo.On("UpdateStatus", customerID.String(), int32(1), models.WishlistItemConfirmed).Return(nil)
+	productSvc.On("GetProductByID", int32(404)).Return(nil, &exceptions.NotFoundEntityError{Reason: "product not found"})
+	wishlistRepo.On("Remove", customerID.String(), int32(404)).Return(nil)
+
+	job := NewWishlistValidationJob(customerRepo, wishlistRepo, productRepo, productSvc, policySvc)
+	err := job.Run()
+
+	assert.NoError(t, err)
+	wishlistRepo.AssertExpectations(t)
+	productRepo.AssertExpectations(t)
+	productSvc.AssertExpectations(t)
+	policySvc.AssertExpectations(t)
+}
+
+func TestWishlistValidationJob_RejectsItemsBreakingTheRules(t *testing.T) {
+	customerRepo := new(mocks.CustomerQuerier)
+	wishlistRepo := new(mocks.WishlistQuerier)
+	productRepo := new(mocks.ProductQuerier)
+	productSvc := new(mocks.ProductServicer)
+	policySvc := new(mocks.PolicyServicer)
+
+	customerID := uuid.New()
+	pending := []models.WishlistItem{
+		{CustomerID: customerID, ProductID: 1, Status: models.WishlistItemPending, PartnerKey: "acme"},
+	}
+	product := createProduct(1)
+
+	wishlistRepo.On("ListByStatus", models.WishlistItemPending, wishlistValidationBatchSize).Return(pending, nil)
+	productSvc.On("GetProductByID", int32(1)).Return(product, nil)
+	productRepo.On("Upsert", product).Return(nil)
+	customerRepo.On("GetByID", customerID.String()).Return(createCustomer(customerID, nil), nil)
+	policySvc.On("Check", mock.MatchedBy(func(input models.PolicyInput) bool {
+		return input.PartnerKey == "acme"
+	})).Return(&exceptions.PolicyViolationError{Reason: "wishlist rules violated: partner-categories"})
+	wishlistRepo.On("Remove", customerID.String(), int32(1)).Return(nil)
+
+	job := NewWishlistValidationJob(customerRepo, wishlistRepo, productRepo, productSvc, policySvc)
+	err := job.Run()
+
+	assert.NoError(t, err)
+	wishlistRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	wishlistRepo.AssertExpectations(t)
+	policySvc.AssertExpectations(t)
+}
+
+func TestWishlistValidationJob_KeepsPendingWhileApiIsDown(t *testing.T) {
+	wishlistRepo := new(mocks.WishlistQuerier)
+	productRepo := new(mocks.ProductQuerier)
+	productSvc := new(mocks.ProductServicer)
+
+	customerID := uuid.New()
+	pending := []models.WishlistItem{
+		{CustomerID: customerID, ProductID: 1, Status: models.WishlistItemPending},
+		{CustomerID: customerID, ProductID: 404, Status: models.WishlistItemPending},
+	}
+
+	wishlistRepo.On("ListByStatus", models.WishlistItemPending, wishlistValidationBatchSize).Return(pending, nil)
+	productSvc.On("GetProductByID", int32(1)).Return(nil, errors.New("connection refused"))
+	// the failed lookup doesn't hold back the rest of the batch
+	productSvc.On("GetProductByID", int32(404)).Return(nil, &exceptions.NotFoundEntityError{Reason: "product not found"})
+	wishlistRepo.On("Remove", customerID.String(), int32(404)).Return(nil)
+
+	job := NewWishlistValidationJob(new(mocks.CustomerQuerier), wishlistRepo, productRepo, productSvc, new(mocks.PolicyServicer))
+	err := job.Run()
+
+	assert.NoError(t, err)
+	wishlistRepo.AssertExpectations(t)
+	wishlistRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	wishlistRepo.AssertNotCalled(t, "Remove", customerID.String(), int32(1))
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"time"
 
 	_ "github.com/joho/godotenv/autoload"
 )
@@ -16,5 +17,86 @@ var (
 	DB_PASS           = os.Getenv("DB_PASS")
 	DB_PORT, _        = strconv.Atoi(os.Getenv("DB_PORT"))
 	PRODUCTS_BASE_URL = os.Getenv("PRODUCTS_BASE_URL")
-	API_KEY           = os.Getenv("API_KEY")
+	// PRODUCTS_API_TIMEOUT bounds each call to the products api
+	PRODUCTS_API_TIMEOUT = durationEnv("PRODUCTS_API_TIMEOUT", 10*time.Second)
+	API_KEY              = os.Getenv("API_KEY")
+	ADMIN_API_KEY        = os.Getenv("ADMIN_API_KEY")
+
+	// SHUTDOWN_TIMEOUT is how long the requests in flight get to finish on a
+	// stop signal
+	SHUTDOWN_TIMEOUT = durationEnv("SHUTDOWN_TIMEOUT", 15*time.Second)
+
+	CATALOG_WEBHOOK_SECRET = os.Getenv("CATALOG_WEBHOOK_SECRET")
+
+	// WISHLIST_RULES_FILE is an optional json file with wishlist rules, used
+	// along with the rules managed in the database
+	WISHLIST_RULES_FILE = os.Getenv("WISHLIST_RULES_FILE")
+	// WISHLIST_RULES_REFRESH_INTERVAL is how long the compiled database rules
+	// are kept before loading them again, for edits made on other instances
+	WISHLIST_RULES_REFRESH_INTERVAL = durationEnv("WISHLIST_RULES_REFRESH_INTERVAL", 30*time.Second)
+
+	WISHLIST_VALIDATION_INTERVAL = durationEnv("WISHLIST_VALIDATION_INTERVAL", time.Minute)
+	CATALOG_MIRROR_INTERVAL      = durationEnv("CATALOG_MIRROR_INTERVAL", time.Hour)
+
+	// Pending gift registry reservations are released after the ttl
+	REGISTRY_RESERVATION_TTL = durationEnv("REGISTRY_RESERVATION_TTL", 48*time.Hour)
+	REGISTRY_EXPIRY_INTERVAL = durationEnv("REGISTRY_EXPIRY_INTERVAL", 15*time.Minute)
+
+	// Removed wishlist items can be restored from the trash until the
+	// retention ends, zero deletes them right away
+	WISHLIST_TRASH_RETENTION        = retentionEnv("WISHLIST_TRASH_RETENTION", 30*24*time.Hour)
+	WISHLIST_TRASH_CLEANUP_INTERVAL = durationEnv("WISHLIST_TRASH_CLEANUP_INTERVAL", time.Hour)
+
+	// Items older than the age get a stale item reminder
+	WISHLIST_REMINDER_AGE      = durationEnv("WISHLIST_REMINDER_AGE", 30*24*time.Hour)
+	WISHLIST_REMINDER_INTERVAL = durationEnv("WISHLIST_REMINDER_INTERVAL", time.Hour)
+
+	// Product views are buffered in memory and written on every flush, each
+	// customer keeps the most recent views up to the max
+	RECENTLY_VIEWED_MAX_ITEMS      = intEnv("RECENTLY_VIEWED_MAX_ITEMS", 50)
+	RECENTLY_VIEWED_FLUSH_INTERVAL = durationEnv("RECENTLY_VIEWED_FLUSH_INTERVAL", 5*time.Second)
+
+	// Uploaded customer imports are picked up on the interval, an import
+	// without progress for the stale time is taken over by another run
+	CUSTOMER_IMPORT_INTERVAL   = durationEnv("CUSTOMER_IMPORT_INTERVAL", 5*time.Second)
+	CUSTOMER_IMPORT_STALE_TIME = durationEnv("CUSTOMER_IMPORT_STALE_TIME", 5*time.Minute)
+
+	// Changes older than the retention are removed from the change feed,
+	// a long-polling request checks for new changes on the poll interval
+	// and waits at most the max wait
+	CHANGE_FEED_RETENTION        = durationEnv("CHANGE_FEED_RETENTION", 7*24*time.Hour)
+	CHANGE_FEED_CLEANUP_INTERVAL = durationEnv("CHANGE_FEED_CLEANUP_INTERVAL", time.Hour)
+	CHANGE_FEED_POLL_INTERVAL    = durationEnv("CHANGE_FEED_POLL_INTERVAL", time.Second)
+	CHANGE_FEED_MAX_WAIT         = durationEnv("CHANGE_FEED_MAX_WAIT", 30*time.Second)
 )
+
+// durationEnv reads a positive time.ParseDuration value ("30s", "1h")
+// falling back to the default when the variable is missing or invalid.
+// Intervals can't be zero, the scheduler tickers would panic.
+func durationEnv(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+// retentionEnv is durationEnv for retentions where zero turns the retention
+// off, only negative values fall back to the default.
+func retentionEnv(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value < 0 {
+		return fallback
+	}
+	return value
+}
+
+// intEnv reads a positive integer falling back to the default when the
+// variable is missing or invalid.
+func intEnv(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
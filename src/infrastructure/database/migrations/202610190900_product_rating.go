@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610190900 = gormigrate.Migration{
+	ID: "202610190900",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.Exec(`
+			ALTER TABLE products
+			ADD COLUMN IF NOT EXISTS rating_rate real NOT NULL DEFAULT 0,
+			ADD COLUMN IF NOT EXISTS rating_count integer NOT NULL DEFAULT 0
+		`).Error
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Exec(`
+			ALTER TABLE products
+			DROP COLUMN IF EXISTS rating_rate,
+			DROP COLUMN IF EXISTS rating_count
+		`).Error
+	},
+}
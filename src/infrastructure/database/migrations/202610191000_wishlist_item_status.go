@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610191000 = gormigrate.Migration{
+	ID: "202610191000",
+	Migrate: func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			ALTER TABLE wishlists
+			ADD COLUMN IF NOT EXISTS status varchar(20) NOT NULL DEFAULT 'confirmed',
+			ADD COLUMN IF NOT EXISTS created_at timestamptz NOT NULL DEFAULT now()
+		`).Error; err != nil {
+			return err
+		}
+
+		// The validation job only ever looks for pending items
+		return tx.Exec(`
+			CREATE INDEX IF NOT EXISTS idx_wishlists_pending
+			ON wishlists (created_at)
+			WHERE status = 'pending'
+		`).Error
+	},
+	Rollback: func(tx *gorm.DB) error {
+		if err := tx.Exec(`DROP INDEX IF EXISTS idx_wishlists_pending`).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`
+			ALTER TABLE wishlists
+			DROP COLUMN IF EXISTS status,
+			DROP COLUMN IF EXISTS created_at
+		`).Error
+	},
+}
@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610191100 = gormigrate.Migration{
+	ID: "202610191100",
+	Migrate: func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			ALTER TABLE products
+			ADD COLUMN IF NOT EXISTS discontinued boolean NOT NULL DEFAULT false
+		`).Error; err != nil {
+			return err
+		}
+
+		return tx.AutoMigrate(&models.CatalogEvent{})
+	},
+	Rollback: func(tx *gorm.DB) error {
+		if err := tx.Migrator().DropTable(&models.CatalogEvent{}); err != nil {
+			return err
+		}
+		return tx.Exec(`ALTER TABLE products DROP COLUMN IF EXISTS discontinued`).Error
+	},
+}
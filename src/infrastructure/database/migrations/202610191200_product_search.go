@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610191200 = gormigrate.Migration{
+	ID: "202610191200",
+	// the full-text vector and the trigram indexes used by the product search
+	Migrate: func(tx *gorm.DB) error {
+		statements := []string{
+			`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+			`ALTER TABLE products
+			ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+				setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+				setweight(to_tsvector('portuguese', coalesce(title, '')), 'A') ||
+				setweight(to_tsvector('english', coalesce(category, '')), 'B') ||
+				setweight(to_tsvector('portuguese', coalesce(category, '')), 'B') ||
+				setweight(to_tsvector('english', coalesce(description, '')), 'C') ||
+				setweight(to_tsvector('portuguese', coalesce(description, '')), 'C')
+			) STORED`,
+			`CREATE INDEX IF NOT EXISTS idx_products_search_vector ON products USING GIN (search_vector)`,
+			`CREATE INDEX IF NOT EXISTS idx_products_title_trgm ON products USING GIN (title gin_trgm_ops)`,
+			`CREATE INDEX IF NOT EXISTS idx_products_category_trgm ON products USING GIN (category gin_trgm_ops)`,
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	Rollback: func(tx *gorm.DB) error {
+		statements := []string{
+			`DROP INDEX IF EXISTS idx_products_category_trgm`,
+			`DROP INDEX IF EXISTS idx_products_title_trgm`,
+			`DROP INDEX IF EXISTS idx_products_search_vector`,
+			`ALTER TABLE products DROP COLUMN IF EXISTS search_vector`,
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// Prices move from a real column to cents plus the currency code, fakestore
+// prices are in dollars.
+var migration202610191400 = gormigrate.Migration{
+	ID: "202610191400",
+	Migrate: func(tx *gorm.DB) error {
+		statements := []string{
+			`ALTER TABLE products
+			ADD COLUMN IF NOT EXISTS price_amount bigint NOT NULL DEFAULT 0,
+			ADD COLUMN IF NOT EXISTS price_currency varchar(3) NOT NULL DEFAULT 'USD'`,
+			// databases created after the change never had the old column
+			`DO $$
+			BEGIN
+				IF EXISTS (SELECT 1 FROM information_schema.columns
+				           WHERE table_name = 'products' AND column_name = 'price') THEN
+					UPDATE products SET price_amount = round(price::numeric * 100), price_currency = 'USD';
+					ALTER TABLE products DROP COLUMN price;
+				END IF;
+			END $$`,
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	Rollback: func(tx *gorm.DB) error {
+		statements := []string{
+			`ALTER TABLE products ADD COLUMN IF NOT EXISTS price real`,
+			`UPDATE products SET price = price_amount / 100.0`,
+			`ALTER TABLE products DROP COLUMN price_amount, DROP COLUMN price_currency`,
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610191500 = gormigrate.Migration{
+	ID: "202610191500",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(&models.ExchangeRate{})
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&models.ExchangeRate{})
+	},
+}
@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// Existing items get the price of their stored product, the closest known
+// value to the price when they were added.
+var migration202610191600 = gormigrate.Migration{
+	ID: "202610191600",
+	Migrate: func(tx *gorm.DB) error {
+		statements := []string{
+			`ALTER TABLE wishlists
+			ADD COLUMN IF NOT EXISTS added_price_amount bigint NOT NULL DEFAULT 0,
+			ADD COLUMN IF NOT EXISTS added_price_currency varchar(3) NOT NULL DEFAULT 'USD'`,
+			`UPDATE wishlists w
+			SET added_price_amount = p.price_amount, added_price_currency = p.price_currency
+			FROM products p
+			WHERE p.id = w.product_id AND w.added_price_amount = 0`,
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Exec(`
+			ALTER TABLE wishlists
+			DROP COLUMN IF EXISTS added_price_amount,
+			DROP COLUMN IF EXISTS added_price_currency
+		`).Error
+	},
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610191700 = gormigrate.Migration{
+	ID: "202610191700",
+	Migrate: func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&models.CartItem{}); err != nil {
+			return err
+		}
+
+		// The cart goes away with its customer
+		return tx.Exec(`
+			ALTER TABLE cart_items
+			ADD CONSTRAINT fk_cart_items_customer
+			FOREIGN KEY (customer_id)
+			REFERENCES customers(id)
+			ON DELETE CASCADE
+		`).Error
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&models.CartItem{})
+	},
+}
@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610191800 = gormigrate.Migration{
+	ID: "202610191800",
+	Migrate: func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&models.WishlistCollaborator{}); err != nil {
+			return err
+		}
+
+		return tx.Exec(`ALTER TABLE wishlists ADD COLUMN IF NOT EXISTS added_by uuid`).Error
+	},
+	Rollback: func(tx *gorm.DB) error {
+		if err := tx.Exec(`ALTER TABLE wishlists DROP COLUMN IF EXISTS added_by`).Error; err != nil {
+			return err
+		}
+		return tx.Migrator().DropTable(&models.WishlistCollaborator{})
+	},
+}
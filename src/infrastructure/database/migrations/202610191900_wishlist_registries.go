@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610191900 = gormigrate.Migration{
+	ID: "202610191900",
+	Migrate: func(tx *gorm.DB) error {
+		if err := tx.Exec(`ALTER TABLE wishlists ADD COLUMN IF NOT EXISTS quantity integer NOT NULL DEFAULT 1`).Error; err != nil {
+			return err
+		}
+		if err := tx.AutoMigrate(&models.WishlistRegistry{}, &models.RegistryReservation{}); err != nil {
+			return err
+		}
+
+		// The registry goes away with its customer and the reservations
+		// with the wishlist item they were made for
+		return tx.Exec(`
+			ALTER TABLE wishlist_registries
+			ADD CONSTRAINT fk_wishlist_registries_customer
+			FOREIGN KEY (customer_id)
+			REFERENCES customers(id)
+			ON DELETE CASCADE;
+
+			ALTER TABLE registry_reservations
+			ADD CONSTRAINT fk_registry_reservations_item
+			FOREIGN KEY (customer_id, product_id)
+			REFERENCES wishlists(customer_id, product_id)
+			ON DELETE CASCADE;
+		`).Error
+	},
+	Rollback: func(tx *gorm.DB) error {
+		if err := tx.Migrator().DropTable(&models.RegistryReservation{}, &models.WishlistRegistry{}); err != nil {
+			return err
+		}
+		return tx.Exec(`ALTER TABLE wishlists DROP COLUMN IF EXISTS quantity`).Error
+	},
+}
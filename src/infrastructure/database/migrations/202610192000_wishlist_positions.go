@@ -0,0 +1,60 @@
+package migrations
+
+import (
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var migration202610192000 = gormigrate.Migration{
+	ID: "202610192000",
+	Migrate: func(tx *gorm.DB) error {
+		err := tx.Exec(`
+			ALTER TABLE wishlists ADD COLUMN IF NOT EXISTS position varchar(255);
+			CREATE INDEX IF NOT EXISTS idx_wishlists_position ON wishlists (customer_id, position COLLATE "C");
+		`).Error
+		if err != nil {
+			return err
+		}
+
+		// The current items keep the order they were added in
+		var items []struct {
+			CustomerID uuid.UUID
+			ProductID  int32
+		}
+		err = tx.Table("wishlists").
+			Select("customer_id, product_id").
+			Where("position IS NULL").
+			Order("customer_id, created_at").
+			Scan(&items).Error
+		if err != nil {
+			return err
+		}
+
+		for start := 0; start < len(items); {
+			end := start
+			for end < len(items) && items[end].CustomerID == items[start].CustomerID {
+				end++
+			}
+			for i, rank := range models.EvenRanks(end - start) {
+				item := items[start+i]
+				err := tx.Table("wishlists").
+					Where("customer_id = ? AND product_id = ?", item.CustomerID, item.ProductID).
+					Update("position", rank).Error
+				if err != nil {
+					return err
+				}
+			}
+			start = end
+		}
+		return nil
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Exec(`
+			DROP INDEX IF EXISTS idx_wishlists_position;
+			ALTER TABLE wishlists DROP COLUMN IF EXISTS position;
+		`).Error
+	},
+}
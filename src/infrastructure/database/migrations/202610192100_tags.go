@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610192100 = gormigrate.Migration{
+	ID: "202610192100",
+	Migrate: func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&models.Tag{}, &models.WishlistItemTag{}); err != nil {
+			return err
+		}
+
+		// Tags go away with their customer, the links with the item or the tag
+		return tx.Exec(`
+			ALTER TABLE tags
+			ADD CONSTRAINT fk_tags_customer
+			FOREIGN KEY (customer_id)
+			REFERENCES customers(id)
+			ON DELETE CASCADE;
+
+			ALTER TABLE wishlist_item_tags
+			ADD CONSTRAINT fk_wishlist_item_tags_item
+			FOREIGN KEY (customer_id, product_id)
+			REFERENCES wishlists(customer_id, product_id)
+			ON DELETE CASCADE;
+
+			ALTER TABLE wishlist_item_tags
+			ADD CONSTRAINT fk_wishlist_item_tags_tag
+			FOREIGN KEY (tag_id)
+			REFERENCES tags(id)
+			ON DELETE CASCADE;
+		`).Error
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&models.WishlistItemTag{}, &models.Tag{})
+	},
+}
@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610192200 = gormigrate.Migration{
+	ID: "202610192200",
+	Migrate: func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&models.TrashedWishlistItem{}); err != nil {
+			return err
+		}
+
+		return tx.Exec(`
+			ALTER TABLE wishlist_trash
+			ADD CONSTRAINT fk_wishlist_trash_customer
+			FOREIGN KEY (customer_id)
+			REFERENCES customers(id)
+			ON DELETE CASCADE;
+		`).Error
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&models.TrashedWishlistItem{})
+	},
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610192300 = gormigrate.Migration{
+	ID: "202610192300",
+	Migrate: func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&models.WishlistReminder{}, &models.ReminderPreference{}); err != nil {
+			return err
+		}
+
+		return tx.Exec(`
+			ALTER TABLE wishlist_reminders
+			ADD CONSTRAINT fk_wishlist_reminders_customer
+			FOREIGN KEY (customer_id)
+			REFERENCES customers(id)
+			ON DELETE CASCADE;
+
+			ALTER TABLE reminder_preferences
+			ADD CONSTRAINT fk_reminder_preferences_customer
+			FOREIGN KEY (customer_id)
+			REFERENCES customers(id)
+			ON DELETE CASCADE;
+		`).Error
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&models.WishlistReminder{}, &models.ReminderPreference{})
+	},
+}
@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610200200 = gormigrate.Migration{
+	ID: "202610200200",
+	Migrate: func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&models.ProductView{}); err != nil {
+			return err
+		}
+
+		return tx.Exec(`
+			ALTER TABLE product_views
+			ADD CONSTRAINT fk_product_views_customer
+			FOREIGN KEY (customer_id)
+			REFERENCES customers(id)
+			ON DELETE CASCADE;
+		`).Error
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&models.ProductView{})
+	},
+}
@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610200300 = gormigrate.Migration{
+	ID: "202610200300",
+	Migrate: func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&models.ImportJob{}, &models.ImportJobError{}); err != nil {
+			return err
+		}
+
+		return tx.Exec(`
+			ALTER TABLE import_job_errors
+			ADD CONSTRAINT fk_import_job_errors_job
+			FOREIGN KEY (job_id)
+			REFERENCES import_jobs(id)
+			ON DELETE CASCADE;
+		`).Error
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&models.ImportJobError{}, &models.ImportJob{})
+	},
+}
@@ -0,0 +1,83 @@
+package migrations
+
+import (
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var migration202610200400 = gormigrate.Migration{
+	ID: "202610200400",
+	Migrate: func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&models.ChangeEvent{}, &models.ChangeFeedState{}); err != nil {
+			return err
+		}
+
+		// The triggers write the changes of customers and wishlist items to
+		// change_events. The transaction id is recorded to order the feed, see
+		// models.ChangeCursor. Updates that don't change the row are skipped.
+		statements := []string{
+			`CREATE OR REPLACE FUNCTION record_change_event() RETURNS trigger AS $$
+			DECLARE
+				row_data jsonb;
+			BEGIN
+				IF TG_OP = 'DELETE' THEN
+					row_data := to_jsonb(OLD);
+				ELSE
+					row_data := to_jsonb(NEW);
+				END IF;
+
+				INSERT INTO change_events (tx_id, entity, action, customer_id, product_id, data, changed_at)
+				VALUES (
+					pg_current_xact_id()::text::bigint,
+					TG_ARGV[0],
+					CASE TG_OP WHEN 'INSERT' THEN 'created' WHEN 'UPDATE' THEN 'updated' ELSE 'deleted' END,
+					COALESCE(row_data->>'customer_id', row_data->>'id')::uuid,
+					(row_data->>'product_id')::integer,
+					CASE WHEN TG_OP = 'DELETE' THEN NULL ELSE row_data END,
+					now()
+				);
+				RETURN NULL;
+			END;
+			$$ LANGUAGE plpgsql`,
+			`DROP TRIGGER IF EXISTS customers_change_events ON customers`,
+			`CREATE TRIGGER customers_change_events
+			AFTER INSERT OR DELETE ON customers
+			FOR EACH ROW EXECUTE FUNCTION record_change_event('customer')`,
+			`DROP TRIGGER IF EXISTS customers_change_events_update ON customers`,
+			`CREATE TRIGGER customers_change_events_update
+			AFTER UPDATE ON customers
+			FOR EACH ROW WHEN (OLD IS DISTINCT FROM NEW) EXECUTE FUNCTION record_change_event('customer')`,
+			`DROP TRIGGER IF EXISTS wishlists_change_events ON wishlists`,
+			`CREATE TRIGGER wishlists_change_events
+			AFTER INSERT OR DELETE ON wishlists
+			FOR EACH ROW EXECUTE FUNCTION record_change_event('wishlist_item')`,
+			`DROP TRIGGER IF EXISTS wishlists_change_events_update ON wishlists`,
+			`CREATE TRIGGER wishlists_change_events_update
+			AFTER UPDATE ON wishlists
+			FOR EACH ROW WHEN (OLD IS DISTINCT FROM NEW) EXECUTE FUNCTION record_change_event('wishlist_item')`,
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	Rollback: func(tx *gorm.DB) error {
+		statements := []string{
+			`DROP TRIGGER IF EXISTS customers_change_events ON customers`,
+			`DROP TRIGGER IF EXISTS customers_change_events_update ON customers`,
+			`DROP TRIGGER IF EXISTS wishlists_change_events ON wishlists`,
+			`DROP TRIGGER IF EXISTS wishlists_change_events_update ON wishlists`,
+			`DROP FUNCTION IF EXISTS record_change_event()`,
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Migrator().DropTable(&models.ChangeFeedState{}, &models.ChangeEvent{})
+	},
+}
@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// Pending items keep the partner that added them, the rules are checked
+// when the validation job confirms them.
+var migration202610200500 = gormigrate.Migration{
+	ID: "202610200500",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.Exec(`ALTER TABLE wishlists ADD COLUMN IF NOT EXISTS partner_key varchar(100)`).Error
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Exec(`ALTER TABLE wishlists DROP COLUMN IF EXISTS partner_key`).Error
+	},
+}
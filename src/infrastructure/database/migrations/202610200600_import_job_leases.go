@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// Import jobs get the lease of the worker running them and customers the
+// import that created them, so a job taken over redoes its rows safely.
+var migration202610200600 = gormigrate.Migration{
+	ID: "202610200600",
+	Migrate: func(tx *gorm.DB) error {
+		statements := []string{
+			`ALTER TABLE import_jobs ADD COLUMN IF NOT EXISTS claimed_by uuid`,
+			`ALTER TABLE customers ADD COLUMN IF NOT EXISTS import_job_id uuid`,
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	Rollback: func(tx *gorm.DB) error {
+		statements := []string{
+			`ALTER TABLE customers DROP COLUMN IF EXISTS import_job_id`,
+			`ALTER TABLE import_jobs DROP COLUMN IF EXISTS claimed_by`,
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// Products stored only to queue a pending item are flagged, the existing
+// ones are recognised by their missing catalog data.
+var migration202610200700 = gormigrate.Migration{
+	ID: "202610200700",
+	Migrate: func(tx *gorm.DB) error {
+		statements := []string{
+			`ALTER TABLE products ADD COLUMN IF NOT EXISTS placeholder boolean NOT NULL DEFAULT false`,
+			`UPDATE products SET placeholder = true
+			WHERE title = '' AND description = '' AND category = '' AND price_amount = 0`,
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Exec(`ALTER TABLE products DROP COLUMN IF EXISTS placeholder`).Error
+	},
+}
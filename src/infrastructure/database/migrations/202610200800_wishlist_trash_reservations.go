@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// Registry reservations are deleted with their wishlist item, the trash
+// keeps a copy so restoring the item brings them back.
+var migration202610200800 = gormigrate.Migration{
+	ID: "202610200800",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.Exec(`ALTER TABLE wishlist_trash ADD COLUMN IF NOT EXISTS reservations text`).Error
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Exec(`ALTER TABLE wishlist_trash DROP COLUMN IF EXISTS reservations`).Error
+	},
+}
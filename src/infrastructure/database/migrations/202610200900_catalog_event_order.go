@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// Catalog events carry the time of the change so out of order deliveries
+// don't overwrite newer product data. The events logged so far take their
+// processing time.
+var migration202610200900 = gormigrate.Migration{
+	ID: "202610200900",
+	Migrate: func(tx *gorm.DB) error {
+		statements := []string{
+			`ALTER TABLE catalog_events ADD COLUMN IF NOT EXISTS occurred_at timestamptz`,
+			`UPDATE catalog_events SET occurred_at = processed_at WHERE occurred_at IS NULL`,
+			`ALTER TABLE catalog_events ALTER COLUMN occurred_at SET NOT NULL`,
+			`CREATE INDEX IF NOT EXISTS idx_catalog_events_product_occurred_at
+			ON catalog_events (product_id, occurred_at)`,
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Exec(`ALTER TABLE catalog_events DROP COLUMN IF EXISTS occurred_at`).Error
+	},
+}
@@ -10,7 +10,32 @@ import (
 var files = []*gormigrate.Migration{
 	&migration202508050602,
 	&migration202508060345,
-	&migration202508060560}
+	&migration202508060560,
+	&migration202610190900,
+	&migration202610191000,
+	&migration202610191100,
+	&migration202610191200,
+	&migration202610191300,
+	&migration202610191400,
+	&migration202610191500,
+	&migration202610191600,
+	&migration202610191700,
+	&migration202610191800,
+	&migration202610191900,
+	&migration202610192000,
+	&migration202610192100,
+	&migration202610192200,
+	&migration202610192300,
+	&migration202610200000,
+	&migration202610200100,
+	&migration202610200200,
+	&migration202610200300,
+	&migration202610200400,
+	&migration202610200500,
+	&migration202610200600,
+	&migration202610200700,
+	&migration202610200800,
+	&migration202610200900}
 
 func RunMigrations(db *gorm.DB) {
 	m := gormigrate.New(db, gormigrate.DefaultOptions, files)
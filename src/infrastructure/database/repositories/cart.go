@@ -0,0 +1,163 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CartRepository struct {
+	db *gorm.DB
+}
+
+func NewCartRepository(db *gorm.DB) interfaces.CartQuerier {
+	return &CartRepository{db: db}
+}
+
+func (r *CartRepository) ListByCustomer(customerID string) ([]models.CartItem, error) {
+	var items []models.CartItem
+	err := r.db.Preload("Product").
+		Where("customer_id = ?", customerID).
+		Order("created_at").
+		Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *CartRepository) Get(customerID string, productID int32) (*models.CartItem, error) {
+	var item models.CartItem
+	err := r.db.Preload("Product").
+		First(&item, "customer_id = ? AND product_id = ?", customerID, productID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &item, nil
+}
+
+// Add puts the item in the cart, adding to the quantity when the product is
+// already there. The unit price is replaced by the new snapshot. The customer
+// row is locked so concurrent adds can't go over the quantity cap together,
+// false is returned when the cap would be exceeded.
+func (r *CartRepository) Add(item *models.CartItem) (bool, error) {
+	added := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockCartCustomer(tx, item.CustomerID.String()); err != nil {
+			return err
+		}
+
+		var current int
+		err := tx.Model(&models.CartItem{}).
+			Select("COALESCE(SUM(quantity), 0)").
+			Where("customer_id = ? AND product_id = ?", item.CustomerID, item.ProductID).
+			Scan(&current).Error
+		if err != nil {
+			return err
+		}
+		if current+item.Quantity > models.MaxCartItemQuantity {
+			return nil
+		}
+
+		added = true
+		return addCartItem(tx, item)
+	})
+	return added, err
+}
+
+func (r *CartRepository) UpdateQuantity(customerID string, productID int32, quantity int) error {
+	return r.db.Model(&models.CartItem{}).
+		Where("customer_id = ? AND product_id = ?", customerID, productID).
+		Update("quantity", quantity).Error
+}
+
+func (r *CartRepository) Remove(customerID string, productID int32) error {
+	return r.db.Where("customer_id = ? AND product_id = ?", customerID, productID).
+		Delete(&models.CartItem{}).Error
+}
+
+// MoveFromWishlist adds the items to the cart and takes them out of the
+// wishlist in a single transaction. The removed items are kept in the trash
+// until trashExpiresAt, a zero time deletes them as when the trash is off.
+// As in Add the customer row is locked, the items that would go over the
+// quantity cap are left in the wishlist and their product ids returned.
+func (r *CartRepository) MoveFromWishlist(customerID string, items []models.CartItem, keepInWishlist bool,
+	trashExpiresAt time.Time) ([]int32, error) {
+	skipped := []int32{}
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockCartCustomer(tx, customerID); err != nil {
+			return err
+		}
+
+		var cart []models.CartItem
+		err := tx.Select("product_id", "quantity").Where("customer_id = ?", customerID).Find(&cart).Error
+		if err != nil {
+			return err
+		}
+		quantities := make(map[int32]int, len(cart))
+		for _, item := range cart {
+			quantities[item.ProductID] = item.Quantity
+		}
+
+		productIDs := []int32{}
+		moved := []models.CartItem{}
+		for i := range items {
+			if quantities[items[i].ProductID]+items[i].Quantity > models.MaxCartItemQuantity {
+				skipped = append(skipped, items[i].ProductID)
+				continue
+			}
+			if err := addCartItem(tx, &items[i]); err != nil {
+				return err
+			}
+			productIDs = append(productIDs, items[i].ProductID)
+			moved = append(moved, items[i])
+		}
+
+		if keepInWishlist || len(productIDs) == 0 {
+			return nil
+		}
+		if trashExpiresAt.IsZero() {
+			return tx.Where("customer_id = ? AND product_id IN ?", customerID, productIDs).
+				Delete(&models.WishlistItem{}).Error
+		}
+
+		trash := NewWishlistTrashRepository(tx)
+		for _, item := range moved {
+			removedBy := item.CustomerID
+			if err := trash.Trash(customerID, item.ProductID, &removedBy, trashExpiresAt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return skipped, err
+}
+
+// lockCartCustomer locks the customer row for the cart changes checking the
+// quantity cap, the lock doesn't block the changes of the other tables
+// referencing the customer
+func lockCartCustomer(tx *gorm.DB, customerID string) error {
+	return tx.Clauses(clause.Locking{Strength: "NO KEY UPDATE"}).
+		Select("id").
+		First(&models.Customer{}, "id = ?", customerID).Error
+}
+
+func addCartItem(db *gorm.DB, item *models.CartItem) error {
+	return db.Omit("Product").Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "customer_id"}, {Name: "product_id"}},
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "quantity"}, Value: gorm.Expr("cart_items.quantity + excluded.quantity")},
+			{Column: clause.Column{Name: "unit_price_amount"}, Value: gorm.Expr("excluded.unit_price_amount")},
+			{Column: clause.Column{Name: "unit_price_currency"}, Value: gorm.Expr("excluded.unit_price_currency")},
+			{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("excluded.updated_at")},
+		},
+	}).Create(item).Error
+}
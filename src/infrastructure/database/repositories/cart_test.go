@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupCartTest(t *testing.T) (queriers.CartQuerier, *models.Customer) {
+	err := TestDB.Migrator().DropTable(&models.CartItem{}, &models.TrashedWishlistItem{}, &models.RegistryReservation{},
+		&models.WishlistItemTag{}, &models.Tag{}, &models.WishlistItem{}, &models.Customer{}, &models.Product{})
+	assert.NoError(t, err)
+
+	err = TestDB.AutoMigrate(&models.Customer{}, &models.Product{}, &models.WishlistItem{}, &models.CartItem{},
+		&models.Tag{}, &models.WishlistItemTag{}, &models.TrashedWishlistItem{}, &models.RegistryReservation{})
+	assert.NoError(t, err)
+
+	customer := &models.Customer{Name: "Customer", Email: "cart@ig.com"}
+	assert.NoError(t, TestDB.Create(customer).Error)
+	assert.NoError(t, TestDB.Create(&[]models.Product{{ID: 1, Title: "Produto 1"}, {ID: 2, Title: "Produto 2"}}).Error)
+
+	return NewCartRepository(TestDB), customer
+}
+
+func TestCartRepository_AddIncrementsQuantity(t *testing.T) {
+	repo, customer := SetupCartTest(t)
+
+	added, err := repo.Add(&models.CartItem{CustomerID: customer.ID, ProductID: 1, Quantity: 2, UnitPrice: models.NewMoney(1000, "USD")})
+	assert.NoError(t, err)
+	assert.True(t, added)
+	added, err = repo.Add(&models.CartItem{CustomerID: customer.ID, ProductID: 1, Quantity: 3, UnitPrice: models.NewMoney(900, "USD")})
+	assert.NoError(t, err)
+	assert.True(t, added)
+	// Over the cap nothing changes
+	added, err = repo.Add(&models.CartItem{CustomerID: customer.ID, ProductID: 1, Quantity: models.MaxCartItemQuantity, UnitPrice: models.NewMoney(800, "USD")})
+	assert.NoError(t, err)
+	assert.False(t, added)
+
+	item, err := repo.Get(customer.ID.String(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, item.Quantity)
+	assert.Equal(t, int64(900), item.UnitPrice.Amount)
+	assert.Equal(t, "Produto 1", item.Product.Title)
+
+	missing, err := repo.Get(customer.ID.String(), 2)
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestCartRepository_UpdateAndRemove(t *testing.T) {
+	repo, customer := SetupCartTest(t)
+
+	_, _ = repo.Add(&models.CartItem{CustomerID: customer.ID, ProductID: 1, Quantity: 1, UnitPrice: models.NewMoney(1000, "USD")})
+	_, _ = repo.Add(&models.CartItem{CustomerID: customer.ID, ProductID: 2, Quantity: 1, UnitPrice: models.NewMoney(500, "USD")})
+
+	assert.NoError(t, repo.UpdateQuantity(customer.ID.String(), 1, 4))
+	assert.NoError(t, repo.Remove(customer.ID.String(), 2))
+
+	items, err := repo.ListByCustomer(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, 4, items[0].Quantity)
+}
+
+func TestCartRepository_MoveFromWishlist(t *testing.T) {
+	repo, customer := SetupCartTest(t)
+	wishlist := NewWishlistRepository(TestDB)
+
+	_ = wishlist.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1, Status: models.WishlistItemConfirmed})
+	_ = wishlist.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 2, Status: models.WishlistItemConfirmed})
+
+	full, err := repo.MoveFromWishlist(customer.ID.String(), []models.CartItem{
+		{CustomerID: customer.ID, ProductID: 1, Quantity: 1, UnitPrice: models.NewMoney(1000, "USD")},
+	}, false, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Empty(t, full)
+	_, err = repo.MoveFromWishlist(customer.ID.String(), []models.CartItem{
+		{CustomerID: customer.ID, ProductID: 2, Quantity: 1, UnitPrice: models.NewMoney(500, "USD")},
+	}, true, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	cart, err := repo.ListByCustomer(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Len(t, cart, 2)
+
+	items, err := wishlist.ListByCustomer(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, int32(2), items[0].ProductID)
+
+	// The moved item can be restored from the trash
+	trashed, err := NewWishlistTrashRepository(TestDB).Get(customer.ID.String(), 1, time.Now())
+	assert.NoError(t, err)
+	assert.NotNil(t, trashed)
+}
+
+func TestCartRepository_MoveFromWishlistKeepsTheCap(t *testing.T) {
+	repo, customer := SetupCartTest(t)
+	wishlist := NewWishlistRepository(TestDB)
+
+	_ = wishlist.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1, Status: models.WishlistItemConfirmed})
+	added, err := repo.Add(&models.CartItem{CustomerID: customer.ID, ProductID: 1,
+		Quantity: models.MaxCartItemQuantity, UnitPrice: models.NewMoney(1000, "USD")})
+	assert.NoError(t, err)
+	assert.True(t, added)
+
+	full, err := repo.MoveFromWishlist(customer.ID.String(), []models.CartItem{
+		{CustomerID: customer.ID, ProductID: 1, Quantity: 1, UnitPrice: models.NewMoney(1000, "USD")},
+	}, false, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{1}, full)
+
+	item, err := repo.Get(customer.ID.String(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, models.MaxCartItemQuantity, item.Quantity)
+	items, err := wishlist.ListByCustomer(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+}
@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"time"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CatalogEventRepository struct {
+	db *gorm.DB
+}
+
+func NewCatalogEventRepository(db *gorm.DB) interfaces.CatalogEventQuerier {
+	return &CatalogEventRepository{db: db}
+}
+
+func (r *CatalogEventRepository) Exists(eventID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.CatalogEvent{}).Where("id = ?", eventID).Count(&count).Error
+	return count > 0, err
+}
+
+// LastOccurredAt is the time of the newest event logged for the product,
+// zero when there is none
+func (r *CatalogEventRepository) LastOccurredAt(productID int32) (time.Time, error) {
+	var last *time.Time
+	err := r.db.Model(&models.CatalogEvent{}).
+		Select("MAX(occurred_at)").
+		Where("product_id = ?", productID).
+		Row().Scan(&last)
+	if err != nil || last == nil {
+		return time.Time{}, err
+	}
+	return *last, nil
+}
+
+// Record logs the event together with its effect on the stored product in a
+// single transaction. The event goes in first, when a concurrent delivery
+// already logged it false is returned and nothing else is saved. The product
+// row is locked while checking for newer events, when one was already logged
+// the event is kept in the log but the product and changes are not saved.
+func (r *CatalogEventRepository) Record(event *models.CatalogEvent, product *models.Product, changes []models.ProductChange) (bool, error) {
+	recorded := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(event)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		recorded = true
+		if product != nil {
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Select("id").
+				Where("id = ?", product.ID).
+				Find(&models.Product{}).Error
+			if err != nil {
+				return err
+			}
+			var newer int64
+			err = tx.Model(&models.CatalogEvent{}).
+				Where("product_id = ? AND occurred_at > ?", event.ProductID, event.OccurredAt).
+				Count(&newer).Error
+			if err != nil {
+				return err
+			}
+			if newer > 0 {
+				return nil
+			}
+			if err := NewProductRepository(tx).Upsert(product); err != nil {
+				return err
+			}
+		}
+		if len(changes) > 0 {
+			return tx.Create(&changes).Error
+		}
+		return nil
+	})
+	return recorded, err
+}
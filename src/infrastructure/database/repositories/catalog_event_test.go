@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupCatalogEventTest(t *testing.T) queriers.CatalogEventQuerier {
+	err := TestDB.Migrator().DropTable(&models.CatalogEvent{}, &models.ProductChange{}, &models.WishlistItem{}, &models.Product{})
+	assert.NoError(t, err)
+
+	err = TestDB.AutoMigrate(&models.CatalogEvent{}, &models.Product{}, &models.ProductChange{})
+	assert.NoError(t, err)
+	assert.NoError(t, TestDB.Create(&models.Product{ID: 1, Title: "Produto 1", Price: models.NewMoney(1000, "USD")}).Error)
+
+	return NewCatalogEventRepository(TestDB)
+}
+
+func TestCatalogEventRepository_RecordAndExists(t *testing.T) {
+	repo := SetupCatalogEventTest(t)
+
+	exists, err := repo.Exists("evt-1")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	event := &models.CatalogEvent{ID: "evt-1", Type: models.CatalogEventProductPriceChanged, ProductID: 1}
+	product := &models.Product{ID: 1, Title: "Produto 1", Price: models.NewMoney(800, "USD")}
+	changes := []models.ProductChange{{ProductID: 1, Type: models.ProductChangeUpdated, Field: "price",
+		OldValue: "10.00", NewValue: "8.00", ChangedAt: time.Now()}}
+	recorded, err := repo.Record(event, product, changes)
+	assert.NoError(t, err)
+	assert.True(t, recorded)
+
+	// A redelivery racing the first one saves nothing
+	redelivery := &models.CatalogEvent{ID: "evt-1", Type: models.CatalogEventProductPriceChanged, ProductID: 1}
+	recorded, err = repo.Record(redelivery, &models.Product{ID: 1, Title: "Produto 1", Price: models.NewMoney(700, "USD")},
+		[]models.ProductChange{{ProductID: 1, Type: models.ProductChangeUpdated, Field: "price", ChangedAt: time.Now()}})
+	assert.NoError(t, err)
+	assert.False(t, recorded)
+
+	exists, err = repo.Exists("evt-1")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	stored, _ := NewProductRepository(TestDB).GetByID(1)
+	assert.Equal(t, int64(800), stored.Price.Amount)
+	var logged int64
+	TestDB.Model(&models.ProductChange{}).Count(&logged)
+	assert.Equal(t, int64(1), logged)
+}
+
+func TestCatalogEventRepository_RecordKeepsNewerChanges(t *testing.T) {
+	repo := SetupCatalogEventTest(t)
+	now := time.Now()
+
+	newer := &models.CatalogEvent{ID: "evt-2", Type: models.CatalogEventProductPriceChanged, ProductID: 1, OccurredAt: now}
+	recorded, err := repo.Record(newer, &models.Product{ID: 1, Title: "Produto 1", Price: models.NewMoney(800, "USD")}, nil)
+	assert.NoError(t, err)
+	assert.True(t, recorded)
+
+	last, err := repo.LastOccurredAt(1)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, now, last, time.Millisecond)
+
+	// The older change arriving late is logged but doesn't overwrite the price
+	older := &models.CatalogEvent{ID: "evt-1", Type: models.CatalogEventProductPriceChanged, ProductID: 1,
+		OccurredAt: now.Add(-time.Minute)}
+	recorded, err = repo.Record(older, &models.Product{ID: 1, Title: "Produto 1", Price: models.NewMoney(900, "USD")},
+		[]models.ProductChange{{ProductID: 1, Type: models.ProductChangeUpdated, Field: "price", ChangedAt: now}})
+	assert.NoError(t, err)
+	assert.True(t, recorded)
+
+	exists, err := repo.Exists("evt-1")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	stored, _ := NewProductRepository(TestDB).GetByID(1)
+	assert.Equal(t, int64(800), stored.Price.Amount)
+	var logged int64
+	TestDB.Model(&models.ProductChange{}).Count(&logged)
+	assert.Equal(t, int64(0), logged)
+
+	last, err = repo.LastOccurredAt(2)
+	assert.NoError(t, err)
+	assert.True(t, last.IsZero())
+}
@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// finishedTransactions is the oldest transaction still running, every
+// transaction before it committed or rolled back
+const finishedTransactions = "pg_snapshot_xmin(pg_current_snapshot())::text::bigint"
+
+type ChangeEventRepository struct {
+	db *gorm.DB
+}
+
+func NewChangeEventRepository(db *gorm.DB) interfaces.ChangeEventQuerier {
+	return &ChangeEventRepository{db: db}
+}
+
+func (r *ChangeEventRepository) ListAfter(cursor models.ChangeCursor, limit int) ([]models.ChangeEvent, error) {
+	var events []models.ChangeEvent
+	err := r.db.
+		Where("(tx_id, id) > (?, ?)", cursor.TxID, cursor.ID).
+		Where("tx_id < " + finishedTransactions).
+		Order("tx_id, id").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+func (r *ChangeEventRepository) PrunedThrough() (models.ChangeCursor, error) {
+	var state models.ChangeFeedState
+	err := r.db.Take(&state, 1).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.ChangeCursor{}, nil
+	}
+	if err != nil {
+		return models.ChangeCursor{}, err
+	}
+	return models.ChangeCursor{TxID: state.PrunedTxID, ID: state.PrunedID}, nil
+}
+
+// Prune removes the changes older than before and moves the pruned position
+// forward in the same statement
+func (r *ChangeEventRepository) Prune(before time.Time) (int64, error) {
+	var pruned int64
+	err := r.db.Raw(`
+		WITH pruned AS (
+			DELETE FROM change_events
+			WHERE changed_at < ? AND tx_id < `+finishedTransactions+`
+			RETURNING tx_id, id
+		), last AS (
+			SELECT tx_id, id FROM pruned ORDER BY tx_id DESC, id DESC LIMIT 1
+		), saved AS (
+			INSERT INTO change_feed_state (id, pruned_tx_id, pruned_id)
+			SELECT 1, tx_id, id FROM last
+			ON CONFLICT (id) DO UPDATE
+			SET pruned_tx_id = EXCLUDED.pruned_tx_id, pruned_id = EXCLUDED.pruned_id
+			WHERE (EXCLUDED.pruned_tx_id, EXCLUDED.pruned_id) >
+				(change_feed_state.pruned_tx_id, change_feed_state.pruned_id)
+			RETURNING 1
+		)
+		SELECT count(*) FROM pruned`, before).Scan(&pruned).Error
+	return pruned, err
+}
@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupChangeEventTest(t *testing.T) queriers.ChangeEventQuerier {
+	// the change feed triggers are only added by the migrations
+	resetSchema(t)
+
+	assert.NoError(t, TestDB.Create(&models.Product{ID: 1, Title: "Produto 1"}).Error)
+	return NewChangeEventRepository(TestDB)
+}
+
+func TestChangeEventRepository_RecordsChangesInOrder(t *testing.T) {
+	repo := SetupChangeEventTest(t)
+
+	customer := &models.Customer{Name: "Customer", Email: "feed@ig.com"}
+	assert.NoError(t, TestDB.Create(customer).Error)
+	assert.NoError(t, TestDB.Model(customer).Update("name", "Customer Feed").Error)
+	// An update that changes nothing is not recorded
+	assert.NoError(t, TestDB.Exec("UPDATE customers SET name = name WHERE id = ?", customer.ID).Error)
+	assert.NoError(t, TestDB.Create(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1,
+		Status: models.WishlistItemConfirmed, Quantity: 1}).Error)
+	assert.NoError(t, TestDB.Exec("DELETE FROM wishlists WHERE customer_id = ?", customer.ID).Error)
+	assert.NoError(t, TestDB.Exec("DELETE FROM customers WHERE id = ?", customer.ID).Error)
+
+	events, err := repo.ListAfter(models.ChangeCursor{}, 100)
+	assert.NoError(t, err)
+	assert.Len(t, events, 5)
+	type change struct{ entity, action string }
+	var changes []change
+	for _, event := range events {
+		changes = append(changes, change{event.Entity, event.Action})
+		assert.Equal(t, customer.ID, event.CustomerID)
+	}
+	assert.Equal(t, []change{
+		{models.ChangeEntityCustomer, models.ChangeCreated},
+		{models.ChangeEntityCustomer, models.ChangeUpdated},
+		{models.ChangeEntityWishlistItem, models.ChangeCreated},
+		{models.ChangeEntityWishlistItem, models.ChangeDeleted},
+		{models.ChangeEntityCustomer, models.ChangeDeleted},
+	}, changes)
+	assert.JSONEq(t, `"Customer Feed"`, string(mustJSONField(t, events[1].Data, "name")))
+	assert.Equal(t, int32(1), *events[2].ProductID)
+	assert.Nil(t, events[4].Data)
+
+	rest, err := repo.ListAfter(events[1].Position(), 100)
+	assert.NoError(t, err)
+	assert.Len(t, rest, 3)
+	assert.Equal(t, events[2].ID, rest[0].ID)
+}
+
+func TestChangeEventRepository_WaitsForRunningTransactions(t *testing.T) {
+	repo := SetupChangeEventTest(t)
+
+	running := TestDB.Begin()
+	assert.NoError(t, running.Create(&models.Customer{Name: "Slow", Email: "slow@ig.com"}).Error)
+	assert.NoError(t, TestDB.Create(&models.Customer{Name: "Fast", Email: "fast@ig.com"}).Error)
+
+	// The later transaction committed first, its change waits for the older one
+	events, err := repo.ListAfter(models.ChangeCursor{}, 100)
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	assert.NoError(t, running.Commit().Error)
+	events, err = repo.ListAfter(models.ChangeCursor{}, 100)
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.JSONEq(t, `"slow@ig.com"`, string(mustJSONField(t, events[0].Data, "email")))
+}
+
+func TestChangeEventRepository_Prune(t *testing.T) {
+	repo := SetupChangeEventTest(t)
+
+	for _, email := range []string{"a@ig.com", "b@ig.com", "c@ig.com"} {
+		assert.NoError(t, TestDB.Create(&models.Customer{Name: "Customer", Email: email}).Error)
+	}
+	events, err := repo.ListAfter(models.ChangeCursor{}, 100)
+	assert.NoError(t, err)
+	assert.NoError(t, TestDB.Model(&models.ChangeEvent{}).Where("id IN ?", []uint64{events[0].ID, events[1].ID}).
+		Update("changed_at", time.Now().Add(-48*time.Hour)).Error)
+
+	pruned, err := repo.Prune(time.Now().Add(-24 * time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), pruned)
+
+	through, err := repo.PrunedThrough()
+	assert.NoError(t, err)
+	assert.Equal(t, events[1].Position(), through)
+
+	// Nothing left to prune keeps the position
+	pruned, err = repo.Prune(time.Now().Add(-24 * time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), pruned)
+	through, err = repo.PrunedThrough()
+	assert.NoError(t, err)
+	assert.Equal(t, events[1].Position(), through)
+
+	left, err := repo.ListAfter(models.ChangeCursor{}, 100)
+	assert.NoError(t, err)
+	assert.Len(t, left, 1)
+	assert.Equal(t, events[2].ID, left[0].ID)
+}
+
+func mustJSONField(t *testing.T, data []byte, field string) []byte {
+	var fields map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal(data, &fields))
+	return fields[field]
+}
@@ -6,6 +6,7 @@ import (
 	"produtos-favoritos/src/domain/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type CustomerRepository struct {
@@ -16,13 +17,22 @@ func NewCustomerRepository(db *gorm.DB) interfaces.CustomerQuerier {
 	return &CustomerRepository{db: db}
 }
 
+// Create and Update leave the wishlist alone, it only changes through the
+// wishlist service where the rules are checked
 func (r *CustomerRepository) Create(customer *models.Customer) error {
-	return r.db.Create(customer).Error
+	return r.db.Omit(clause.Associations).Create(customer).Error
 }
 
 func (r *CustomerRepository) GetByID(id string) (*models.Customer, error) {
 	var customer models.Customer
-	if err := r.db.Preload("Wishlist").First(&customer, "id = ?", id).Error; err != nil {
+	// The wishlist follows the order set by the customer
+	inWishlistOrder := func(db *gorm.DB) *gorm.DB {
+		return db.Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  `(SELECT w.position FROM wishlists w WHERE w.customer_id = ? AND w.product_id = products.id) COLLATE "C" NULLS LAST`,
+			Vars: []interface{}{id},
+		}})
+	}
+	if err := r.db.Preload("Wishlist", inWishlistOrder).First(&customer, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			// No record found → return nil customer, nil error
 			return nil, nil
@@ -31,6 +41,13 @@ func (r *CustomerRepository) GetByID(id string) (*models.Customer, error) {
 	return &customer, nil
 }
 
+// Exists checks the customer without loading it, for the hot paths
+func (r *CustomerRepository) Exists(id string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Customer{}).Where("id = ?", id).Count(&count).Error
+	return count > 0, err
+}
+
 func (r *CustomerRepository) GetByEmail(email string) (*models.Customer, error) {
 	var customer models.Customer
 	if err := r.db.Preload("Wishlist").First(&customer, "email = ?", email).Error; err != nil {
@@ -43,7 +60,7 @@ func (r *CustomerRepository) GetByEmail(email string) (*models.Customer, error)
 }
 
 func (r *CustomerRepository) Update(customer *models.Customer) (*models.Customer, error) {
-	err := r.db.Save(customer).Error
+	err := r.db.Omit(clause.Associations).Save(customer).Error
 	return customer, err
 }
 
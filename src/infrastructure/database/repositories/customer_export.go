@@ -0,0 +1,95 @@
+package repositories
+
+import (
+	"fmt"
+	"time"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type CustomerExportRepository struct {
+	db *gorm.DB
+}
+
+func NewCustomerExportRepository(db *gorm.DB) interfaces.CustomerExportQuerier {
+	return &CustomerExportRepository{db: db}
+}
+
+// StreamCustomers reads every customer with its wishlist items through a
+// server-side cursor, handing the rows to fn a batch at a time so the memory
+// used doesn't grow with the table. The rows of a customer are contiguous,
+// in the wishlist order, and all of them come from the same snapshot.
+//
+// With updatedSince only the customers with a change in the change feed
+// after it are read: the customer updated, or wishlist items added, edited
+// or removed. The customers deleted after it get a row with DeletedAt.
+func (r *CustomerExportRepository) StreamCustomers(updatedSince *time.Time, batchSize int,
+	fn func(rows []models.CustomerExportRow) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SET TRANSACTION READ ONLY").Error; err != nil {
+			return err
+		}
+
+		where := ""
+		deleted := ""
+		var args []interface{}
+		if updatedSince != nil {
+			where = `WHERE c.id IN (SELECT ce.customer_id FROM change_events ce WHERE ce.changed_at > @since)`
+			// the created_at of a deleted customer comes from the last change
+			// with its data, the deletion time when it was pruned already
+			deleted = `
+				UNION ALL
+				SELECT ce.customer_id, '', '',
+					COALESCE((SELECT (cd.data->>'created_at')::timestamptz FROM change_events cd
+						WHERE cd.customer_id = ce.customer_id AND cd.entity = @customer AND cd.data IS NOT NULL
+						ORDER BY cd.tx_id DESC, cd.id DESC LIMIT 1), max(ce.changed_at)),
+					max(ce.changed_at), max(ce.changed_at),
+					NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL
+				FROM change_events ce
+				WHERE ce.entity = @customer AND ce.action = @deleted AND ce.changed_at > @since
+					AND NOT EXISTS (SELECT 1 FROM customers c WHERE c.id = ce.customer_id)
+				GROUP BY ce.customer_id`
+			args = append(args, map[string]interface{}{
+				"since":    *updatedSince,
+				"customer": models.ChangeEntityCustomer,
+				"deleted":  models.ChangeDeleted,
+			})
+		}
+		err := tx.Exec(`
+			DECLARE customer_export NO SCROLL CURSOR FOR
+			SELECT customer_id, name, email, created_at, updated_at, deleted_at,
+				product_id, title, category, status, quantity,
+				added_price_amount, added_price_currency, added_at
+			FROM (
+				SELECT c.id AS customer_id, c.name, c.email, c.created_at, c.updated_at,
+					NULL::timestamptz AS deleted_at,
+					w.product_id, p.title, p.category, w.status, w.quantity,
+					w.added_price_amount, w.added_price_currency, w.created_at AS added_at, w.position
+				FROM customers c
+				LEFT JOIN wishlists w ON w.customer_id = c.id
+				LEFT JOIN products p ON p.id = w.product_id
+				`+where+deleted+`
+			) export
+			ORDER BY customer_id, position COLLATE "C" NULLS LAST, added_at, product_id`, args...).Error
+		if err != nil {
+			return err
+		}
+
+		fetch := fmt.Sprintf("FETCH %d FROM customer_export", batchSize)
+		for {
+			var rows []models.CustomerExportRow
+			if err := tx.Raw(fetch).Scan(&rows).Error; err != nil {
+				return err
+			}
+			if len(rows) == 0 {
+				return nil
+			}
+			if err := fn(rows); err != nil {
+				return err
+			}
+		}
+	})
+}
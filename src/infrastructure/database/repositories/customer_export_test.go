@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupCustomerExportTest(t *testing.T) (queriers.CustomerExportQuerier, *models.Customer, *models.Customer) {
+	// the change feed triggers are only added by the migrations
+	resetSchema(t)
+
+	past := time.Now().Add(-48 * time.Hour)
+	withItems := &models.Customer{BaseModel: models.BaseModel{CreatedAt: past, UpdatedAt: past},
+		Name: "Ana", Email: "ana@ig.com"}
+	withoutItems := &models.Customer{BaseModel: models.BaseModel{CreatedAt: past, UpdatedAt: past},
+		Name: "Bruno", Email: "bruno@ig.com"}
+	assert.NoError(t, TestDB.Create(withItems).Error)
+	assert.NoError(t, TestDB.Create(withoutItems).Error)
+	assert.NoError(t, TestDB.Create(&[]models.Product{
+		{ID: 1, Title: "Produto 1", Category: "bags"}, {ID: 2, Title: "Produto 2"}, {ID: 3, Title: "Produto 3"},
+	}).Error)
+	assert.NoError(t, TestDB.Create(&[]models.WishlistItem{
+		{CustomerID: withItems.ID, ProductID: 1, Status: models.WishlistItemConfirmed, Position: "b", Quantity: 1,
+			AddedPrice: models.NewMoney(1500, "USD"), CreatedAt: past},
+		{CustomerID: withItems.ID, ProductID: 2, Status: models.WishlistItemConfirmed, Position: "a", Quantity: 2,
+			CreatedAt: past},
+		{CustomerID: withItems.ID, ProductID: 3, Status: models.WishlistItemConfirmed, Position: "c", Quantity: 1,
+			CreatedAt: past},
+	}).Error)
+	// the seeded rows were changed before any updated_since of the tests
+	assert.NoError(t, TestDB.Model(&models.ChangeEvent{}).Where("true").Update("changed_at", past).Error)
+
+	return NewCustomerExportRepository(TestDB), withItems, withoutItems
+}
+
+func collectExportRows(t *testing.T, repo queriers.CustomerExportQuerier, updatedSince *time.Time) ([]models.CustomerExportRow, int) {
+	var rows []models.CustomerExportRow
+	batches := 0
+	err := repo.StreamCustomers(updatedSince, 2, func(batch []models.CustomerExportRow) error {
+		assert.LessOrEqual(t, len(batch), 2)
+		rows = append(rows, batch...)
+		batches++
+		return nil
+	})
+	assert.NoError(t, err)
+	return rows, batches
+}
+
+func TestCustomerExportRepository_StreamCustomersInBatches(t *testing.T) {
+	repo, withItems, withoutItems := SetupCustomerExportTest(t)
+
+	rows, batches := collectExportRows(t, repo, nil)
+
+	assert.Len(t, rows, 4)
+	assert.Equal(t, 2, batches)
+	var products []int32
+	for _, row := range rows {
+		if row.CustomerID == withItems.ID {
+			products = append(products, *row.ProductID)
+		} else {
+			assert.Equal(t, withoutItems.ID, row.CustomerID)
+			assert.Nil(t, row.ProductID)
+		}
+	}
+	// The items are in the order set by the customer
+	assert.Equal(t, []int32{2, 1, 3}, products)
+
+	for _, row := range rows {
+		if row.ProductID != nil && *row.ProductID == 1 {
+			assert.Equal(t, "Produto 1", *row.Title)
+			assert.Equal(t, "bags", *row.Category)
+			assert.Equal(t, int64(1500), *row.AddedPriceAmount)
+			assert.Equal(t, "USD", *row.AddedPriceCurrency)
+		}
+	}
+}
+
+func TestCustomerExportRepository_StreamCustomersUpdatedSince(t *testing.T) {
+	repo, withItems, withoutItems := SetupCustomerExportTest(t)
+	since := time.Now().Add(-time.Hour)
+
+	rows, _ := collectExportRows(t, repo, &since)
+	assert.Empty(t, rows)
+
+	// An item removed for good after since brings the customer with the
+	// items left
+	assert.NoError(t, TestDB.Where("customer_id = ? AND product_id = ?", withItems.ID, 3).
+		Delete(&models.WishlistItem{}).Error)
+
+	rows, _ = collectExportRows(t, repo, &since)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, withItems.ID, rows[0].CustomerID)
+
+	// An item edit counts too
+	assert.NoError(t, TestDB.Model(&models.WishlistItem{}).
+		Where("customer_id = ? AND product_id = ?", withItems.ID, 1).Update("quantity", 3).Error)
+	assert.NoError(t, TestDB.Model(&models.ChangeEvent{}).Where("entity = ? AND action = ?",
+		models.ChangeEntityWishlistItem, models.ChangeDeleted).Update("changed_at", since.Add(-time.Hour)).Error)
+
+	rows, _ = collectExportRows(t, repo, &since)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, 3, *rows[1].Quantity)
+
+	// An updated customer
+	assert.NoError(t, TestDB.Model(withoutItems).Update("name", "Bruno Silva").Error)
+
+	rows, _ = collectExportRows(t, repo, &since)
+	assert.Len(t, rows, 3)
+}
+
+func TestCustomerExportRepository_StreamCustomersUpdatedSinceDeleted(t *testing.T) {
+	repo, _, withoutItems := SetupCustomerExportTest(t)
+	since := time.Now().Add(-time.Hour)
+
+	assert.NoError(t, TestDB.Exec("DELETE FROM customers WHERE id = ?", withoutItems.ID).Error)
+
+	rows, _ := collectExportRows(t, repo, &since)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, withoutItems.ID, rows[0].CustomerID)
+	assert.NotNil(t, rows[0].DeletedAt)
+	assert.Empty(t, rows[0].Email)
+	assert.Nil(t, rows[0].ProductID)
+	assert.WithinDuration(t, withoutItems.CreatedAt, rows[0].CreatedAt, time.Second)
+
+	// A full export leaves deleted customers out
+	rows, _ = collectExportRows(t, repo, nil)
+	assert.Len(t, rows, 3)
+	for _, row := range rows {
+		assert.Nil(t, row.DeletedAt)
+	}
+}
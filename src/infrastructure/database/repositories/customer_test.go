@@ -6,14 +6,15 @@ import (
 	queriers "produtos-favoritos/src/domain/interfaces/repositories"
 	"produtos-favoritos/src/domain/models"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
 func SetupCustomerTest(t *testing.T) queriers.CustomerQuerier {
-	err := TestDB.Migrator().DropTable(&models.Customer{}, &models.Product{})
+	err := TestDB.Migrator().DropTable(&models.WishlistItem{}, &models.Customer{}, &models.Product{})
 	assert.NoError(t, err)
 
-	err = TestDB.AutoMigrate(&models.Customer{}, &models.Product{})
+	err = TestDB.AutoMigrate(&models.Customer{}, &models.Product{}, &models.WishlistItem{})
 	assert.NoError(t, err)
 
 	return NewCustomerRepository(TestDB)
@@ -34,6 +35,13 @@ func TestCustomerRepository_CreateAndGetByID(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, c.Email, fetched.Email)
 	assert.Equal(t, c.Name, fetched.Name)
+
+	exists, err := repo.Exists(c.ID.String())
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = repo.Exists(uuid.New().String())
+	assert.NoError(t, err)
+	assert.False(t, exists)
 }
 
 func TestCustomerRepository_Update(t *testing.T) {
@@ -84,26 +92,52 @@ func TestCustomerRepository_RemoveProductFromWishlist(t *testing.T) {
 
 	product := &models.Product{ID: 1,
 		Title:       "Produto 1",
-		Price:       10.1,
+		Price:       models.NewMoney(1010, "USD"),
 		Description: "Produto Test",
 		Category:    "Cat 1",
 		Image:       ""}
-	customer := &models.Customer{
-		Name:     "Customer",
-		Email:    "customer@ig.com",
-		Wishlist: []*models.Product{product},
-	}
+	customer := &models.Customer{Name: "Customer", Email: "customer@ig.com"}
 
 	err := TestDB.Create(&product).Error
 	assert.NoError(t, err)
 
 	err = repo.Create(customer)
 	assert.NoError(t, err)
+	err = TestDB.Create(&models.WishlistItem{CustomerID: customer.ID, ProductID: product.ID,
+		Status: models.WishlistItemConfirmed, Quantity: 1}).Error
+	assert.NoError(t, err)
+
+	fetched, err := repo.GetByID(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Len(t, fetched.Wishlist, 1)
 
 	err = repo.RemoveProductFromWishlist(customer.ID.String(), product.ID)
 	assert.NoError(t, err)
 
-	fetched, err := repo.GetByID(customer.ID.String())
+	fetched, err = repo.GetByID(customer.ID.String())
 	assert.NoError(t, err)
 	assert.Len(t, fetched.Wishlist, 0)
 }
+
+func TestCustomerRepository_WishlistKeepsProductRating(t *testing.T) {
+	repo := SetupCustomerTest(t)
+
+	product := &models.Product{ID: 1,
+		Title:  "Produto 1",
+		Price:  models.NewMoney(1010, "USD"),
+		Rating: models.Rating{Rate: 3.9, Count: 120}}
+	customer := &models.Customer{Name: "Customer", Email: "rating@ig.com"}
+
+	assert.NoError(t, TestDB.Create(product).Error)
+	err := repo.Create(customer)
+	assert.NoError(t, err)
+	err = TestDB.Create(&models.WishlistItem{CustomerID: customer.ID, ProductID: product.ID,
+		Status: models.WishlistItemConfirmed, Quantity: 1}).Error
+	assert.NoError(t, err)
+
+	fetched, err := repo.GetByID(customer.ID.String())
+	assert.NoError(t, err)
+	if assert.Len(t, fetched.Wishlist, 1) {
+		assert.Equal(t, models.Rating{Rate: 3.9, Count: 120}, fetched.Wishlist[0].Rating)
+	}
+}
@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"errors"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ExchangeRateRepository struct {
+	db *gorm.DB
+}
+
+func NewExchangeRateRepository(db *gorm.DB) interfaces.ExchangeRateQuerier {
+	return &ExchangeRateRepository{db: db}
+}
+
+func (r *ExchangeRateRepository) List() ([]models.ExchangeRate, error) {
+	var rates []models.ExchangeRate
+	err := r.db.Order("currency").Find(&rates).Error
+	return rates, err
+}
+
+func (r *ExchangeRateRepository) Get(currency string) (*models.ExchangeRate, error) {
+	var rate models.ExchangeRate
+	if err := r.db.First(&rate, "currency = ?", currency).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &rate, nil
+}
+
+func (r *ExchangeRateRepository) Upsert(rate *models.ExchangeRate) error {
+	return r.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(rate).Error
+}
+
+// UpsertMany saves every rate or none of them
+func (r *ExchangeRateRepository) UpsertMany(rates []models.ExchangeRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&rates).Error
+	})
+}
+
+func (r *ExchangeRateRepository) Delete(currency string) error {
+	return r.db.Delete(&models.ExchangeRate{}, "currency = ?", currency).Error
+}
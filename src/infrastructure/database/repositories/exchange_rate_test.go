@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupExchangeRateTest(t *testing.T) queriers.ExchangeRateQuerier {
+	err := TestDB.Migrator().DropTable(&models.ExchangeRate{})
+	assert.NoError(t, err)
+
+	err = TestDB.AutoMigrate(&models.ExchangeRate{})
+	assert.NoError(t, err)
+
+	return NewExchangeRateRepository(TestDB)
+}
+
+func TestExchangeRateRepository_UpsertGetAndDelete(t *testing.T) {
+	repo := SetupExchangeRateTest(t)
+	rateDate := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	err := repo.UpsertMany([]models.ExchangeRate{
+		{Currency: "BRL", Rate: 5.4321, RateDate: rateDate},
+		{Currency: "EUR", Rate: 0.92, RateDate: rateDate},
+	})
+	assert.NoError(t, err)
+
+	err = repo.Upsert(&models.ExchangeRate{Currency: "BRL", Rate: 5.5, RateDate: rateDate})
+	assert.NoError(t, err)
+
+	rate, err := repo.Get("BRL")
+	assert.NoError(t, err)
+	assert.Equal(t, 5.5, rate.Rate)
+	assert.True(t, rateDate.Equal(rate.RateDate))
+
+	rates, err := repo.List()
+	assert.NoError(t, err)
+	assert.Len(t, rates, 2)
+
+	assert.NoError(t, repo.Delete("EUR"))
+	rate, err = repo.Get("EUR")
+	assert.NoError(t, err)
+	assert.Nil(t, rate)
+}
@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"time"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FavoriteCategoryRepository struct {
+	db *gorm.DB
+}
+
+func NewFavoriteCategoryRepository(db *gorm.DB) interfaces.FavoriteCategoryQuerier {
+	return &FavoriteCategoryRepository{db: db}
+}
+
+func (r *FavoriteCategoryRepository) List(customerID string) ([]models.FavoriteCategory, error) {
+	favorites := []models.FavoriteCategory{}
+	err := r.db.Where("customer_id = ?", customerID).
+		Order("category").
+		Find(&favorites).Error
+	if err != nil {
+		return nil, err
+	}
+	return favorites, nil
+}
+
+// Add follows the category, following it again keeps the original checkpoint
+func (r *FavoriteCategoryRepository) Add(favorite *models.FavoriteCategory) error {
+	err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(favorite).Error
+	if err != nil {
+		return err
+	}
+	return r.db.Where("customer_id = ? AND category = ?", favorite.CustomerID, favorite.Category).
+		Take(favorite).Error
+}
+
+func (r *FavoriteCategoryRepository) Delete(customerID string, category string) (bool, error) {
+	result := r.db.Where("customer_id = ? AND category = ?", customerID, category).
+		Delete(&models.FavoriteCategory{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListNewProducts reads the catalog changelog for the products that were
+// created, or came back, in each followed category after its checkpoint and
+// up to until
+func (r *FavoriteCategoryRepository) ListNewProducts(customerID string, until time.Time) ([]models.Product, error) {
+	products := []models.Product{}
+	err := r.db.Table("products p").
+		Select("p.*").
+		Joins("JOIN favorite_categories fc ON fc.category = p.category").
+		Where("fc.customer_id = ? AND NOT p.discontinued AND NOT p.placeholder", customerID).
+		Where(`EXISTS (
+			SELECT 1 FROM product_changes pc
+			WHERE pc.product_id = p.id
+			AND pc.type IN ?
+			AND pc.changed_at > fc.checked_at
+			AND pc.changed_at <= ?
+		)`, []string{models.ProductChangeCreated, models.ProductChangeRestored}, until).
+		Order("p.id").
+		Find(&products).Error
+	if err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// MarkChecked moves the checkpoints forward, never back
+func (r *FavoriteCategoryRepository) MarkChecked(customerID string, checkedAt time.Time) error {
+	return r.db.Model(&models.FavoriteCategory{}).
+		Where("customer_id = ? AND checked_at < ?", customerID, checkedAt).
+		Update("checked_at", checkedAt).Error
+}
@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupFavoriteCategoryTest(t *testing.T) (queriers.FavoriteCategoryQuerier, *models.Customer) {
+	err := TestDB.Migrator().DropTable(&models.FavoriteCategory{}, &models.ProductChange{},
+		&models.Customer{}, &models.Product{})
+	assert.NoError(t, err)
+
+	err = TestDB.AutoMigrate(&models.Customer{}, &models.Product{}, &models.ProductChange{}, &models.FavoriteCategory{})
+	assert.NoError(t, err)
+
+	customer := &models.Customer{Name: "Customer", Email: "categories@ig.com"}
+	assert.NoError(t, TestDB.Create(customer).Error)
+
+	return NewFavoriteCategoryRepository(TestDB), customer
+}
+
+func TestFavoriteCategoryRepository_AddKeepsCheckpoint(t *testing.T) {
+	repo, customer := SetupFavoriteCategoryTest(t)
+
+	followedAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	assert.NoError(t, repo.Add(&models.FavoriteCategory{CustomerID: customer.ID, Category: "jewelery", CheckedAt: followedAt}))
+
+	again := &models.FavoriteCategory{CustomerID: customer.ID, Category: "jewelery", CheckedAt: time.Now()}
+	assert.NoError(t, repo.Add(again))
+	assert.True(t, followedAt.Equal(again.CheckedAt))
+
+	favorites, err := repo.List(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Len(t, favorites, 1)
+
+	deleted, err := repo.Delete(customer.ID.String(), "jewelery")
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+}
+
+func TestFavoriteCategoryRepository_ListNewProducts(t *testing.T) {
+	repo, customer := SetupFavoriteCategoryTest(t)
+
+	followedAt := time.Now().Add(-time.Hour)
+	assert.NoError(t, repo.Add(&models.FavoriteCategory{CustomerID: customer.ID, Category: "jewelery", CheckedAt: followedAt}))
+	assert.NoError(t, TestDB.Create(&[]models.Product{
+		{ID: 1, Title: "Old ring", Category: "jewelery"},
+		{ID: 2, Title: "New ring", Category: "jewelery"},
+		{ID: 3, Title: "New laptop", Category: "electronics"},
+		{ID: 4, Title: "Gone ring", Category: "jewelery", Discontinued: true},
+	}).Error)
+	assert.NoError(t, TestDB.Create(&[]models.ProductChange{
+		{ProductID: 1, Type: models.ProductChangeCreated, ChangedAt: followedAt.Add(-time.Hour)},
+		{ProductID: 1, Type: models.ProductChangeUpdated, Field: "price", ChangedAt: followedAt.Add(time.Minute)},
+		{ProductID: 2, Type: models.ProductChangeCreated, ChangedAt: followedAt.Add(time.Minute)},
+		{ProductID: 3, Type: models.ProductChangeCreated, ChangedAt: followedAt.Add(time.Minute)},
+		{ProductID: 4, Type: models.ProductChangeCreated, ChangedAt: followedAt.Add(time.Minute)},
+	}).Error)
+
+	now := time.Now()
+	products, err := repo.ListNewProducts(customer.ID.String(), now)
+	assert.NoError(t, err)
+	assert.Len(t, products, 1)
+	assert.Equal(t, "New ring", products[0].Title)
+
+	assert.NoError(t, repo.MarkChecked(customer.ID.String(), now))
+	// An older cursor doesn't move the checkpoint back
+	assert.NoError(t, repo.MarkChecked(customer.ID.String(), followedAt))
+	products, err = repo.ListNewProducts(customer.ID.String(), time.Now())
+	assert.NoError(t, err)
+	assert.Empty(t, products)
+}
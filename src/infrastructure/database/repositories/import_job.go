@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ImportJobRepository struct {
+	db *gorm.DB
+}
+
+func NewImportJobRepository(db *gorm.DB) interfaces.ImportJobQuerier {
+	return &ImportJobRepository{db: db}
+}
+
+func (r *ImportJobRepository) Create(job *models.ImportJob) error {
+	return r.db.Create(job).Error
+}
+
+// GetByID returns the job without the file
+func (r *ImportJobRepository) GetByID(id string) (*models.ImportJob, error) {
+	var job models.ImportJob
+	err := r.db.Omit("file").Where("id = ?", id).Take(&job).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// ClaimNext uses SKIP LOCKED so instances polling at the same time claim
+// different jobs
+func (r *ImportJobRepository) ClaimNext(staleBefore time.Time) (*models.ImportJob, error) {
+	var jobs []models.ImportJob
+	err := r.db.Raw(`
+		UPDATE import_jobs
+		SET status = @running, claimed_by = @lease, started_at = COALESCE(started_at, @now), updated_at = @now
+		WHERE id = (
+			SELECT id FROM import_jobs
+			WHERE status = @pending OR (status = @running AND updated_at < @stale)
+			ORDER BY created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, map[string]interface{}{
+		"running": models.ImportJobRunning,
+		"lease":   uuid.New(),
+		"pending": models.ImportJobPending,
+		"now":     time.Now(),
+		"stale":   staleBefore,
+	}).Scan(&jobs).Error
+	if err != nil || len(jobs) == 0 {
+		return nil, err
+	}
+	return &jobs[0], nil
+}
+
+// SaveProgress stores the counters and status of the job along with the
+// errors of the rows processed since the previous save, the file is dropped
+// once the job completes. Only the worker holding the lease can save.
+func (r *ImportJobRepository) SaveProgress(job *models.ImportJob, jobErrors []models.ImportJobError) (bool, error) {
+	job.UpdatedAt = time.Now()
+	updates := map[string]interface{}{
+		"status":         job.Status,
+		"processed_rows": job.ProcessedRows,
+		"created_rows":   job.CreatedRows,
+		"failed_rows":    job.FailedRows,
+		"error_count":    job.ErrorCount,
+		"finished_at":    job.FinishedAt,
+		"updated_at":     job.UpdatedAt,
+	}
+	if job.Status == models.ImportJobCompleted {
+		updates["file"] = nil
+	}
+
+	saved := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.ImportJob{}).
+			Where("id = ? AND claimed_by = ?", job.ID, job.ClaimedBy).
+			Updates(updates)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		saved = true
+		if len(jobErrors) == 0 {
+			return nil
+		}
+		for i := range jobErrors {
+			jobErrors[i].JobID = job.ID
+		}
+		return tx.CreateInBatches(jobErrors, 500).Error
+	})
+	if err != nil {
+		return false, err
+	}
+	return saved, nil
+}
+
+func (r *ImportJobRepository) ListErrors(jobID string) ([]models.ImportJobError, error) {
+	var jobErrors []models.ImportJobError
+	err := r.db.Where("job_id = ?", jobID).Order("line, id").Find(&jobErrors).Error
+	return jobErrors, err
+}
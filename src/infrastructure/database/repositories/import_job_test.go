@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupImportJobTest(t *testing.T) queriers.ImportJobQuerier {
+	err := TestDB.Migrator().DropTable(&models.ImportJobError{}, &models.ImportJob{})
+	assert.NoError(t, err)
+
+	err = TestDB.AutoMigrate(&models.ImportJob{}, &models.ImportJobError{})
+	assert.NoError(t, err)
+
+	return NewImportJobRepository(TestDB)
+}
+
+func TestImportJobRepository_ClaimNext(t *testing.T) {
+	repo := SetupImportJobTest(t)
+
+	old := time.Now().Add(-time.Hour)
+	stale := &models.ImportJob{BaseModel: models.BaseModel{CreatedAt: old.Add(-time.Minute), UpdatedAt: old},
+		Type: models.ImportJobCustomers, Status: models.ImportJobRunning, ProcessedRows: 100, File: []byte("stale")}
+	running := &models.ImportJob{BaseModel: models.BaseModel{CreatedAt: old.Add(-2 * time.Minute)},
+		Type: models.ImportJobCustomers, Status: models.ImportJobRunning, File: []byte("running")}
+	pending := &models.ImportJob{Type: models.ImportJobCustomers, Status: models.ImportJobPending, File: []byte("pending")}
+	for _, job := range []*models.ImportJob{stale, running, pending} {
+		assert.NoError(t, repo.Create(job))
+	}
+
+	staleBefore := time.Now().Add(-5 * time.Minute)
+	// The running job is still making progress, the stale one is taken over first
+	claimed, err := repo.ClaimNext(staleBefore)
+	assert.NoError(t, err)
+	assert.Equal(t, stale.ID, claimed.ID)
+	assert.Equal(t, 100, claimed.ProcessedRows)
+	assert.Equal(t, []byte("stale"), claimed.File)
+	assert.NotNil(t, claimed.StartedAt)
+
+	claimed, err = repo.ClaimNext(staleBefore)
+	assert.NoError(t, err)
+	assert.Equal(t, pending.ID, claimed.ID)
+	assert.Equal(t, models.ImportJobRunning, claimed.Status)
+
+	claimed, err = repo.ClaimNext(staleBefore)
+	assert.NoError(t, err)
+	assert.Nil(t, claimed)
+}
+
+func TestImportJobRepository_SaveProgress(t *testing.T) {
+	repo := SetupImportJobTest(t)
+
+	assert.NoError(t, repo.Create(&models.ImportJob{Type: models.ImportJobCustomers, Status: models.ImportJobPending,
+		TotalRows: 4, File: []byte("name,email\n")}))
+	job, err := repo.ClaimNext(time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+
+	job.ProcessedRows, job.CreatedRows, job.FailedRows, job.ErrorCount = 2, 1, 1, 1
+	ok, err := repo.SaveProgress(job, []models.ImportJobError{{Line: 3, Email: "ana@ig.com", Error: "invalid"}})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	saved, err := repo.GetByID(job.ID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, saved.ProcessedRows)
+	assert.Equal(t, float64(50), saved.Progress)
+	assert.Nil(t, saved.File)
+
+	productID := int32(7)
+	now := time.Now()
+	job.Status, job.FinishedAt, job.ProcessedRows, job.CreatedRows, job.ErrorCount = models.ImportJobCompleted, &now, 4, 3, 2
+	ok, err = repo.SaveProgress(job, []models.ImportJobError{
+		{Line: 2, Email: "bruno@ig.com", ProductID: &productID, Error: "product not found"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	saved, err = repo.GetByID(job.ID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, models.ImportJobCompleted, saved.Status)
+	assert.Equal(t, float64(100), saved.Progress)
+	var files [][]byte
+	assert.NoError(t, TestDB.Model(&models.ImportJob{}).Where("id = ?", job.ID).Pluck("file", &files).Error)
+	assert.Equal(t, [][]byte{nil}, files)
+
+	jobErrors, err := repo.ListErrors(job.ID.String())
+	assert.NoError(t, err)
+	assert.Len(t, jobErrors, 2)
+	assert.Equal(t, 2, jobErrors[0].Line)
+	assert.Equal(t, productID, *jobErrors[0].ProductID)
+	assert.Equal(t, 3, jobErrors[1].Line)
+}
+
+func TestImportJobRepository_SaveProgressAfterTakeover(t *testing.T) {
+	repo := SetupImportJobTest(t)
+
+	assert.NoError(t, repo.Create(&models.ImportJob{Type: models.ImportJobCustomers, Status: models.ImportJobPending,
+		TotalRows: 4, File: []byte("name,email\n")}))
+	first, err := repo.ClaimNext(time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	// The first worker looks stale to the second one
+	second, err := repo.ClaimNext(time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, first.ID, second.ID)
+	assert.NotEqual(t, *first.ClaimedBy, *second.ClaimedBy)
+
+	first.ProcessedRows = 3
+	ok, err := repo.SaveProgress(first, []models.ImportJobError{{Line: 2, Error: "invalid"}})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	second.ProcessedRows = 1
+	ok, err = repo.SaveProgress(second, nil)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	saved, err := repo.GetByID(first.ID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, saved.ProcessedRows)
+	jobErrors, err := repo.ListErrors(first.ID.String())
+	assert.NoError(t, err)
+	assert.Empty(t, jobErrors)
+}
+
+func TestImportJobRepository_GetByIDNotFound(t *testing.T) {
+	repo := SetupImportJobTest(t)
+
+	job, err := repo.GetByID("7b6f1a57-6c2e-4a3e-9d1e-0f0c1d1e2f3a")
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+}
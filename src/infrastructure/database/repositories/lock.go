@@ -0,0 +1,42 @@
+package repositories
+
+import (
+	"log"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+
+	"gorm.io/gorm"
+)
+
+// LockRepository coordinates the instances of the api through postgres
+// session advisory locks.
+type LockRepository struct {
+	db *gorm.DB
+}
+
+func NewLockRepository(db *gorm.DB) interfaces.LockQuerier {
+	return &LockRepository{db: db}
+}
+
+func (r *LockRepository) TryWithLock(name string, fn func() error) (bool, error) {
+	ran := false
+	// advisory locks belong to the session, so lock and unlock have to use the same connection
+	err := r.db.Connection(func(conn *gorm.DB) error {
+		var locked bool
+		if err := conn.Raw("SELECT pg_try_advisory_lock(hashtext(?))", name).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+		defer func() {
+			if err := conn.Exec("SELECT pg_advisory_unlock(hashtext(?))", name).Error; err != nil {
+				log.Printf("failed to release lock %s: %v", name, err)
+			}
+		}()
+
+		ran = true
+		return fn()
+	})
+	return ran, err
+}
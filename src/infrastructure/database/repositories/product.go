@@ -0,0 +1,187 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// The search matches the full-text vector (english and portuguese stems) and,
+// to tolerate typos, trigram word similarity on title and category.
+const productSearchCTE = `
+	WITH search AS (
+		SELECT websearch_to_tsquery('english', @query) || websearch_to_tsquery('portuguese', @query) AS query,
+		       websearch_to_tsquery('english', @query) AS english_query
+	)`
+
+const productSearchMatch = `
+	NOT p.discontinued AND NOT p.placeholder AND (
+		p.search_vector @@ s.query
+		OR @query <% p.title
+		OR @query <% p.category
+	)`
+
+type ProductRepository struct {
+	db *gorm.DB
+}
+
+func NewProductRepository(db *gorm.DB) interfaces.ProductQuerier {
+	return &ProductRepository{db: db}
+}
+
+func (r *ProductRepository) GetByID(id int32) (*models.Product, error) {
+	var product models.Product
+	if err := r.db.First(&product, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &product, nil
+}
+
+// ListAll returns the products with catalog data, placeholders are left out
+func (r *ProductRepository) ListAll() ([]models.Product, error) {
+	var products []models.Product
+	err := r.db.Where("NOT placeholder").Order("id").Find(&products).Error
+	return products, err
+}
+
+// Upsert stores the latest known data of a product, replacing the local copy.
+// A product stored with its catalog data for the first time is logged as
+// created, whichever path brought it in.
+func (r *ProductRepository) Upsert(product *models.Product) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := logCreatedProducts(tx, []int32{product.ID}, time.Now()); err != nil {
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(product).Error
+	})
+}
+
+func (r *ProductRepository) AddPlaceholder(id int32) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.Product{ID: id, Placeholder: true}).Error
+}
+
+func (r *ProductRepository) UpsertMany(products []models.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		ids := make([]int32, len(products))
+		for i, product := range products {
+			ids[i] = product.ID
+		}
+		if err := logCreatedProducts(tx, ids, time.Now()); err != nil {
+			return err
+		}
+		return upsertProducts(tx, products)
+	})
+}
+
+func upsertProducts(tx *gorm.DB, products []models.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+	return tx.Clauses(clause.OnConflict{UpdateAll: true}).CreateInBatches(products, 500).Error
+}
+
+// logCreatedProducts records a created change for the ids not stored yet, or
+// stored only as placeholders. It runs before the upsert, in its transaction.
+func logCreatedProducts(tx *gorm.DB, ids []int32, now time.Time) error {
+	var known []int32
+	err := tx.Model(&models.Product{}).Where("id IN ? AND NOT placeholder", ids).Pluck("id", &known).Error
+	if err != nil {
+		return err
+	}
+	stored := make(map[int32]bool, len(known))
+	for _, id := range known {
+		stored[id] = true
+	}
+
+	var changes []models.ProductChange
+	for _, id := range ids {
+		if stored[id] {
+			continue
+		}
+		stored[id] = true
+		changes = append(changes, models.ProductChange{ProductID: id, Type: models.ProductChangeCreated, ChangedAt: now})
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return tx.CreateInBatches(changes, 500).Error
+}
+
+// SyncCatalog saves a full catalog pull together with the changes it
+// introduced, so the changelog never gets ahead or behind the products table.
+// The changes already hold the created products, nothing else is logged.
+func (r *ProductRepository) SyncCatalog(products []models.Product, removedIDs []int32, changes []models.ProductChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := upsertProducts(tx, products); err != nil {
+			return err
+		}
+		if len(removedIDs) > 0 {
+			err := tx.Model(&models.Product{}).Where("id IN ?", removedIDs).Update("discontinued", true).Error
+			if err != nil {
+				return err
+			}
+		}
+		if len(changes) > 0 {
+			return tx.CreateInBatches(changes, 500).Error
+		}
+		return nil
+	})
+}
+
+// Search ranks the mirrored products matching the query. Facets are counted
+// over every match, ignoring the category filter and the pagination.
+func (r *ProductRepository) Search(search models.ProductSearch) ([]models.ProductSearchResult, []models.CategoryFacet, error) {
+	categoryFilter := ""
+	if search.Category != "" {
+		categoryFilter = " AND p.category = @category"
+	}
+
+	var results []models.ProductSearchResult
+	err := r.db.Raw(productSearchCTE+`
+		SELECT p.*,
+		       ts_rank_cd(p.search_vector, s.query) + word_similarity(@query, p.title) AS rank,
+		       ts_headline('english', p.title, s.english_query,
+		                   'StartSel=<mark>, StopSel=</mark>, HighlightAll=true') AS title_highlight,
+		       ts_headline('english', p.description, s.english_query,
+		                   'StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15') AS snippet
+		FROM products p, search s
+		WHERE`+productSearchMatch+categoryFilter+`
+		ORDER BY rank DESC, p.id
+		LIMIT @limit OFFSET @offset`,
+		sql.Named("query", search.Query),
+		sql.Named("category", search.Category),
+		sql.Named("limit", search.Limit),
+		sql.Named("offset", search.Offset),
+	).Scan(&results).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var facets []models.CategoryFacet
+	err = r.db.Raw(productSearchCTE+`
+		SELECT p.category, count(*) AS count
+		FROM products p, search s
+		WHERE`+productSearchMatch+`
+		GROUP BY p.category
+		ORDER BY count DESC, p.category`,
+		sql.Named("query", search.Query),
+	).Scan(&facets).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return results, facets, nil
+}
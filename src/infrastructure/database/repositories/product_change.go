@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"time"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type ProductChangeRepository struct {
+	db *gorm.DB
+}
+
+func NewProductChangeRepository(db *gorm.DB) interfaces.ProductChangeQuerier {
+	return &ProductChangeRepository{db: db}
+}
+
+func (r *ProductChangeRepository) ListSince(since time.Time, limit int, offset int) ([]models.ProductChange, error) {
+	var changes []models.ProductChange
+	err := r.db.Where("changed_at > ?", since).
+		Order("changed_at, id").
+		Limit(limit).
+		Offset(offset).
+		Find(&changes).Error
+	return changes, err
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductRepository_SyncCatalogAndListChanges(t *testing.T) {
+	repo := SetupProductTest(t)
+	changeRepo := NewProductChangeRepository(TestDB)
+
+	// Stored directly so only the changes of the sync are logged
+	_ = TestDB.Create(&[]models.Product{{ID: 1, Title: "Produto 1"}, {ID: 2, Title: "Produto 2"}}).Error
+
+	before := time.Now().Add(-time.Minute)
+	err := repo.SyncCatalog(
+		[]models.Product{{ID: 1, Title: "Produto 1 renomeado"}},
+		[]int32{2},
+		[]models.ProductChange{
+			{ProductID: 1, Type: models.ProductChangeUpdated, Field: "title", OldValue: "Produto 1", NewValue: "Produto 1 renomeado", ChangedAt: time.Now()},
+			{ProductID: 2, Type: models.ProductChangeRemoved, ChangedAt: time.Now()},
+		})
+	assert.NoError(t, err)
+
+	removed, _ := repo.GetByID(2)
+	assert.True(t, removed.Discontinued)
+
+	changes, err := changeRepo.ListSince(before, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, "title", changes[0].Field)
+
+	changes, err = changeRepo.ListSince(time.Now().Add(time.Minute), 10, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestLockRepository_TryWithLock(t *testing.T) {
+	repo := NewLockRepository(TestDB)
+
+	ran, err := repo.TryWithLock("test-lock", func() error {
+		// the lock is taken while fn runs
+		nested, err := repo.TryWithLock("test-lock", func() error { return nil })
+		assert.NoError(t, err)
+		assert.False(t, nested)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, ran)
+
+	ran, err = repo.TryWithLock("test-lock", func() error { return nil })
+	assert.NoError(t, err)
+	assert.True(t, ran)
+}
@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"testing"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupProductTest(t *testing.T) queriers.ProductQuerier {
+	// the search columns and indexes are only added by the migrations
+	resetSchema(t)
+
+	return NewProductRepository(TestDB)
+}
+
+func TestProductRepository_UpsertAndGetByID(t *testing.T) {
+	repo := SetupProductTest(t)
+
+	product := &models.Product{ID: 1, Title: "Produto 1", Price: models.NewMoney(1010, "USD")}
+	err := repo.Upsert(product)
+	assert.NoError(t, err)
+
+	product.Title = "Produto 1 renomeado"
+	product.Rating = models.Rating{Rate: 4.1, Count: 10}
+	err = repo.Upsert(product)
+	assert.NoError(t, err)
+
+	fetched, err := repo.GetByID(1)
+	assert.NoError(t, err)
+	assert.Equal(t, "Produto 1 renomeado", fetched.Title)
+	assert.Equal(t, models.Rating{Rate: 4.1, Count: 10}, fetched.Rating)
+}
+
+func TestProductRepository_GetByID_NotFound(t *testing.T) {
+	repo := SetupProductTest(t)
+
+	fetched, err := repo.GetByID(99)
+	assert.NoError(t, err)
+	assert.Nil(t, fetched)
+}
+
+func TestProductRepository_AddPlaceholder(t *testing.T) {
+	repo := SetupProductTest(t)
+
+	assert.NoError(t, repo.Upsert(&models.Product{ID: 1, Title: "Produto 1", Price: models.NewMoney(1010, "USD")}))
+	// A known product is never replaced by a placeholder
+	assert.NoError(t, repo.AddPlaceholder(1))
+	assert.NoError(t, repo.AddPlaceholder(2))
+
+	fetched, err := repo.GetByID(1)
+	assert.NoError(t, err)
+	assert.Equal(t, "Produto 1", fetched.Title)
+	assert.False(t, fetched.Placeholder)
+
+	fetched, err = repo.GetByID(2)
+	assert.NoError(t, err)
+	assert.True(t, fetched.Placeholder)
+
+	products, err := repo.ListAll()
+	assert.NoError(t, err)
+	assert.Len(t, products, 1)
+	assert.Equal(t, int32(1), products[0].ID)
+
+	assert.NoError(t, repo.Upsert(&models.Product{ID: 2, Title: "Produto 2", Price: models.NewMoney(500, "USD")}))
+	fetched, err = repo.GetByID(2)
+	assert.NoError(t, err)
+	assert.False(t, fetched.Placeholder)
+}
+
+func TestProductRepository_UpsertLogsCreatedProducts(t *testing.T) {
+	repo := SetupProductTest(t)
+
+	assert.NoError(t, repo.AddPlaceholder(1))
+	assert.NoError(t, repo.Upsert(&models.Product{ID: 1, Title: "Produto 1"}))
+	assert.NoError(t, repo.Upsert(&models.Product{ID: 1, Title: "Produto 1 renomeado"}))
+	assert.NoError(t, repo.UpsertMany([]models.Product{{ID: 1, Title: "Produto 1"}, {ID: 2, Title: "Produto 2"}}))
+
+	var changes []models.ProductChange
+	assert.NoError(t, TestDB.Order("product_id").Find(&changes).Error)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, int32(1), changes[0].ProductID)
+	assert.Equal(t, models.ProductChangeCreated, changes[0].Type)
+	assert.Equal(t, int32(2), changes[1].ProductID)
+}
+
+func TestProductRepository_Search(t *testing.T) {
+	repo := SetupProductTest(t)
+
+	err := repo.UpsertMany([]models.Product{
+		{ID: 1, Title: "Mens Casual Jacket", Category: "men's clothing", Description: "Warm cotton jacket for winter"},
+		{ID: 2, Title: "Womens Rain Jacket", Category: "women's clothing", Description: "Lightweight jacket"},
+		{ID: 3, Title: "Gold Ring", Category: "jewelery", Description: "Classic ring"},
+		{ID: 4, Title: "Old Jacket", Category: "men's clothing", Description: "Jacket", Discontinued: true},
+	})
+	assert.NoError(t, err)
+
+	results, facets, err := repo.Search(models.ProductSearch{Query: "jackets", Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Contains(t, results[0].TitleHighlight, "<mark>")
+	assert.ElementsMatch(t, []models.CategoryFacet{
+		{Category: "men's clothing", Count: 1},
+		{Category: "women's clothing", Count: 1},
+	}, facets)
+
+	results, facets, err = repo.Search(models.ProductSearch{Query: "jaket", Category: "men's clothing", Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, int32(1), results[0].ID)
+	assert.Len(t, facets, 2)
+}
@@ -0,0 +1,112 @@
+package repositories
+
+import (
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProductViewRepository struct {
+	db *gorm.DB
+}
+
+func NewProductViewRepository(db *gorm.DB) interfaces.ProductViewQuerier {
+	return &ProductViewRepository{db: db}
+}
+
+// RecordViews writes a batch of views and trims the histories of the
+// customers in it to the max. The batch is written after the requests were
+// answered, views of unknown customers or of products missing from the
+// catalog mirror are dropped instead of failing the whole batch, their number
+// is returned.
+func (r *ProductViewRepository) RecordViews(views []models.ProductView, maxPerCustomer int) (int, error) {
+	if len(views) == 0 {
+		return 0, nil
+	}
+
+	dropped := 0
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		customerIDs := make([]uuid.UUID, 0, len(views))
+		productIDs := make([]int32, 0, len(views))
+		for _, view := range views {
+			customerIDs = append(customerIDs, view.CustomerID)
+			productIDs = append(productIDs, view.ProductID)
+		}
+
+		var customers []uuid.UUID
+		if err := tx.Model(&models.Customer{}).Where("id IN ?", customerIDs).Pluck("id", &customers).Error; err != nil {
+			return err
+		}
+		var products []int32
+		if err := tx.Model(&models.Product{}).Where("id IN ?", productIDs).Pluck("id", &products).Error; err != nil {
+			return err
+		}
+		knownCustomers := make(map[uuid.UUID]bool, len(customers))
+		for _, id := range customers {
+			knownCustomers[id] = true
+		}
+		knownProducts := make(map[int32]bool, len(products))
+		for _, id := range products {
+			knownProducts[id] = true
+		}
+
+		valid := make([]models.ProductView, 0, len(views))
+		for _, view := range views {
+			if knownCustomers[view.CustomerID] && knownProducts[view.ProductID] {
+				valid = append(valid, models.ProductView{
+					CustomerID: view.CustomerID,
+					ProductID:  view.ProductID,
+					ViewedAt:   view.ViewedAt,
+				})
+			}
+		}
+		dropped = len(views) - len(valid)
+		if len(valid) == 0 {
+			return nil
+		}
+
+		// A view older than the stored one (a late batch) doesn't move the product back
+		err := tx.Omit("Product").Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "customer_id"}, {Name: "product_id"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"viewed_at": gorm.Expr("GREATEST(product_views.viewed_at, excluded.viewed_at)"),
+			}),
+		}).Create(&valid).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Exec(`
+			DELETE FROM product_views pv
+			USING (
+				SELECT customer_id, product_id,
+					ROW_NUMBER() OVER (PARTITION BY customer_id ORDER BY viewed_at DESC, product_id) AS position
+				FROM product_views
+				WHERE customer_id IN ?
+			) ranked
+			WHERE pv.customer_id = ranked.customer_id
+			AND pv.product_id = ranked.product_id
+			AND ranked.position > ?`, customers, maxPerCustomer).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return dropped, nil
+}
+
+// ListByCustomer returns the most recent views first with their product
+func (r *ProductViewRepository) ListByCustomer(customerID string, limit int) ([]models.ProductView, error) {
+	views := []models.ProductView{}
+	err := r.db.Preload("Product").
+		Where("customer_id = ?", customerID).
+		Order("viewed_at DESC, product_id").
+		Limit(limit).
+		Find(&views).Error
+	if err != nil {
+		return nil, err
+	}
+	return views, nil
+}
@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupProductViewTest(t *testing.T) (queriers.ProductViewQuerier, *models.Customer) {
+	err := TestDB.Migrator().DropTable(&models.ProductView{}, &models.Customer{}, &models.Product{})
+	assert.NoError(t, err)
+
+	err = TestDB.AutoMigrate(&models.Customer{}, &models.Product{}, &models.ProductView{})
+	assert.NoError(t, err)
+
+	customer := &models.Customer{Name: "Customer", Email: "views@ig.com"}
+	assert.NoError(t, TestDB.Create(customer).Error)
+	assert.NoError(t, TestDB.Create(&[]models.Product{
+		{ID: 1, Title: "Produto 1"}, {ID: 2, Title: "Produto 2"}, {ID: 3, Title: "Produto 3"},
+	}).Error)
+
+	return NewProductViewRepository(TestDB), customer
+}
+
+func TestProductViewRepository_RecordViewsKeepsTheLatest(t *testing.T) {
+	repo, customer := SetupProductViewTest(t)
+
+	now := time.Now()
+	dropped, err := repo.RecordViews([]models.ProductView{
+		{CustomerID: customer.ID, ProductID: 1, ViewedAt: now.Add(-3 * time.Minute)},
+		{CustomerID: customer.ID, ProductID: 2, ViewedAt: now.Add(-2 * time.Minute)},
+		{CustomerID: uuid.New(), ProductID: 1, ViewedAt: now},
+		{CustomerID: customer.ID, ProductID: 99, ViewedAt: now},
+	}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, dropped)
+
+	// Product 1 viewed again moves to the top, a late older view doesn't
+	_, err = repo.RecordViews([]models.ProductView{
+		{CustomerID: customer.ID, ProductID: 1, ViewedAt: now.Add(-time.Minute)},
+		{CustomerID: customer.ID, ProductID: 2, ViewedAt: now.Add(-time.Hour)},
+	}, 2)
+	assert.NoError(t, err)
+
+	views, err := repo.ListByCustomer(customer.ID.String(), 10)
+	assert.NoError(t, err)
+	assert.Len(t, views, 2)
+	assert.Equal(t, int32(1), views[0].ProductID)
+	assert.Equal(t, "Produto 1", views[0].Product.Title)
+	assert.Equal(t, int32(2), views[1].ProductID)
+
+	// The oldest view is trimmed past the max
+	_, err = repo.RecordViews([]models.ProductView{{CustomerID: customer.ID, ProductID: 3, ViewedAt: now}}, 2)
+	assert.NoError(t, err)
+
+	views, err = repo.ListByCustomer(customer.ID.String(), 10)
+	assert.NoError(t, err)
+	assert.Len(t, views, 2)
+	assert.Equal(t, int32(3), views[0].ProductID)
+	assert.Equal(t, int32(1), views[1].ProductID)
+}
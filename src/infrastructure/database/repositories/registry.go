@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RegistryRepository struct {
+	db *gorm.DB
+}
+
+func NewRegistryRepository(db *gorm.DB) interfaces.RegistryQuerier {
+	return &RegistryRepository{db: db}
+}
+
+func (r *RegistryRepository) GetByCustomer(customerID string) (*models.WishlistRegistry, error) {
+	return r.first("customer_id = ?", customerID)
+}
+
+func (r *RegistryRepository) GetByToken(token string) (*models.WishlistRegistry, error) {
+	return r.first("token = ?", token)
+}
+
+func (r *RegistryRepository) first(query string, args ...interface{}) (*models.WishlistRegistry, error) {
+	var registry models.WishlistRegistry
+	if err := r.db.Where(query, args...).First(&registry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &registry, nil
+}
+
+func (r *RegistryRepository) Save(registry *models.WishlistRegistry) error {
+	return r.db.Save(registry).Error
+}
+
+// Delete turns the registry off, dropping the reservations made on it
+func (r *RegistryRepository) Delete(customerID string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("customer_id = ?", customerID).Delete(&models.RegistryReservation{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("customer_id = ?", customerID).Delete(&models.WishlistRegistry{}).Error
+	})
+}
+
+// ReservedQuantities sums the active reservations by product
+func (r *RegistryRepository) ReservedQuantities(customerID string, now time.Time) (map[int32]int, error) {
+	var rows []struct {
+		ProductID int32
+		Reserved  int
+	}
+	err := activeReservations(r.db.Model(&models.RegistryReservation{}), now).
+		Select("product_id, SUM(quantity) AS reserved").
+		Where("customer_id = ?", customerID).
+		Group("product_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	reserved := make(map[int32]int, len(rows))
+	for _, row := range rows {
+		reserved[row.ProductID] = row.Reserved
+	}
+	return reserved, nil
+}
+
+// Reserve creates the reservation when the item still has the quantity
+// available. The wishlist item row is locked so concurrent guests can't
+// reserve the same units, false is returned when there are not enough left.
+func (r *RegistryRepository) Reserve(reservation *models.RegistryReservation, now time.Time) (bool, error) {
+	reserved := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var item models.WishlistItem
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("customer_id = ? AND product_id = ?", reservation.CustomerID, reservation.ProductID).
+			First(&item).Error
+		if err != nil {
+			return err
+		}
+
+		var taken int
+		err = activeReservations(tx.Model(&models.RegistryReservation{}), now).
+			Select("COALESCE(SUM(quantity), 0)").
+			Where("customer_id = ? AND product_id = ?", reservation.CustomerID, reservation.ProductID).
+			Scan(&taken).Error
+		if err != nil {
+			return err
+		}
+		if taken+reservation.Quantity > item.Quantity {
+			return nil
+		}
+
+		reserved = true
+		return tx.Create(reservation).Error
+	})
+	return reserved, err
+}
+
+func (r *RegistryRepository) GetReservation(customerID string, reservationID string) (*models.RegistryReservation, error) {
+	var reservation models.RegistryReservation
+	err := r.db.First(&reservation, "customer_id = ? AND id = ?", customerID, reservationID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+func (r *RegistryRepository) SaveReservation(reservation *models.RegistryReservation) error {
+	return r.db.Save(reservation).Error
+}
+
+func (r *RegistryRepository) DeleteReservation(reservationID string) error {
+	return r.db.Delete(&models.RegistryReservation{}, "id = ?", reservationID).Error
+}
+
+// DeleteExpiredReservations drops the pending reservations past their expiry
+func (r *RegistryRepository) DeleteExpiredReservations(now time.Time) (int64, error) {
+	result := r.db.Where("status = ? AND expires_at <= ?", models.ReservationPending, now).
+		Delete(&models.RegistryReservation{})
+	return result.RowsAffected, result.Error
+}
+
+func activeReservations(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Where("(status = ? OR expires_at > ?)", models.ReservationConfirmed, now)
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupRegistryTest(t *testing.T) (queriers.RegistryQuerier, *models.Customer) {
+	err := TestDB.Migrator().DropTable(&models.RegistryReservation{}, &models.WishlistRegistry{},
+		&models.WishlistItem{}, &models.Customer{}, &models.Product{})
+	assert.NoError(t, err)
+
+	err = TestDB.AutoMigrate(&models.Customer{}, &models.Product{}, &models.WishlistItem{},
+		&models.WishlistRegistry{}, &models.RegistryReservation{})
+	assert.NoError(t, err)
+
+	customer := &models.Customer{Name: "Customer", Email: "registry@ig.com"}
+	assert.NoError(t, TestDB.Create(customer).Error)
+	assert.NoError(t, TestDB.Create(&models.Product{ID: 1, Title: "Produto 1"}).Error)
+	assert.NoError(t, TestDB.Create(&models.WishlistItem{
+		CustomerID: customer.ID,
+		ProductID:  1,
+		Status:     models.WishlistItemConfirmed,
+		Quantity:   3,
+	}).Error)
+
+	return NewRegistryRepository(TestDB), customer
+}
+
+func TestRegistryRepository_SaveAndGet(t *testing.T) {
+	repo, customer := SetupRegistryTest(t)
+
+	err := repo.Save(&models.WishlistRegistry{CustomerID: customer.ID, Token: "token", Title: "Wedding"})
+	assert.NoError(t, err)
+
+	byToken, err := repo.GetByToken("token")
+	assert.NoError(t, err)
+	assert.Equal(t, customer.ID, byToken.CustomerID)
+
+	missing, err := repo.GetByCustomer("00000000-0000-0000-0000-000000000000")
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestRegistryRepository_ReserveUpToQuantity(t *testing.T) {
+	repo, customer := SetupRegistryTest(t)
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	expiredAt := now.Add(-time.Hour)
+
+	// An expired reservation doesn't hold its units
+	assert.NoError(t, TestDB.Create(&models.RegistryReservation{
+		CustomerID: customer.ID, ProductID: 1, Quantity: 3,
+		Status: models.ReservationPending, ExpiresAt: &expiredAt,
+	}).Error)
+
+	reserved, err := repo.Reserve(&models.RegistryReservation{
+		CustomerID: customer.ID, ProductID: 1, Quantity: 2,
+		Status: models.ReservationPending, ExpiresAt: &expiresAt,
+	}, now)
+	assert.NoError(t, err)
+	assert.True(t, reserved)
+
+	reserved, err = repo.Reserve(&models.RegistryReservation{
+		CustomerID: customer.ID, ProductID: 1, Quantity: 2,
+		Status: models.ReservationPending, ExpiresAt: &expiresAt,
+	}, now)
+	assert.NoError(t, err)
+	assert.False(t, reserved)
+
+	quantities, err := repo.ReservedQuantities(customer.ID.String(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, quantities[1])
+
+	deleted, err := repo.DeleteExpiredReservations(now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"time"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReminderRepository struct {
+	db *gorm.DB
+}
+
+func NewReminderRepository(db *gorm.DB) interfaces.ReminderQuerier {
+	return &ReminderRepository{db: db}
+}
+
+// CreateStaleReminders inserts the reminders straight from the wishlists, the
+// unique index keeps a concurrent run from reminding twice.
+func (r *ReminderRepository) CreateStaleReminders(addedBefore time.Time, limit int) (int64, error) {
+	now := time.Now()
+	result := r.db.Exec(`
+		INSERT INTO wishlist_reminders (customer_id, product_id, item_added_at, kind, status, created_at, updated_at)
+		SELECT w.customer_id, w.product_id, w.created_at, ?, ?, ?, ?
+		FROM wishlists w
+		WHERE w.status = ?
+		AND w.created_at <= ?
+		AND NOT EXISTS (
+			SELECT 1 FROM wishlist_reminders wr
+			WHERE wr.customer_id = w.customer_id
+			AND wr.product_id = w.product_id
+			AND wr.item_added_at = w.created_at
+			AND wr.kind = ?
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM reminder_preferences rp
+			WHERE rp.customer_id = w.customer_id
+			AND rp.stale_items_opt_out
+		)
+		ORDER BY w.created_at
+		LIMIT ?
+		ON CONFLICT DO NOTHING`,
+		models.ReminderStaleItem, models.ReminderPending, now, now,
+		models.WishlistItemConfirmed, addedBefore, models.ReminderStaleItem, limit)
+	return result.RowsAffected, result.Error
+}
+
+// ListByCustomer returns the reminder history, newest first
+func (r *ReminderRepository) ListByCustomer(customerID string) ([]models.WishlistReminder, error) {
+	reminders := []models.WishlistReminder{}
+	err := r.db.Preload("Product").
+		Where("customer_id = ?", customerID).
+		Order("created_at DESC").
+		Find(&reminders).Error
+	if err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+// ListPending returns the reminders waiting for delivery, oldest first. The
+// reminders of customers who opted out after they were created are left out.
+func (r *ReminderRepository) ListPending(limit int) ([]models.WishlistReminder, error) {
+	reminders := []models.WishlistReminder{}
+	err := r.db.Preload("Product").
+		Where("status = ?", models.ReminderPending).
+		Where(`NOT (kind = ? AND EXISTS (
+			SELECT 1 FROM reminder_preferences rp
+			WHERE rp.customer_id = wishlist_reminders.customer_id
+			AND rp.stale_items_opt_out
+		))`, models.ReminderStaleItem).
+		Order("created_at").
+		Limit(limit).
+		Find(&reminders).Error
+	if err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+// MarkDelivered flags the reminder as delivered, false when there is no
+// pending reminder with the id
+func (r *ReminderRepository) MarkDelivered(reminderID string, deliveredAt time.Time) (bool, error) {
+	result := r.db.Model(&models.WishlistReminder{}).
+		Where("id = ? AND status = ?", reminderID, models.ReminderPending).
+		Updates(map[string]any{
+			"status":       models.ReminderDelivered,
+			"delivered_at": deliveredAt,
+			"updated_at":   time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *ReminderRepository) GetPreference(customerID string) (*models.ReminderPreference, error) {
+	var preference models.ReminderPreference
+	result := r.db.Where("customer_id = ?", customerID).Limit(1).Find(&preference)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &preference, nil
+}
+
+func (r *ReminderRepository) SavePreference(preference *models.ReminderPreference) error {
+	return r.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(preference).Error
+}
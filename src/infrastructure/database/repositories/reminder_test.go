@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupReminderTest(t *testing.T) (queriers.ReminderQuerier, *models.Customer, *models.Customer) {
+	err := TestDB.Migrator().DropTable(&models.WishlistReminder{}, &models.ReminderPreference{},
+		&models.WishlistItem{}, &models.Customer{}, &models.Product{})
+	assert.NoError(t, err)
+
+	err = TestDB.AutoMigrate(&models.Customer{}, &models.Product{}, &models.WishlistItem{},
+		&models.WishlistReminder{}, &models.ReminderPreference{})
+	assert.NoError(t, err)
+
+	customer := &models.Customer{Name: "Customer", Email: "reminders@ig.com"}
+	optedOut := &models.Customer{Name: "Opted Out", Email: "optout@ig.com"}
+	assert.NoError(t, TestDB.Create(customer).Error)
+	assert.NoError(t, TestDB.Create(optedOut).Error)
+	assert.NoError(t, TestDB.Create(&[]models.Product{{ID: 1, Title: "Produto 1"}, {ID: 2, Title: "Produto 2"}}).Error)
+
+	return NewReminderRepository(TestDB), customer, optedOut
+}
+
+func TestReminderRepository_CreateStaleReminders(t *testing.T) {
+	repo, customer, optedOut := SetupReminderTest(t)
+	wishlist := NewWishlistRepository(TestDB)
+
+	old := time.Now().Add(-60 * 24 * time.Hour)
+	_ = wishlist.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1, Status: models.WishlistItemConfirmed, CreatedAt: old})
+	_ = wishlist.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 2, Status: models.WishlistItemConfirmed})
+	_ = wishlist.Add(&models.WishlistItem{CustomerID: optedOut.ID, ProductID: 1, Status: models.WishlistItemConfirmed, CreatedAt: old})
+	assert.NoError(t, repo.SavePreference(&models.ReminderPreference{CustomerID: optedOut.ID, StaleItemsOptOut: true}))
+
+	addedBefore := time.Now().Add(-30 * 24 * time.Hour)
+	created, err := repo.CreateStaleReminders(addedBefore, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), created)
+
+	// Items are reminded once
+	created, err = repo.CreateStaleReminders(addedBefore, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), created)
+
+	history, err := repo.ListByCustomer(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Len(t, history, 1)
+	assert.Equal(t, int32(1), history[0].ProductID)
+	assert.Equal(t, "Produto 1", history[0].Product.Title)
+
+	optedOutHistory, _ := repo.ListByCustomer(optedOut.ID.String())
+	assert.Empty(t, optedOutHistory)
+}
+
+func TestReminderRepository_MarkDelivered(t *testing.T) {
+	repo, customer, _ := SetupReminderTest(t)
+	wishlist := NewWishlistRepository(TestDB)
+
+	_ = wishlist.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1, Status: models.WishlistItemConfirmed,
+		CreatedAt: time.Now().Add(-time.Hour)})
+	_, _ = repo.CreateStaleReminders(time.Now(), 100)
+
+	pending, err := repo.ListPending(10)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+
+	marked, err := repo.MarkDelivered(pending[0].ID.String(), time.Now())
+	assert.NoError(t, err)
+	assert.True(t, marked)
+	marked, err = repo.MarkDelivered(pending[0].ID.String(), time.Now())
+	assert.NoError(t, err)
+	assert.False(t, marked)
+
+	pending, _ = repo.ListPending(10)
+	assert.Empty(t, pending)
+}
+
+func TestReminderRepository_ListPending_SkipsOptedOut(t *testing.T) {
+	repo, customer, _ := SetupReminderTest(t)
+	wishlist := NewWishlistRepository(TestDB)
+
+	_ = wishlist.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1, Status: models.WishlistItemConfirmed,
+		CreatedAt: time.Now().Add(-time.Hour)})
+	_, _ = repo.CreateStaleReminders(time.Now(), 100)
+
+	assert.NoError(t, repo.SavePreference(&models.ReminderPreference{CustomerID: customer.ID, StaleItemsOptOut: true}))
+	pending, err := repo.ListPending(10)
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+
+	assert.NoError(t, repo.SavePreference(&models.ReminderPreference{CustomerID: customer.ID, StaleItemsOptOut: false}))
+	pending, err = repo.ListPending(10)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+}
@@ -8,6 +8,7 @@ import (
 	"produtos-favoritos/src/infrastructure/database/migrations"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
 
@@ -74,3 +75,12 @@ func TestMain(m *testing.M) {
 
 	os.Exit(code)
 }
+
+// resetSchema drops every table and runs the migrations again, for the tests
+// needing what the migrations add on top of the models, like columns or
+// triggers written in sql
+func resetSchema(t *testing.T) {
+	assert.NoError(t, TestDB.Exec("DROP SCHEMA public CASCADE").Error)
+	assert.NoError(t, TestDB.Exec("CREATE SCHEMA public").Error)
+	migrations.RunMigrations(TestDB)
+}
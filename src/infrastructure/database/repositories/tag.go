@@ -0,0 +1,139 @@
+package repositories
+
+import (
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) interfaces.TagQuerier {
+	return &TagRepository{db: db}
+}
+
+func (r *TagRepository) ListUsage(customerID string) ([]models.TagUsage, error) {
+	usage := []models.TagUsage{}
+	err := r.db.Table("tags t").
+		Select("t.name, COUNT(wit.product_id) AS item_count").
+		Joins("LEFT JOIN wishlist_item_tags wit ON wit.tag_id = t.id").
+		Where("t.customer_id = ?", customerID).
+		Group("t.name").
+		Order("t.name").
+		Scan(&usage).Error
+	if err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// Attach puts the tags on the item, creating the ones the customer doesn't
+// have yet. Tags already on the item are left as they are.
+func (r *TagRepository) Attach(customerID string, productID int32, names []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		owner, err := uuid.Parse(customerID)
+		if err != nil {
+			return err
+		}
+
+		tags := make([]models.Tag, len(names))
+		for i, name := range names {
+			tags[i] = models.Tag{CustomerID: owner, Name: name}
+		}
+		err = tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "customer_id"}, {Name: "name"}},
+			DoNothing: true,
+		}).Create(&tags).Error
+		if err != nil {
+			return err
+		}
+
+		var tagIDs []uuid.UUID
+		err = tx.Model(&models.Tag{}).
+			Where("customer_id = ? AND name IN ?", customerID, names).
+			Pluck("id", &tagIDs).Error
+		if err != nil {
+			return err
+		}
+
+		links := make([]models.WishlistItemTag, len(tagIDs))
+		for i, tagID := range tagIDs {
+			links[i] = models.WishlistItemTag{CustomerID: owner, ProductID: productID, TagID: tagID}
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&links).Error
+	})
+}
+
+// Detach takes the tag off the item, false when the item didn't have it
+func (r *TagRepository) Detach(customerID string, productID int32, name string) (bool, error) {
+	result := r.db.
+		Where("customer_id = ? AND product_id = ?", customerID, productID).
+		Where("tag_id IN (?)", r.db.Model(&models.Tag{}).Select("id").
+			Where("customer_id = ? AND name = ?", customerID, name)).
+		Delete(&models.WishlistItemTag{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// Delete removes the tag from the customer and from every item
+func (r *TagRepository) Delete(customerID string, name string) (bool, error) {
+	deleted := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var tag models.Tag
+		result := tx.Where("customer_id = ? AND name = ?", customerID, name).Limit(1).Find(&tag)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		if err := tx.Where("tag_id = ?", tag.ID).Delete(&models.WishlistItemTag{}).Error; err != nil {
+			return err
+		}
+		deleted = true
+		return tx.Delete(&tag).Error
+	})
+	return deleted, err
+}
+
+// TagsByProduct returns the tag names of each tagged item, sorted by name
+func (r *TagRepository) TagsByProduct(customerID string) (map[int32][]string, error) {
+	var rows []struct {
+		ProductID int32
+		Name      string
+	}
+	err := r.db.Table("wishlist_item_tags wit").
+		Select("wit.product_id, t.name").
+		Joins("JOIN tags t ON t.id = wit.tag_id").
+		Where("wit.customer_id = ?", customerID).
+		Order("t.name").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	tags := map[int32][]string{}
+	for _, row := range rows {
+		tags[row.ProductID] = append(tags[row.ProductID], row.Name)
+	}
+	return tags, nil
+}
+
+// ProductIDsByTags returns the items with all the tags when matchAll is set,
+// or with any of them otherwise
+func (r *TagRepository) ProductIDsByTags(customerID string, names []string, matchAll bool) ([]int32, error) {
+	query := r.db.Table("wishlist_item_tags wit").
+		Joins("JOIN tags t ON t.id = wit.tag_id").
+		Where("wit.customer_id = ? AND t.name IN ?", customerID, names).
+		Group("wit.product_id")
+	if matchAll {
+		query = query.Having("COUNT(DISTINCT t.name) = ?", len(names))
+	}
+
+	productIDs := []int32{}
+	if err := query.Pluck("wit.product_id", &productIDs).Error; err != nil {
+		return nil, err
+	}
+	return productIDs, nil
+}
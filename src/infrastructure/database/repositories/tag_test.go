@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"testing"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupTagTest(t *testing.T) (queriers.TagQuerier, *models.Customer) {
+	err := TestDB.Migrator().DropTable(&models.WishlistItemTag{}, &models.Tag{}, &models.WishlistItem{}, &models.Customer{}, &models.Product{})
+	assert.NoError(t, err)
+
+	err = TestDB.AutoMigrate(&models.Customer{}, &models.Product{}, &models.WishlistItem{}, &models.Tag{}, &models.WishlistItemTag{})
+	assert.NoError(t, err)
+
+	customer := &models.Customer{Name: "Customer", Email: "tags@ig.com"}
+	assert.NoError(t, TestDB.Create(customer).Error)
+	assert.NoError(t, TestDB.Create(&[]models.Product{{ID: 1, Title: "Produto 1"}, {ID: 2, Title: "Produto 2"}}).Error)
+
+	wishlist := NewWishlistRepository(TestDB)
+	assert.NoError(t, wishlist.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1, Status: models.WishlistItemConfirmed}))
+	assert.NoError(t, wishlist.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 2, Status: models.WishlistItemConfirmed}))
+
+	return NewTagRepository(TestDB), customer
+}
+
+func TestTagRepository_AttachAndList(t *testing.T) {
+	repo, customer := SetupTagTest(t)
+
+	assert.NoError(t, repo.Attach(customer.ID.String(), 1, []string{"gift", "kitchen"}))
+	assert.NoError(t, repo.Attach(customer.ID.String(), 2, []string{"gift"}))
+	// Attaching again keeps a single link
+	assert.NoError(t, repo.Attach(customer.ID.String(), 2, []string{"gift"}))
+
+	usage, err := repo.ListUsage(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, []models.TagUsage{{Name: "gift", ItemCount: 2}, {Name: "kitchen", ItemCount: 1}}, usage)
+
+	tags, err := repo.TagsByProduct(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"gift", "kitchen"}, tags[1])
+	assert.Equal(t, []string{"gift"}, tags[2])
+}
+
+func TestTagRepository_ProductIDsByTags(t *testing.T) {
+	repo, customer := SetupTagTest(t)
+
+	_ = repo.Attach(customer.ID.String(), 1, []string{"gift", "kitchen"})
+	_ = repo.Attach(customer.ID.String(), 2, []string{"gift"})
+
+	anyTags, err := repo.ProductIDsByTags(customer.ID.String(), []string{"gift", "kitchen"}, false)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int32{1, 2}, anyTags)
+
+	allTags, err := repo.ProductIDsByTags(customer.ID.String(), []string{"gift", "kitchen"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{1}, allTags)
+}
+
+func TestTagRepository_DetachAndDelete(t *testing.T) {
+	repo, customer := SetupTagTest(t)
+
+	_ = repo.Attach(customer.ID.String(), 1, []string{"gift", "kitchen"})
+
+	detached, err := repo.Detach(customer.ID.String(), 1, "kitchen")
+	assert.NoError(t, err)
+	assert.True(t, detached)
+	detached, err = repo.Detach(customer.ID.String(), 1, "kitchen")
+	assert.NoError(t, err)
+	assert.False(t, detached)
+
+	deleted, err := repo.Delete(customer.ID.String(), "gift")
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+	deleted, err = repo.Delete(customer.ID.String(), "gift")
+	assert.NoError(t, err)
+	assert.False(t, deleted)
+
+	// The detached tag is kept with no items
+	usage, err := repo.ListUsage(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, []models.TagUsage{{Name: "kitchen", ItemCount: 0}}, usage)
+}
+
+func TestWishlistRepository_ListMemberships(t *testing.T) {
+	repo, customer := SetupTagTest(t)
+	wishlist := NewWishlistRepository(TestDB)
+
+	assert.NoError(t, repo.Attach(customer.ID.String(), 1, []string{"kitchen", "gift"}))
+
+	memberships, err := wishlist.ListMemberships(customer.ID.String(), []int32{1, 2, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, map[int32][]string{1: {"gift", "kitchen"}, 2: {}}, memberships)
+}
@@ -0,0 +1,173 @@
+package repositories
+
+import (
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// The ranks sort byte by byte, items without a position go last by age
+const (
+	wishlistPositionOrder = `position COLLATE "C" NULLS LAST, created_at`
+	wishlistPositionDesc  = `position COLLATE "C" DESC`
+)
+
+type WishlistRepository struct {
+	db *gorm.DB
+}
+
+func NewWishlistRepository(db *gorm.DB) interfaces.WishlistQuerier {
+	return &WishlistRepository{db: db}
+}
+
+// Add puts the item at the end of the wishlist unless it already has a
+// position. Appending makes the ranks longer, past MaxRankLength the whole
+// wishlist is ranked again in the same transaction.
+func (r *WishlistRepository) Add(item *models.WishlistItem) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if item.Position == "" {
+			var last string
+			err := tx.Model(&models.WishlistItem{}).
+				Select("position").
+				Where("customer_id = ? AND position IS NOT NULL", item.CustomerID).
+				Order(wishlistPositionDesc).
+				Limit(1).
+				Scan(&last).Error
+			if err != nil {
+				return err
+			}
+			item.Position = models.RankBetween(last, "")
+			if len(item.Position) > models.MaxRankLength {
+				if item.Position, err = rebalanceForAppend(tx, item.CustomerID.String()); err != nil {
+					return err
+				}
+			}
+		}
+		return tx.Omit("Product").Create(item).Error
+	})
+}
+
+// rebalanceForAppend ranks the wishlist evenly again and returns the rank
+// after the last item
+func rebalanceForAppend(tx *gorm.DB, customerID string) (string, error) {
+	var productIDs []int32
+	err := tx.Model(&models.WishlistItem{}).
+		Where("customer_id = ?", customerID).
+		Order(wishlistPositionOrder).
+		Pluck("product_id", &productIDs).Error
+	if err != nil {
+		return "", err
+	}
+
+	ranks := models.EvenRanks(len(productIDs) + 1)
+	for i, productID := range productIDs {
+		err := tx.Model(&models.WishlistItem{}).
+			Where("customer_id = ? AND product_id = ?", customerID, productID).
+			Update("position", ranks[i]).Error
+		if err != nil {
+			return "", err
+		}
+	}
+	return ranks[len(productIDs)], nil
+}
+
+func (r *WishlistRepository) ListByCustomer(customerID string) ([]models.WishlistItem, error) {
+	var items []models.WishlistItem
+	err := r.db.Preload("Product").
+		Where("customer_id = ?", customerID).
+		Order(wishlistPositionOrder).
+		Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *WishlistRepository) ListByStatus(status string, limit int) ([]models.WishlistItem, error) {
+	var items []models.WishlistItem
+	err := r.db.Where("status = ?", status).
+		Order("created_at").
+		Limit(limit).
+		Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *WishlistRepository) UpdateStatus(customerID string, productID int32, status string) error {
+	return r.db.Model(&models.WishlistItem{}).
+		Where("customer_id = ? AND product_id = ?", customerID, productID).
+		Update("status", status).Error
+}
+
+func (r *WishlistRepository) UpdateAddedPrice(customerID string, productID int32, price models.Money) error {
+	return r.db.Model(&models.WishlistItem{}).
+		Where("customer_id = ? AND product_id = ?", customerID, productID).
+		Updates(map[string]any{
+			"added_price_amount":   price.Amount,
+			"added_price_currency": price.Currency,
+		}).Error
+}
+
+func (r *WishlistRepository) UpdateQuantity(customerID string, productID int32, quantity int) error {
+	return r.db.Model(&models.WishlistItem{}).
+		Where("customer_id = ? AND product_id = ?", customerID, productID).
+		Update("quantity", quantity).Error
+}
+
+// UpdatePositions sets the position of several items at once
+func (r *WishlistRepository) UpdatePositions(customerID string, positions map[int32]string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for productID, position := range positions {
+			err := tx.Model(&models.WishlistItem{}).
+				Where("customer_id = ? AND product_id = ?", customerID, productID).
+				Update("position", position).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *WishlistRepository) Remove(customerID string, productID int32) error {
+	return r.db.Where("customer_id = ? AND product_id = ?", customerID, productID).
+		Delete(&models.WishlistItem{}).Error
+}
+
+// ListMemberships tells which of the products are in the customer wishlist,
+// with the tags of each item, in a single query. Products out of the
+// wishlist are missing from the map, items without tags have an empty slice.
+func (r *WishlistRepository) ListMemberships(customerID string, productIDs []int32) (map[int32][]string, error) {
+	memberships := map[int32][]string{}
+	if len(productIDs) == 0 {
+		return memberships, nil
+	}
+
+	var rows []struct {
+		ProductID int32
+		Tag       *string
+	}
+	err := r.db.Table("wishlists w").
+		Select("w.product_id, t.name AS tag").
+		Joins("LEFT JOIN wishlist_item_tags wit ON wit.customer_id = w.customer_id AND wit.product_id = w.product_id").
+		Joins("LEFT JOIN tags t ON t.id = wit.tag_id").
+		Where("w.customer_id = ? AND w.product_id IN ?", customerID, productIDs).
+		Order("w.product_id, t.name").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if _, ok := memberships[row.ProductID]; !ok {
+			memberships[row.ProductID] = []string{}
+		}
+		if row.Tag != nil {
+			memberships[row.ProductID] = append(memberships[row.ProductID], *row.Tag)
+		}
+	}
+	return memberships, nil
+}
@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"errors"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type WishlistCollaboratorRepository struct {
+	db *gorm.DB
+}
+
+func NewWishlistCollaboratorRepository(db *gorm.DB) interfaces.WishlistCollaboratorQuerier {
+	return &WishlistCollaboratorRepository{db: db}
+}
+
+func (r *WishlistCollaboratorRepository) Get(ownerID string, collaboratorID string) (*models.WishlistCollaborator, error) {
+	var collaborator models.WishlistCollaborator
+	err := r.db.First(&collaborator, "owner_id = ? AND collaborator_id = ?", ownerID, collaboratorID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &collaborator, nil
+}
+
+func (r *WishlistCollaboratorRepository) ListByOwner(ownerID string) ([]models.WishlistCollaborator, error) {
+	var collaborators []models.WishlistCollaborator
+	err := r.db.Preload("Collaborator").
+		Where("owner_id = ?", ownerID).
+		Order("created_at").
+		Find(&collaborators).Error
+	if err != nil {
+		return nil, err
+	}
+	return collaborators, nil
+}
+
+func (r *WishlistCollaboratorRepository) ListByCollaborator(collaboratorID string) ([]models.WishlistCollaborator, error) {
+	var collaborators []models.WishlistCollaborator
+	err := r.db.Preload("Owner").
+		Where("collaborator_id = ?", collaboratorID).
+		Order("created_at").
+		Find(&collaborators).Error
+	if err != nil {
+		return nil, err
+	}
+	return collaborators, nil
+}
+
+func (r *WishlistCollaboratorRepository) Save(collaborator *models.WishlistCollaborator) error {
+	return r.db.Omit("Owner", "Collaborator").Save(collaborator).Error
+}
+
+func (r *WishlistCollaboratorRepository) Remove(ownerID string, collaboratorID string) error {
+	return r.db.Where("owner_id = ? AND collaborator_id = ?", ownerID, collaboratorID).
+		Delete(&models.WishlistCollaborator{}).Error
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"testing"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupWishlistCollaboratorTest(t *testing.T) (queriers.WishlistCollaboratorQuerier, *models.Customer, *models.Customer) {
+	err := TestDB.Migrator().DropTable(&models.WishlistCollaborator{}, &models.WishlistItem{}, &models.Customer{})
+	assert.NoError(t, err)
+
+	err = TestDB.AutoMigrate(&models.Customer{}, &models.WishlistCollaborator{})
+	assert.NoError(t, err)
+
+	owner := &models.Customer{Name: "Owner", Email: "owner@ig.com"}
+	assert.NoError(t, TestDB.Create(owner).Error)
+	partner := &models.Customer{Name: "Partner", Email: "partner@ig.com"}
+	assert.NoError(t, TestDB.Create(partner).Error)
+
+	return NewWishlistCollaboratorRepository(TestDB), owner, partner
+}
+
+func TestWishlistCollaboratorRepository_Lifecycle(t *testing.T) {
+	repo, owner, partner := SetupWishlistCollaboratorTest(t)
+
+	err := repo.Save(&models.WishlistCollaborator{
+		OwnerID:        owner.ID,
+		CollaboratorID: partner.ID,
+		Role:           models.CollaboratorViewer,
+		Status:         models.InvitationPending,
+	})
+	assert.NoError(t, err)
+
+	collaborator, err := repo.Get(owner.ID.String(), partner.ID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, models.InvitationPending, collaborator.Status)
+
+	collaborator.Status = models.InvitationAccepted
+	assert.NoError(t, repo.Save(collaborator))
+
+	byOwner, err := repo.ListByOwner(owner.ID.String())
+	assert.NoError(t, err)
+	assert.Len(t, byOwner, 1)
+	assert.Equal(t, "Partner", byOwner[0].Collaborator.Name)
+
+	byCollaborator, err := repo.ListByCollaborator(partner.ID.String())
+	assert.NoError(t, err)
+	assert.Len(t, byCollaborator, 1)
+	assert.Equal(t, models.InvitationAccepted, byCollaborator[0].Status)
+	assert.Equal(t, "Owner", byCollaborator[0].Owner.Name)
+
+	assert.NoError(t, repo.Remove(owner.ID.String(), partner.ID.String()))
+	missing, err := repo.Get(owner.ID.String(), partner.ID.String())
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+}
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WishlistRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewWishlistRuleRepository(db *gorm.DB) interfaces.WishlistRuleQuerier {
+	return &WishlistRuleRepository{db: db}
+}
+
+func (r *WishlistRuleRepository) List() ([]models.WishlistRule, error) {
+	rules := []models.WishlistRule{}
+	if err := r.db.Order("name").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (r *WishlistRuleRepository) ListEnabled() ([]models.WishlistRule, error) {
+	rules := []models.WishlistRule{}
+	if err := r.db.Where("enabled").Order("name").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// Save keeps the id and creation date of the rule it replaces
+func (r *WishlistRuleRepository) Save(rule *models.WishlistRule) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"description", "actions", "expression", "enabled", "updated_at"}),
+	}, clause.Returning{}).Create(rule).Error
+}
+
+func (r *WishlistRuleRepository) Delete(name string) (bool, error) {
+	result := r.db.Where("name = ?", name).Delete(&models.WishlistRule{})
+	return result.RowsAffected > 0, result.Error
+}
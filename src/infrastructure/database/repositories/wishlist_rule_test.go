@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"testing"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupWishlistRuleTest(t *testing.T) queriers.WishlistRuleQuerier {
+	err := TestDB.Migrator().DropTable(&models.WishlistRule{})
+	assert.NoError(t, err)
+
+	err = TestDB.AutoMigrate(&models.WishlistRule{})
+	assert.NoError(t, err)
+
+	return NewWishlistRuleRepository(TestDB)
+}
+
+func TestWishlistRuleRepository_SaveReplacesByName(t *testing.T) {
+	repo := SetupWishlistRuleTest(t)
+
+	first := &models.WishlistRule{Name: "max-items", Expression: "customer.wishlist_count < 100", Enabled: true}
+	assert.NoError(t, repo.Save(first))
+	second := &models.WishlistRule{Name: "max-items", Actions: []string{"add"}, Expression: "customer.wishlist_count < 50"}
+	assert.NoError(t, repo.Save(second))
+	assert.Equal(t, first.ID, second.ID)
+
+	rules, err := repo.List()
+	assert.NoError(t, err)
+	assert.Len(t, rules, 1)
+	assert.Equal(t, "customer.wishlist_count < 50", rules[0].Expression)
+	assert.Equal(t, []string{"add"}, rules[0].Actions)
+
+	enabled, err := repo.ListEnabled()
+	assert.NoError(t, err)
+	assert.Empty(t, enabled)
+
+	deleted, err := repo.Delete("max-items")
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+}
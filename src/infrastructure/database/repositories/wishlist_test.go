@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"strings"
+	"testing"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupWishlistTest(t *testing.T) (queriers.WishlistQuerier, *models.Customer) {
+	err := TestDB.Migrator().DropTable(&models.WishlistItem{}, &models.Customer{}, &models.Product{})
+	assert.NoError(t, err)
+
+	err = TestDB.AutoMigrate(&models.Customer{}, &models.Product{}, &models.WishlistItem{})
+	assert.NoError(t, err)
+
+	customer := &models.Customer{Name: "Customer", Email: "wishlist@ig.com"}
+	assert.NoError(t, TestDB.Create(customer).Error)
+	assert.NoError(t, TestDB.Create(&[]models.Product{{ID: 1, Title: "Produto 1"}, {ID: 2, Title: "Produto 2"}}).Error)
+
+	return NewWishlistRepository(TestDB), customer
+}
+
+func TestWishlistRepository_AddAndListByCustomer(t *testing.T) {
+	repo, customer := SetupWishlistTest(t)
+
+	err := repo.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1, Status: models.WishlistItemConfirmed})
+	assert.NoError(t, err)
+	err = repo.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 2, Status: models.WishlistItemPending})
+	assert.NoError(t, err)
+
+	items, err := repo.ListByCustomer(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, "Produto 1", items[0].Product.Title)
+	assert.NotZero(t, items[0].CreatedAt)
+}
+
+func TestWishlistRepository_PendingLifecycle(t *testing.T) {
+	repo, customer := SetupWishlistTest(t)
+
+	_ = repo.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1, Status: models.WishlistItemPending})
+	_ = repo.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 2, Status: models.WishlistItemPending})
+
+	pending, err := repo.ListByStatus(models.WishlistItemPending, 10)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 2)
+
+	err = repo.UpdateStatus(customer.ID.String(), 1, models.WishlistItemConfirmed)
+	assert.NoError(t, err)
+	err = repo.Remove(customer.ID.String(), 2)
+	assert.NoError(t, err)
+
+	pending, err = repo.ListByStatus(models.WishlistItemPending, 10)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 0)
+
+	items, err := repo.ListByCustomer(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, models.WishlistItemConfirmed, items[0].Status)
+}
+
+func TestWishlistRepository_AddedPrice(t *testing.T) {
+	repo, customer := SetupWishlistTest(t)
+
+	err := repo.Add(&models.WishlistItem{
+		CustomerID: customer.ID,
+		ProductID:  1,
+		Status:     models.WishlistItemConfirmed,
+		AddedPrice: models.NewMoney(10995, "USD"),
+	})
+	assert.NoError(t, err)
+	_ = repo.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 2, Status: models.WishlistItemPending})
+
+	err = repo.UpdateAddedPrice(customer.ID.String(), 2, models.NewMoney(2230, "USD"))
+	assert.NoError(t, err)
+
+	items, err := repo.ListByCustomer(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, models.NewMoney(10995, "USD"), items[0].AddedPrice)
+	assert.Equal(t, models.NewMoney(2230, "USD"), items[1].AddedPrice)
+}
+
+func TestWishlistRepository_Positions(t *testing.T) {
+	repo, customer := SetupWishlistTest(t)
+
+	// Appended items get increasing ranks
+	_ = repo.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1, Status: models.WishlistItemConfirmed})
+	_ = repo.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 2, Status: models.WishlistItemConfirmed})
+
+	items, err := repo.ListByCustomer(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), items[0].ProductID)
+	assert.Less(t, items[0].Position, items[1].Position)
+
+	err = repo.UpdatePositions(customer.ID.String(), map[int32]string{2: models.RankBetween("", items[0].Position)})
+	assert.NoError(t, err)
+
+	items, err = repo.ListByCustomer(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), items[0].ProductID)
+	assert.Equal(t, int32(1), items[1].ProductID)
+}
+
+func TestWishlistRepository_AddRebalancesLongRanks(t *testing.T) {
+	repo, customer := SetupWishlistTest(t)
+
+	long := strings.Repeat("z", models.MaxRankLength)
+	assert.NoError(t, repo.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1,
+		Status: models.WishlistItemConfirmed, Position: long}))
+	assert.NoError(t, repo.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 2,
+		Status: models.WishlistItemConfirmed}))
+
+	items, err := repo.ListByCustomer(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, models.EvenRanks(2), []string{items[0].Position, items[1].Position})
+	assert.Equal(t, int32(1), items[0].ProductID)
+	assert.Equal(t, int32(2), items[1].ProductID)
+}
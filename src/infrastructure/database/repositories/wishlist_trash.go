@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"time"
+
+	interfaces "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WishlistTrashRepository struct {
+	db *gorm.DB
+}
+
+func NewWishlistTrashRepository(db *gorm.DB) interfaces.WishlistTrashQuerier {
+	return &WishlistTrashRepository{db: db}
+}
+
+// Trash copies the item, its tags and its registry reservations to the trash
+// and removes it from the wishlist. Removing the same product again replaces
+// the older entry.
+func (r *WishlistTrashRepository) Trash(customerID string, productID int32, removedBy *uuid.UUID, expiresAt time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var item models.WishlistItem
+		result := tx.Where("customer_id = ? AND product_id = ?", customerID, productID).Limit(1).Find(&item)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		tags, err := NewTagRepository(tx).TagsByProduct(customerID)
+		if err != nil {
+			return err
+		}
+
+		var reservations []models.RegistryReservation
+		err = tx.Where("customer_id = ? AND product_id = ?", customerID, productID).Find(&reservations).Error
+		if err != nil {
+			return err
+		}
+
+		entry := models.TrashedWishlistItem{
+			CustomerID:   item.CustomerID,
+			ProductID:    item.ProductID,
+			Status:       item.Status,
+			AddedAt:      item.CreatedAt,
+			AddedPrice:   item.AddedPrice,
+			AddedBy:      item.AddedBy,
+			Quantity:     item.Quantity,
+			Position:     item.Position,
+			Tags:         tags[productID],
+			Reservations: reservations,
+			RemovedAt:    time.Now(),
+			RemovedBy:    removedBy,
+			ExpiresAt:    expiresAt,
+		}
+		err = tx.Omit("Product").Clauses(clause.OnConflict{UpdateAll: true}).Create(&entry).Error
+		if err != nil {
+			return err
+		}
+
+		// The tag links and the reservations go with the item
+		return tx.Where("customer_id = ? AND product_id = ?", customerID, productID).
+			Delete(&models.WishlistItem{}).Error
+	})
+}
+
+func (r *WishlistTrashRepository) Get(customerID string, productID int32, now time.Time) (*models.TrashedWishlistItem, error) {
+	var entry models.TrashedWishlistItem
+	result := r.db.Preload("Product").
+		Where("customer_id = ? AND product_id = ? AND expires_at > ?", customerID, productID, now).
+		Limit(1).
+		Find(&entry)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &entry, nil
+}
+
+// ListByCustomer returns the entries not expired yet, last removed first
+func (r *WishlistTrashRepository) ListByCustomer(customerID string, now time.Time) ([]models.TrashedWishlistItem, error) {
+	entries := []models.TrashedWishlistItem{}
+	err := r.db.Preload("Product").
+		Where("customer_id = ? AND expires_at > ?", customerID, now).
+		Order("removed_at DESC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *WishlistTrashRepository) Restore(entry *models.TrashedWishlistItem) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Product").Create(entry.ToWishlistItem()).Error; err != nil {
+			return err
+		}
+		if len(entry.Tags) > 0 {
+			err := NewTagRepository(tx).Attach(entry.CustomerID.String(), entry.ProductID, entry.Tags)
+			if err != nil {
+				return err
+			}
+		}
+		if len(entry.Reservations) > 0 {
+			// The ids are kept, the guests still hold them to confirm or cancel
+			reservations := make([]models.RegistryReservation, len(entry.Reservations))
+			for i, reservation := range entry.Reservations {
+				reservation.CustomerID = entry.CustomerID
+				reservations[i] = reservation
+			}
+			if err := tx.Create(&reservations).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Where("customer_id = ? AND product_id = ?", entry.CustomerID, entry.ProductID).
+			Delete(&models.TrashedWishlistItem{}).Error
+	})
+}
+
+func (r *WishlistTrashRepository) Empty(customerID string) (int64, error) {
+	result := r.db.Where("customer_id = ?", customerID).Delete(&models.TrashedWishlistItem{})
+	return result.RowsAffected, result.Error
+}
+
+func (r *WishlistTrashRepository) DeleteExpired(now time.Time) (int64, error) {
+	result := r.db.Where("expires_at <= ?", now).Delete(&models.TrashedWishlistItem{})
+	return result.RowsAffected, result.Error
+}
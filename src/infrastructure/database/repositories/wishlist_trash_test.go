@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	queriers "produtos-favoritos/src/domain/interfaces/repositories"
+	"produtos-favoritos/src/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupWishlistTrashTest(t *testing.T) (queriers.WishlistTrashQuerier, *models.Customer) {
+	err := TestDB.Migrator().DropTable(&models.TrashedWishlistItem{}, &models.RegistryReservation{},
+		&models.WishlistItemTag{}, &models.Tag{}, &models.WishlistItem{}, &models.Customer{}, &models.Product{})
+	assert.NoError(t, err)
+
+	err = TestDB.AutoMigrate(&models.Customer{}, &models.Product{}, &models.WishlistItem{}, &models.Tag{},
+		&models.WishlistItemTag{}, &models.TrashedWishlistItem{}, &models.RegistryReservation{})
+	assert.NoError(t, err)
+
+	customer := &models.Customer{Name: "Customer", Email: "trash@ig.com"}
+	assert.NoError(t, TestDB.Create(customer).Error)
+	assert.NoError(t, TestDB.Create(&[]models.Product{{ID: 1, Title: "Produto 1"}, {ID: 2, Title: "Produto 2"}}).Error)
+
+	return NewWishlistTrashRepository(TestDB), customer
+}
+
+func TestWishlistTrashRepository_TrashAndRestore(t *testing.T) {
+	repo, customer := SetupWishlistTrashTest(t)
+	wishlist := NewWishlistRepository(TestDB)
+	tags := NewTagRepository(TestDB)
+
+	addedAt := time.Now().Add(-72 * time.Hour).Truncate(time.Microsecond)
+	_ = wishlist.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1, Status: models.WishlistItemConfirmed,
+		CreatedAt: addedAt, AddedPrice: models.NewMoney(1500, "USD"), Quantity: 3})
+	_ = tags.Attach(customer.ID.String(), 1, []string{"gift"})
+
+	err := repo.Trash(customer.ID.String(), 1, &customer.ID, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	items, _ := wishlist.ListByCustomer(customer.ID.String())
+	assert.Empty(t, items)
+
+	entry, err := repo.Get(customer.ID.String(), 1, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"gift"}, entry.Tags)
+	assert.Equal(t, "Produto 1", entry.Product.Title)
+
+	assert.NoError(t, repo.Restore(entry))
+
+	items, _ = wishlist.ListByCustomer(customer.ID.String())
+	assert.Len(t, items, 1)
+	assert.True(t, addedAt.Equal(items[0].CreatedAt))
+	assert.Equal(t, int64(1500), items[0].AddedPrice.Amount)
+	assert.Equal(t, 3, items[0].Quantity)
+
+	restoredTags, _ := tags.TagsByProduct(customer.ID.String())
+	assert.Equal(t, []string{"gift"}, restoredTags[1])
+
+	trash, _ := repo.ListByCustomer(customer.ID.String(), time.Now())
+	assert.Empty(t, trash)
+}
+
+func TestWishlistTrashRepository_KeepsRegistryReservations(t *testing.T) {
+	repo, customer := SetupWishlistTrashTest(t)
+	wishlist := NewWishlistRepository(TestDB)
+
+	_ = wishlist.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1, Status: models.WishlistItemConfirmed, Quantity: 2})
+	reservation := &models.RegistryReservation{CustomerID: customer.ID, ProductID: 1, Quantity: 1,
+		GuestName: "Guest", Status: models.ReservationConfirmed}
+	assert.NoError(t, TestDB.Create(reservation).Error)
+
+	assert.NoError(t, repo.Trash(customer.ID.String(), 1, &customer.ID, time.Now().Add(time.Hour)))
+	// Stands in for the ON DELETE CASCADE of the registry migration
+	assert.NoError(t, TestDB.Where("customer_id = ?", customer.ID).Delete(&models.RegistryReservation{}).Error)
+
+	entry, err := repo.Get(customer.ID.String(), 1, time.Now())
+	assert.NoError(t, err)
+	assert.Len(t, entry.Reservations, 1)
+	assert.NoError(t, repo.Restore(entry))
+
+	var restored []models.RegistryReservation
+	assert.NoError(t, TestDB.Where("customer_id = ? AND product_id = ?", customer.ID, 1).Find(&restored).Error)
+	assert.Len(t, restored, 1)
+	assert.Equal(t, reservation.ID, restored[0].ID)
+	assert.Equal(t, "Guest", restored[0].GuestName)
+	assert.Equal(t, models.ReservationConfirmed, restored[0].Status)
+}
+
+func TestWishlistTrashRepository_ExpiredEntries(t *testing.T) {
+	repo, customer := SetupWishlistTrashTest(t)
+	wishlist := NewWishlistRepository(TestDB)
+
+	_ = wishlist.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 1, Status: models.WishlistItemConfirmed})
+	_ = wishlist.Add(&models.WishlistItem{CustomerID: customer.ID, ProductID: 2, Status: models.WishlistItemConfirmed})
+	_ = repo.Trash(customer.ID.String(), 1, nil, time.Now().Add(-time.Minute))
+	_ = repo.Trash(customer.ID.String(), 2, nil, time.Now().Add(time.Hour))
+
+	trash, err := repo.ListByCustomer(customer.ID.String(), time.Now())
+	assert.NoError(t, err)
+	assert.Len(t, trash, 1)
+
+	deleted, err := repo.DeleteExpired(time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	emptied, err := repo.Empty(customer.ID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), emptied)
+}
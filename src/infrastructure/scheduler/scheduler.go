@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+)
+
+type Scheduler struct {
+	jobs []servicers.Job
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewScheduler(jobs []servicers.Job) *Scheduler {
+	return &Scheduler{
+		jobs: jobs,
+		stop: make(chan struct{}),
+	}
+}
+
+// Start runs every job right away and then on its own ticker until Stop is called
+func (s *Scheduler) Start() {
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(job)
+	}
+}
+
+func (s *Scheduler) Stop() {
+	close(s.stop)
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(job servicers.Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval())
+	defer ticker.Stop()
+
+	s.run(job)
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.run(job)
+		}
+	}
+}
+
+// run executes a single job, a failing or panicking job must not take the
+// scheduler (or the api) down with it.
+func (s *Scheduler) run(job servicers.Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("job %s panicked: %v", job.Name(), r)
+		}
+	}()
+
+	if err := job.Run(); err != nil {
+		log.Printf("job %s failed: %v", job.Name(), err)
+	}
+}
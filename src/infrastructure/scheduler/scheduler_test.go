@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	servicers "produtos-favoritos/src/domain/interfaces/services"
+)
+
+type countingJob struct {
+	runs  atomic.Int32
+	err   error
+	panic bool
+}
+
+func (j *countingJob) Name() string            { return "counting" }
+func (j *countingJob) Interval() time.Duration { return 5 * time.Millisecond }
+func (j *countingJob) Run() error {
+	j.runs.Add(1)
+	if j.panic {
+		panic("boom")
+	}
+	return j.err
+}
+
+func TestScheduler_RunsJobsUntilStopped(t *testing.T) {
+	job := &countingJob{}
+	s := NewScheduler([]servicers.Job{job})
+
+	s.Start()
+	assert.Eventually(t, func() bool { return job.runs.Load() >= 2 }, time.Second, time.Millisecond)
+	s.Stop()
+
+	runs := job.runs.Load()
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, runs, job.runs.Load())
+}
+
+func TestScheduler_SurvivesFailingJobs(t *testing.T) {
+	failing := &countingJob{err: errors.New("upstream down")}
+	panicking := &countingJob{panic: true}
+	s := NewScheduler([]servicers.Job{failing, panicking})
+
+	s.Start()
+	assert.Eventually(t, func() bool {
+		return failing.runs.Load() >= 2 && panicking.runs.Load() >= 2
+	}, time.Second, time.Millisecond)
+	s.Stop()
+}
+
+func TestScheduler_RunsJobsOnStart(t *testing.T) {
+	job := &slowJob{}
+	s := NewScheduler([]servicers.Job{job})
+
+	s.Start()
+	assert.Eventually(t, func() bool { return job.runs.Load() == 1 }, time.Second, time.Millisecond)
+	s.Stop()
+}
+
+type slowJob struct {
+	runs atomic.Int32
+}
+
+func (j *slowJob) Name() string            { return "slow" }
+func (j *slowJob) Interval() time.Duration { return time.Hour }
+func (j *slowJob) Run() error {
+	j.runs.Add(1)
+	return nil
+}
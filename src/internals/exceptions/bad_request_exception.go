@@ -7,5 +7,5 @@ type BadRequestError struct {
 }
 
 func (i *BadRequestError) Error() string {
-	return fmt.Sprintf(i.Reason)
+	return fmt.Sprintf("%s", i.Reason)
 }
@@ -0,0 +1,11 @@
+package exceptions
+
+import "fmt"
+
+type ForbiddenError struct {
+	Reason string
+}
+
+func (i *ForbiddenError) Error() string {
+	return fmt.Sprintf("%s", i.Reason)
+}
@@ -0,0 +1,13 @@
+package exceptions
+
+import "fmt"
+
+// GoneError is a resource that existed but was removed for good, like a
+// change feed cursor past the retention
+type GoneError struct {
+	Reason string
+}
+
+func (i *GoneError) Error() string {
+	return fmt.Sprintf("%s", i.Reason)
+}
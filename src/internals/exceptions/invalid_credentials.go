@@ -7,5 +7,5 @@ type InvalidCredentialsError struct {
 }
 
 func (i *InvalidCredentialsError) Error() string {
-	return fmt.Sprintf(i.Reason)
+	return fmt.Sprintf("%s", i.Reason)
 }
@@ -7,5 +7,5 @@ type InvalidEntityError struct {
 }
 
 func (i *InvalidEntityError) Error() string {
-	return fmt.Sprintf(i.Reason)
+	return fmt.Sprintf("%s", i.Reason)
 }
@@ -0,0 +1,18 @@
+package exceptions
+
+import "fmt"
+
+type PolicyViolation struct {
+	Rule        string `json:"rule"`
+	Description string `json:"description"`
+}
+
+// PolicyViolationError lists the wishlist rules the change breaks
+type PolicyViolationError struct {
+	Reason     string
+	Violations []PolicyViolation
+}
+
+func (i *PolicyViolationError) Error() string {
+	return fmt.Sprintf("%s", i.Reason)
+}
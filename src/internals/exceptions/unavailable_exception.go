@@ -0,0 +1,13 @@
+package exceptions
+
+import "fmt"
+
+// UnavailableError is a request the service can't take right now but could
+// take later, like a view arriving while the view buffer is full
+type UnavailableError struct {
+	Reason string
+}
+
+func (i *UnavailableError) Error() string {
+	return fmt.Sprintf("%s", i.Reason)
+}
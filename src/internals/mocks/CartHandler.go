@@ -0,0 +1,52 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CartHandler is an autogenerated mock type for the CartHandler type
+type CartHandler struct {
+	mock.Mock
+}
+
+// AddItem provides a mock function with given fields: c
+func (_m *CartHandler) AddItem(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Get provides a mock function with given fields: c
+func (_m *CartHandler) Get(c *gin.Context) {
+	_m.Called(c)
+}
+
+// MoveFromWishlist provides a mock function with given fields: c
+func (_m *CartHandler) MoveFromWishlist(c *gin.Context) {
+	_m.Called(c)
+}
+
+// RemoveItem provides a mock function with given fields: c
+func (_m *CartHandler) RemoveItem(c *gin.Context) {
+	_m.Called(c)
+}
+
+// UpdateItem provides a mock function with given fields: c
+func (_m *CartHandler) UpdateItem(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewCartHandler creates a new instance of CartHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCartHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CartHandler {
+	mock := &CartHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,184 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// CartQuerier is an autogenerated mock type for the CartQuerier type
+type CartQuerier struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: item
+func (_m *CartQuerier) Add(item *models.CartItem) (bool, error) {
+	ret := _m.Called(item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.CartItem) (bool, error)); ok {
+		return rf(item)
+	}
+	if rf, ok := ret.Get(0).(func(*models.CartItem) bool); ok {
+		r0 = rf(item)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.CartItem) error); ok {
+		r1 = rf(item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: customerID, productID
+func (_m *CartQuerier) Get(customerID string, productID int32) (*models.CartItem, error) {
+	ret := _m.Called(customerID, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *models.CartItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int32) (*models.CartItem, error)); ok {
+		return rf(customerID, productID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int32) *models.CartItem); ok {
+		r0 = rf(customerID, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CartItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int32) error); ok {
+		r1 = rf(customerID, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByCustomer provides a mock function with given fields: customerID
+func (_m *CartQuerier) ListByCustomer(customerID string) ([]models.CartItem, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByCustomer")
+	}
+
+	var r0 []models.CartItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.CartItem, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.CartItem); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CartItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveFromWishlist provides a mock function with given fields: customerID, items, keepInWishlist, trashExpiresAt
+func (_m *CartQuerier) MoveFromWishlist(customerID string, items []models.CartItem, keepInWishlist bool, trashExpiresAt time.Time) ([]int32, error) {
+	ret := _m.Called(customerID, items, keepInWishlist, trashExpiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveFromWishlist")
+	}
+
+	var r0 []int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []models.CartItem, bool, time.Time) ([]int32, error)); ok {
+		return rf(customerID, items, keepInWishlist, trashExpiresAt)
+	}
+	if rf, ok := ret.Get(0).(func(string, []models.CartItem, bool, time.Time) []int32); ok {
+		r0 = rf(customerID, items, keepInWishlist, trashExpiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []models.CartItem, bool, time.Time) error); ok {
+		r1 = rf(customerID, items, keepInWishlist, trashExpiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Remove provides a mock function with given fields: customerID, productID
+func (_m *CartQuerier) Remove(customerID string, productID int32) error {
+	ret := _m.Called(customerID, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32) error); ok {
+		r0 = rf(customerID, productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateQuantity provides a mock function with given fields: customerID, productID, quantity
+func (_m *CartQuerier) UpdateQuantity(customerID string, productID int32, quantity int) error {
+	ret := _m.Called(customerID, productID, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateQuantity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32, int) error); ok {
+		r0 = rf(customerID, productID, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCartQuerier creates a new instance of CartQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCartQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CartQuerier {
+	mock := &CartQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
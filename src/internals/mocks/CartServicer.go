@@ -0,0 +1,166 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CartServicer is an autogenerated mock type for the CartServicer type
+type CartServicer struct {
+	mock.Mock
+}
+
+// AddItem provides a mock function with given fields: customerID, productID, quantity
+func (_m *CartServicer) AddItem(customerID string, productID int32, quantity int) (*models.Cart, error) {
+	ret := _m.Called(customerID, productID, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddItem")
+	}
+
+	var r0 *models.Cart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int32, int) (*models.Cart, error)); ok {
+		return rf(customerID, productID, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(string, int32, int) *models.Cart); ok {
+		r0 = rf(customerID, productID, quantity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Cart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int32, int) error); ok {
+		r1 = rf(customerID, productID, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCart provides a mock function with given fields: customerID
+func (_m *CartServicer) GetCart(customerID string) (*models.Cart, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCart")
+	}
+
+	var r0 *models.Cart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.Cart, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.Cart); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Cart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveFromWishlist provides a mock function with given fields: customerID, productID, keepInWishlist
+func (_m *CartServicer) MoveFromWishlist(customerID string, productID *int32, keepInWishlist bool) (*models.MoveToCartResult, error) {
+	ret := _m.Called(customerID, productID, keepInWishlist)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveFromWishlist")
+	}
+
+	var r0 *models.MoveToCartResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *int32, bool) (*models.MoveToCartResult, error)); ok {
+		return rf(customerID, productID, keepInWishlist)
+	}
+	if rf, ok := ret.Get(0).(func(string, *int32, bool) *models.MoveToCartResult); ok {
+		r0 = rf(customerID, productID, keepInWishlist)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.MoveToCartResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *int32, bool) error); ok {
+		r1 = rf(customerID, productID, keepInWishlist)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveItem provides a mock function with given fields: customerID, productID
+func (_m *CartServicer) RemoveItem(customerID string, productID int32) error {
+	ret := _m.Called(customerID, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32) error); ok {
+		r0 = rf(customerID, productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateItem provides a mock function with given fields: customerID, productID, quantity
+func (_m *CartServicer) UpdateItem(customerID string, productID int32, quantity int) (*models.Cart, error) {
+	ret := _m.Called(customerID, productID, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateItem")
+	}
+
+	var r0 *models.Cart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int32, int) (*models.Cart, error)); ok {
+		return rf(customerID, productID, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(string, int32, int) *models.Cart); ok {
+		r0 = rf(customerID, productID, quantity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Cart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int32, int) error); ok {
+		r1 = rf(customerID, productID, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCartServicer creates a new instance of CartServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCartServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CartServicer {
+	mock := &CartServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
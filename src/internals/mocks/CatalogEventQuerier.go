@@ -0,0 +1,114 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// CatalogEventQuerier is an autogenerated mock type for the CatalogEventQuerier type
+type CatalogEventQuerier struct {
+	mock.Mock
+}
+
+// Exists provides a mock function with given fields: eventID
+func (_m *CatalogEventQuerier) Exists(eventID string) (bool, error) {
+	ret := _m.Called(eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(eventID)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(eventID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LastOccurredAt provides a mock function with given fields: productID
+func (_m *CatalogEventQuerier) LastOccurredAt(productID int32) (time.Time, error) {
+	ret := _m.Called(productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastOccurredAt")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32) (time.Time, error)); ok {
+		return rf(productID)
+	}
+	if rf, ok := ret.Get(0).(func(int32) time.Time); ok {
+		r0 = rf(productID)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(int32) error); ok {
+		r1 = rf(productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Record provides a mock function with given fields: event, product, changes
+func (_m *CatalogEventQuerier) Record(event *models.CatalogEvent, product *models.Product, changes []models.ProductChange) (bool, error) {
+	ret := _m.Called(event, product, changes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.CatalogEvent, *models.Product, []models.ProductChange) (bool, error)); ok {
+		return rf(event, product, changes)
+	}
+	if rf, ok := ret.Get(0).(func(*models.CatalogEvent, *models.Product, []models.ProductChange) bool); ok {
+		r0 = rf(event, product, changes)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.CatalogEvent, *models.Product, []models.ProductChange) error); ok {
+		r1 = rf(event, product, changes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCatalogEventQuerier creates a new instance of CatalogEventQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCatalogEventQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CatalogEventQuerier {
+	mock := &CatalogEventQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
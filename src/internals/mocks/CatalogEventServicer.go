@@ -0,0 +1,56 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CatalogEventServicer is an autogenerated mock type for the CatalogEventServicer type
+type CatalogEventServicer struct {
+	mock.Mock
+}
+
+// Process provides a mock function with given fields: event
+func (_m *CatalogEventServicer) Process(event *models.CatalogEvent) (bool, error) {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Process")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.CatalogEvent) (bool, error)); ok {
+		return rf(event)
+	}
+	if rf, ok := ret.Get(0).(func(*models.CatalogEvent) bool); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.CatalogEvent) error); ok {
+		r1 = rf(event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCatalogEventServicer creates a new instance of CatalogEventServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCatalogEventServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CatalogEventServicer {
+	mock := &CatalogEventServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
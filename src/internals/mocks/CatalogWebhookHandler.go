@@ -0,0 +1,32 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CatalogWebhookHandler is an autogenerated mock type for the CatalogWebhookHandler type
+type CatalogWebhookHandler struct {
+	mock.Mock
+}
+
+// Receive provides a mock function with given fields: c
+func (_m *CatalogWebhookHandler) Receive(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewCatalogWebhookHandler creates a new instance of CatalogWebhookHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCatalogWebhookHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CatalogWebhookHandler {
+	mock := &CatalogWebhookHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,116 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ChangeEventQuerier is an autogenerated mock type for the ChangeEventQuerier type
+type ChangeEventQuerier struct {
+	mock.Mock
+}
+
+// ListAfter provides a mock function with given fields: cursor, limit
+func (_m *ChangeEventQuerier) ListAfter(cursor models.ChangeCursor, limit int) ([]models.ChangeEvent, error) {
+	ret := _m.Called(cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAfter")
+	}
+
+	var r0 []models.ChangeEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.ChangeCursor, int) ([]models.ChangeEvent, error)); ok {
+		return rf(cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(models.ChangeCursor, int) []models.ChangeEvent); ok {
+		r0 = rf(cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ChangeEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ChangeCursor, int) error); ok {
+		r1 = rf(cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Prune provides a mock function with given fields: before
+func (_m *ChangeEventQuerier) Prune(before time.Time) (int64, error) {
+	ret := _m.Called(before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Prune")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (int64, error)); ok {
+		return rf(before)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = rf(before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrunedThrough provides a mock function with no fields
+func (_m *ChangeEventQuerier) PrunedThrough() (models.ChangeCursor, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrunedThrough")
+	}
+
+	var r0 models.ChangeCursor
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (models.ChangeCursor, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() models.ChangeCursor); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.ChangeCursor)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewChangeEventQuerier creates a new instance of ChangeEventQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChangeEventQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChangeEventQuerier {
+	mock := &ChangeEventQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
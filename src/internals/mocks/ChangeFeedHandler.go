@@ -0,0 +1,32 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ChangeFeedHandler is an autogenerated mock type for the ChangeFeedHandler type
+type ChangeFeedHandler struct {
+	mock.Mock
+}
+
+// List provides a mock function with given fields: c
+func (_m *ChangeFeedHandler) List(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewChangeFeedHandler creates a new instance of ChangeFeedHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChangeFeedHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChangeFeedHandler {
+	mock := &ChangeFeedHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
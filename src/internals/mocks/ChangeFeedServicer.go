@@ -0,0 +1,61 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ChangeFeedServicer is an autogenerated mock type for the ChangeFeedServicer type
+type ChangeFeedServicer struct {
+	mock.Mock
+}
+
+// ListChanges provides a mock function with given fields: ctx, cursor, limit, wait
+func (_m *ChangeFeedServicer) ListChanges(ctx context.Context, cursor string, limit int, wait time.Duration) (*models.ChangeFeedPage, error) {
+	ret := _m.Called(ctx, cursor, limit, wait)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChanges")
+	}
+
+	var r0 *models.ChangeFeedPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, time.Duration) (*models.ChangeFeedPage, error)); ok {
+		return rf(ctx, cursor, limit, wait)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, time.Duration) *models.ChangeFeedPage); ok {
+		r0 = rf(ctx, cursor, limit, wait)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ChangeFeedPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, time.Duration) error); ok {
+		r1 = rf(ctx, cursor, limit, wait)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewChangeFeedServicer creates a new instance of ChangeFeedServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChangeFeedServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChangeFeedServicer {
+	mock := &ChangeFeedServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
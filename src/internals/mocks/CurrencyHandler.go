@@ -0,0 +1,47 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CurrencyHandler is an autogenerated mock type for the CurrencyHandler type
+type CurrencyHandler struct {
+	mock.Mock
+}
+
+// DeleteRate provides a mock function with given fields: c
+func (_m *CurrencyHandler) DeleteRate(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ImportRates provides a mock function with given fields: c
+func (_m *CurrencyHandler) ImportRates(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ListRates provides a mock function with given fields: c
+func (_m *CurrencyHandler) ListRates(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SetRate provides a mock function with given fields: c
+func (_m *CurrencyHandler) SetRate(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewCurrencyHandler creates a new instance of CurrencyHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCurrencyHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CurrencyHandler {
+	mock := &CurrencyHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
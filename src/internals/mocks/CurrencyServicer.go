@@ -0,0 +1,148 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	io "io"
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CurrencyServicer is an autogenerated mock type for the CurrencyServicer type
+type CurrencyServicer struct {
+	mock.Mock
+}
+
+// ConvertProducts provides a mock function with given fields: currency, products
+func (_m *CurrencyServicer) ConvertProducts(currency string, products ...*models.Product) error {
+	_va := make([]interface{}, len(products))
+	for _i := range products {
+		_va[_i] = products[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, currency)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertProducts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...*models.Product) error); ok {
+		r0 = rf(currency, products...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRate provides a mock function with given fields: currency
+func (_m *CurrencyServicer) DeleteRate(currency string) error {
+	ret := _m.Called(currency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(currency)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ImportRates provides a mock function with given fields: file
+func (_m *CurrencyServicer) ImportRates(file io.Reader) (int, error) {
+	ret := _m.Called(file)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportRates")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(io.Reader) (int, error)); ok {
+		return rf(file)
+	}
+	if rf, ok := ret.Get(0).(func(io.Reader) int); ok {
+		r0 = rf(file)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(io.Reader) error); ok {
+		r1 = rf(file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRates provides a mock function with no fields
+func (_m *CurrencyServicer) ListRates() ([]models.ExchangeRate, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRates")
+	}
+
+	var r0 []models.ExchangeRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.ExchangeRate, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.ExchangeRate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ExchangeRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetRate provides a mock function with given fields: rate
+func (_m *CurrencyServicer) SetRate(rate *models.ExchangeRate) error {
+	ret := _m.Called(rate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ExchangeRate) error); ok {
+		r0 = rf(rate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCurrencyServicer creates a new instance of CurrencyServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCurrencyServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CurrencyServicer {
+	mock := &CurrencyServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
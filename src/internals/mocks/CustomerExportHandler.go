@@ -0,0 +1,32 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CustomerExportHandler is an autogenerated mock type for the CustomerExportHandler type
+type CustomerExportHandler struct {
+	mock.Mock
+}
+
+// Export provides a mock function with given fields: c
+func (_m *CustomerExportHandler) Export(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewCustomerExportHandler creates a new instance of CustomerExportHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCustomerExportHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CustomerExportHandler {
+	mock := &CustomerExportHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,48 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// CustomerExportQuerier is an autogenerated mock type for the CustomerExportQuerier type
+type CustomerExportQuerier struct {
+	mock.Mock
+}
+
+// StreamCustomers provides a mock function with given fields: updatedSince, batchSize, fn
+func (_m *CustomerExportQuerier) StreamCustomers(updatedSince *time.Time, batchSize int, fn func([]models.CustomerExportRow) error) error {
+	ret := _m.Called(updatedSince, batchSize, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamCustomers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*time.Time, int, func([]models.CustomerExportRow) error) error); ok {
+		r0 = rf(updatedSince, batchSize, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCustomerExportQuerier creates a new instance of CustomerExportQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCustomerExportQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CustomerExportQuerier {
+	mock := &CustomerExportQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	io "io"
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CustomerExportServicer is an autogenerated mock type for the CustomerExportServicer type
+type CustomerExportServicer struct {
+	mock.Mock
+}
+
+// ExportCustomers provides a mock function with given fields: w, options
+func (_m *CustomerExportServicer) ExportCustomers(w io.Writer, options models.CustomerExportOptions) (int, error) {
+	ret := _m.Called(w, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCustomers")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(io.Writer, models.CustomerExportOptions) (int, error)); ok {
+		return rf(w, options)
+	}
+	if rf, ok := ret.Get(0).(func(io.Writer, models.CustomerExportOptions) int); ok {
+		r0 = rf(w, options)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(io.Writer, models.CustomerExportOptions) error); ok {
+		r1 = rf(w, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCustomerExportServicer creates a new instance of CustomerExportServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCustomerExportServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CustomerExportServicer {
+	mock := &CustomerExportServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
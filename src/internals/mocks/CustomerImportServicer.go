@@ -0,0 +1,137 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	io "io"
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CustomerImportServicer is an autogenerated mock type for the CustomerImportServicer type
+type CustomerImportServicer struct {
+	mock.Mock
+}
+
+// GetJob provides a mock function with given fields: id
+func (_m *CustomerImportServicer) GetJob(id string) (*models.ImportJob, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJob")
+	}
+
+	var r0 *models.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.ImportJob, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.ImportJob); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImportJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportCustomers provides a mock function with given fields: filename, file
+func (_m *CustomerImportServicer) ImportCustomers(filename string, file io.Reader) (*models.ImportJob, error) {
+	ret := _m.Called(filename, file)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportCustomers")
+	}
+
+	var r0 *models.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) (*models.ImportJob, error)); ok {
+		return rf(filename, file)
+	}
+	if rf, ok := ret.Get(0).(func(string, io.Reader) *models.ImportJob); ok {
+		r0 = rf(filename, file)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImportJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, io.Reader) error); ok {
+		r1 = rf(filename, file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListJobErrors provides a mock function with given fields: id
+func (_m *CustomerImportServicer) ListJobErrors(id string) ([]models.ImportJobError, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListJobErrors")
+	}
+
+	var r0 []models.ImportJobError
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.ImportJobError, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.ImportJobError); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ImportJobError)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProcessPendingImports provides a mock function with no fields
+func (_m *CustomerImportServicer) ProcessPendingImports() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessPendingImports")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCustomerImportServicer creates a new instance of CustomerImportServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCustomerImportServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CustomerImportServicer {
+	mock := &CustomerImportServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
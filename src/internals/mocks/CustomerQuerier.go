@@ -49,6 +49,34 @@ func (_m *CustomerQuerier) Delete(id string) error {
 	return r0
 }
 
+// Exists provides a mock function with given fields: id
+func (_m *CustomerQuerier) Exists(id string) (bool, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByEmail provides a mock function with given fields: email
 func (_m *CustomerQuerier) GetByEmail(email string) (*models.Customer, error) {
 	ret := _m.Called(email)
@@ -0,0 +1,142 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ExchangeRateQuerier is an autogenerated mock type for the ExchangeRateQuerier type
+type ExchangeRateQuerier struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: currency
+func (_m *ExchangeRateQuerier) Delete(currency string) error {
+	ret := _m.Called(currency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(currency)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: currency
+func (_m *ExchangeRateQuerier) Get(currency string) (*models.ExchangeRate, error) {
+	ret := _m.Called(currency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *models.ExchangeRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.ExchangeRate, error)); ok {
+		return rf(currency)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.ExchangeRate); ok {
+		r0 = rf(currency)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ExchangeRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with no fields
+func (_m *ExchangeRateQuerier) List() ([]models.ExchangeRate, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []models.ExchangeRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.ExchangeRate, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.ExchangeRate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ExchangeRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: rate
+func (_m *ExchangeRateQuerier) Upsert(rate *models.ExchangeRate) error {
+	ret := _m.Called(rate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ExchangeRate) error); ok {
+		r0 = rf(rate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertMany provides a mock function with given fields: rates
+func (_m *ExchangeRateQuerier) UpsertMany(rates []models.ExchangeRate) error {
+	ret := _m.Called(rates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertMany")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]models.ExchangeRate) error); ok {
+		r0 = rf(rates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewExchangeRateQuerier creates a new instance of ExchangeRateQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExchangeRateQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExchangeRateQuerier {
+	mock := &ExchangeRateQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
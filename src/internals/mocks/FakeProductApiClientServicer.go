@@ -39,6 +39,36 @@ func (_m *FakeProductApiClientServicer) GetProduct(productID int32) ([]byte, err
 	return r0, r1
 }
 
+// ListCategories provides a mock function with no fields
+func (_m *FakeProductApiClientServicer) ListCategories() ([]byte, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategories")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]byte, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListProducts provides a mock function with no fields
 func (_m *FakeProductApiClientServicer) ListProducts() ([]byte, error) {
 	ret := _m.Called()
@@ -69,6 +99,36 @@ func (_m *FakeProductApiClientServicer) ListProducts() ([]byte, error) {
 	return r0, r1
 }
 
+// QueryProducts provides a mock function with given fields: category, limit, sort
+func (_m *FakeProductApiClientServicer) QueryProducts(category string, limit int, sort string) ([]byte, error) {
+	ret := _m.Called(category, limit, sort)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryProducts")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string) ([]byte, error)); ok {
+		return rf(category, limit, sort)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string) []byte); ok {
+		r0 = rf(category, limit, sort)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string) error); ok {
+		r1 = rf(category, limit, sort)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewFakeProductApiClientServicer creates a new instance of FakeProductApiClientServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFakeProductApiClientServicer(t interface {
@@ -0,0 +1,52 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FavoriteCategoryHandler is an autogenerated mock type for the FavoriteCategoryHandler type
+type FavoriteCategoryHandler struct {
+	mock.Mock
+}
+
+// AcknowledgeNew provides a mock function with given fields: c
+func (_m *FavoriteCategoryHandler) AcknowledgeNew(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Follow provides a mock function with given fields: c
+func (_m *FavoriteCategoryHandler) Follow(c *gin.Context) {
+	_m.Called(c)
+}
+
+// List provides a mock function with given fields: c
+func (_m *FavoriteCategoryHandler) List(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ListNew provides a mock function with given fields: c
+func (_m *FavoriteCategoryHandler) ListNew(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Unfollow provides a mock function with given fields: c
+func (_m *FavoriteCategoryHandler) Unfollow(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewFavoriteCategoryHandler creates a new instance of FavoriteCategoryHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFavoriteCategoryHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FavoriteCategoryHandler {
+	mock := &FavoriteCategoryHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
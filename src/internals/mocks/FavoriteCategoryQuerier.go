@@ -0,0 +1,154 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// FavoriteCategoryQuerier is an autogenerated mock type for the FavoriteCategoryQuerier type
+type FavoriteCategoryQuerier struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: favorite
+func (_m *FavoriteCategoryQuerier) Add(favorite *models.FavoriteCategory) error {
+	ret := _m.Called(favorite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.FavoriteCategory) error); ok {
+		r0 = rf(favorite)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: customerID, category
+func (_m *FavoriteCategoryQuerier) Delete(customerID string, category string) (bool, error) {
+	ret := _m.Called(customerID, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (bool, error)); ok {
+		return rf(customerID, category)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(customerID, category)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(customerID, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: customerID
+func (_m *FavoriteCategoryQuerier) List(customerID string) ([]models.FavoriteCategory, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []models.FavoriteCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.FavoriteCategory, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.FavoriteCategory); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FavoriteCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListNewProducts provides a mock function with given fields: customerID, until
+func (_m *FavoriteCategoryQuerier) ListNewProducts(customerID string, until time.Time) ([]models.Product, error) {
+	ret := _m.Called(customerID, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNewProducts")
+	}
+
+	var r0 []models.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) ([]models.Product, error)); ok {
+		return rf(customerID, until)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) []models.Product); ok {
+		r0 = rf(customerID, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(customerID, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkChecked provides a mock function with given fields: customerID, checkedAt
+func (_m *FavoriteCategoryQuerier) MarkChecked(customerID string, checkedAt time.Time) error {
+	ret := _m.Called(customerID, checkedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkChecked")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) error); ok {
+		r0 = rf(customerID, checkedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewFavoriteCategoryQuerier creates a new instance of FavoriteCategoryQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFavoriteCategoryQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FavoriteCategoryQuerier {
+	mock := &FavoriteCategoryQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,156 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// FavoriteCategoryServicer is an autogenerated mock type for the FavoriteCategoryServicer type
+type FavoriteCategoryServicer struct {
+	mock.Mock
+}
+
+// AcknowledgeNewProducts provides a mock function with given fields: customerID, cursor
+func (_m *FavoriteCategoryServicer) AcknowledgeNewProducts(customerID string, cursor time.Time) error {
+	ret := _m.Called(customerID, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcknowledgeNewProducts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) error); ok {
+		r0 = rf(customerID, cursor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowCategory provides a mock function with given fields: customerID, category
+func (_m *FavoriteCategoryServicer) FollowCategory(customerID string, category string) (*models.FavoriteCategory, error) {
+	ret := _m.Called(customerID, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowCategory")
+	}
+
+	var r0 *models.FavoriteCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*models.FavoriteCategory, error)); ok {
+		return rf(customerID, category)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *models.FavoriteCategory); ok {
+		r0 = rf(customerID, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.FavoriteCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(customerID, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCategories provides a mock function with given fields: customerID
+func (_m *FavoriteCategoryServicer) ListCategories(customerID string) ([]models.FavoriteCategory, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategories")
+	}
+
+	var r0 []models.FavoriteCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.FavoriteCategory, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.FavoriteCategory); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FavoriteCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListNewProducts provides a mock function with given fields: customerID
+func (_m *FavoriteCategoryServicer) ListNewProducts(customerID string) (*models.NewInFavoriteCategories, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNewProducts")
+	}
+
+	var r0 *models.NewInFavoriteCategories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.NewInFavoriteCategories, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.NewInFavoriteCategories); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NewInFavoriteCategories)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnfollowCategory provides a mock function with given fields: customerID, category
+func (_m *FavoriteCategoryServicer) UnfollowCategory(customerID string, category string) error {
+	ret := _m.Called(customerID, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnfollowCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(customerID, category)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewFavoriteCategoryServicer creates a new instance of FavoriteCategoryServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFavoriteCategoryServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FavoriteCategoryServicer {
+	mock := &FavoriteCategoryServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,42 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ImportJobHandler is an autogenerated mock type for the ImportJobHandler type
+type ImportJobHandler struct {
+	mock.Mock
+}
+
+// ErrorReport provides a mock function with given fields: c
+func (_m *ImportJobHandler) ErrorReport(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Get provides a mock function with given fields: c
+func (_m *ImportJobHandler) Get(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ImportCustomers provides a mock function with given fields: c
+func (_m *ImportJobHandler) ImportCustomers(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewImportJobHandler creates a new instance of ImportJobHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewImportJobHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ImportJobHandler {
+	mock := &ImportJobHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
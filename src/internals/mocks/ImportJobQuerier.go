@@ -0,0 +1,166 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ImportJobQuerier is an autogenerated mock type for the ImportJobQuerier type
+type ImportJobQuerier struct {
+	mock.Mock
+}
+
+// ClaimNext provides a mock function with given fields: staleBefore
+func (_m *ImportJobQuerier) ClaimNext(staleBefore time.Time) (*models.ImportJob, error) {
+	ret := _m.Called(staleBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimNext")
+	}
+
+	var r0 *models.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (*models.ImportJob, error)); ok {
+		return rf(staleBefore)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) *models.ImportJob); ok {
+		r0 = rf(staleBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImportJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(staleBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: job
+func (_m *ImportJobQuerier) Create(job *models.ImportJob) error {
+	ret := _m.Called(job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ImportJob) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: id
+func (_m *ImportJobQuerier) GetByID(id string) (*models.ImportJob, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *models.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.ImportJob, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.ImportJob); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImportJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListErrors provides a mock function with given fields: jobID
+func (_m *ImportJobQuerier) ListErrors(jobID string) ([]models.ImportJobError, error) {
+	ret := _m.Called(jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListErrors")
+	}
+
+	var r0 []models.ImportJobError
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.ImportJobError, error)); ok {
+		return rf(jobID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.ImportJobError); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ImportJobError)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveProgress provides a mock function with given fields: job, errors
+func (_m *ImportJobQuerier) SaveProgress(job *models.ImportJob, errors []models.ImportJobError) (bool, error) {
+	ret := _m.Called(job, errors)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveProgress")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.ImportJob, []models.ImportJobError) (bool, error)); ok {
+		return rf(job, errors)
+	}
+	if rf, ok := ret.Get(0).(func(*models.ImportJob, []models.ImportJobError) bool); ok {
+		r0 = rf(job, errors)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.ImportJob, []models.ImportJobError) error); ok {
+		r1 = rf(job, errors)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewImportJobQuerier creates a new instance of ImportJobQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewImportJobQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ImportJobQuerier {
+	mock := &ImportJobQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,52 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// LockQuerier is an autogenerated mock type for the LockQuerier type
+type LockQuerier struct {
+	mock.Mock
+}
+
+// TryWithLock provides a mock function with given fields: name, fn
+func (_m *LockQuerier) TryWithLock(name string, fn func() error) (bool, error) {
+	ret := _m.Called(name, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TryWithLock")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, func() error) (bool, error)); ok {
+		return rf(name, fn)
+	}
+	if rf, ok := ret.Get(0).(func(string, func() error) bool); ok {
+		r0 = rf(name, fn)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, func() error) error); ok {
+		r1 = rf(name, fn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewLockQuerier creates a new instance of LockQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLockQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LockQuerier {
+	mock := &LockQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
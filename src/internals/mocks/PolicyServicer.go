@@ -0,0 +1,124 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PolicyServicer is an autogenerated mock type for the PolicyServicer type
+type PolicyServicer struct {
+	mock.Mock
+}
+
+// Check provides a mock function with given fields: input
+func (_m *PolicyServicer) Check(input models.PolicyInput) error {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.PolicyInput) error); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRule provides a mock function with given fields: name
+func (_m *PolicyServicer) DeleteRule(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListRules provides a mock function with no fields
+func (_m *PolicyServicer) ListRules() ([]models.WishlistRule, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRules")
+	}
+
+	var r0 []models.WishlistRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.WishlistRule, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.WishlistRule); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveRule provides a mock function with given fields: rule
+func (_m *PolicyServicer) SaveRule(rule *models.WishlistRule) (*models.WishlistRule, error) {
+	ret := _m.Called(rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRule")
+	}
+
+	var r0 *models.WishlistRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.WishlistRule) (*models.WishlistRule, error)); ok {
+		return rf(rule)
+	}
+	if rf, ok := ret.Get(0).(func(*models.WishlistRule) *models.WishlistRule); ok {
+		r0 = rf(rule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WishlistRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.WishlistRule) error); ok {
+		r1 = rf(rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPolicyServicer creates a new instance of PolicyServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPolicyServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PolicyServicer {
+	mock := &PolicyServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ProductChangeQuerier is an autogenerated mock type for the ProductChangeQuerier type
+type ProductChangeQuerier struct {
+	mock.Mock
+}
+
+// ListSince provides a mock function with given fields: since, limit, offset
+func (_m *ProductChangeQuerier) ListSince(since time.Time, limit int, offset int) ([]models.ProductChange, error) {
+	ret := _m.Called(since, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSince")
+	}
+
+	var r0 []models.ProductChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int, int) ([]models.ProductChange, error)); ok {
+		return rf(since, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int, int) []models.ProductChange); ok {
+		r0 = rf(since, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ProductChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int, int) error); ok {
+		r1 = rf(since, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewProductChangeQuerier creates a new instance of ProductChangeQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProductChangeQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProductChangeQuerier {
+	mock := &ProductChangeQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -12,11 +12,31 @@ type ProductHandler struct {
 	mock.Mock
 }
 
+// Categories provides a mock function with given fields: c
+func (_m *ProductHandler) Categories(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Changes provides a mock function with given fields: c
+func (_m *ProductHandler) Changes(c *gin.Context) {
+	_m.Called(c)
+}
+
+// GetByID provides a mock function with given fields: c
+func (_m *ProductHandler) GetByID(c *gin.Context) {
+	_m.Called(c)
+}
+
 // List provides a mock function with given fields: c
 func (_m *ProductHandler) List(c *gin.Context) {
 	_m.Called(c)
 }
 
+// Search provides a mock function with given fields: c
+func (_m *ProductHandler) Search(c *gin.Context) {
+	_m.Called(c)
+}
+
 // NewProductHandler creates a new instance of ProductHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewProductHandler(t interface {
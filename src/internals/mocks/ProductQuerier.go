@@ -0,0 +1,199 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ProductQuerier is an autogenerated mock type for the ProductQuerier type
+type ProductQuerier struct {
+	mock.Mock
+}
+
+// AddPlaceholder provides a mock function with given fields: id
+func (_m *ProductQuerier) AddPlaceholder(id int32) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPlaceholder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: id
+func (_m *ProductQuerier) GetByID(id int32) (*models.Product, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *models.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32) (*models.Product, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int32) *models.Product); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAll provides a mock function with no fields
+func (_m *ProductQuerier) ListAll() ([]models.Product, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAll")
+	}
+
+	var r0 []models.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Product, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Product); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: search
+func (_m *ProductQuerier) Search(search models.ProductSearch) ([]models.ProductSearchResult, []models.CategoryFacet, error) {
+	ret := _m.Called(search)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []models.ProductSearchResult
+	var r1 []models.CategoryFacet
+	var r2 error
+	if rf, ok := ret.Get(0).(func(models.ProductSearch) ([]models.ProductSearchResult, []models.CategoryFacet, error)); ok {
+		return rf(search)
+	}
+	if rf, ok := ret.Get(0).(func(models.ProductSearch) []models.ProductSearchResult); ok {
+		r0 = rf(search)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ProductSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ProductSearch) []models.CategoryFacet); ok {
+		r1 = rf(search)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]models.CategoryFacet)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(models.ProductSearch) error); ok {
+		r2 = rf(search)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SyncCatalog provides a mock function with given fields: products, removedIDs, changes
+func (_m *ProductQuerier) SyncCatalog(products []models.Product, removedIDs []int32, changes []models.ProductChange) error {
+	ret := _m.Called(products, removedIDs, changes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncCatalog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]models.Product, []int32, []models.ProductChange) error); ok {
+		r0 = rf(products, removedIDs, changes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Upsert provides a mock function with given fields: product
+func (_m *ProductQuerier) Upsert(product *models.Product) error {
+	ret := _m.Called(product)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Product) error); ok {
+		r0 = rf(product)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertMany provides a mock function with given fields: products
+func (_m *ProductQuerier) UpsertMany(products []models.Product) error {
+	ret := _m.Called(products)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertMany")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]models.Product) error); ok {
+		r0 = rf(products)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewProductQuerier creates a new instance of ProductQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProductQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProductQuerier {
+	mock := &ProductQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -6,6 +6,8 @@ import (
 	models "produtos-favoritos/src/domain/models"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // ProductServicer is an autogenerated mock type for the ProductServicer type
@@ -13,6 +15,36 @@ type ProductServicer struct {
 	mock.Mock
 }
 
+// GetCategories provides a mock function with no fields
+func (_m *ProductServicer) GetCategories() ([]string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategories")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProductByID provides a mock function with given fields: productID
 func (_m *ProductServicer) GetProductByID(productID int32) (*models.Product, error) {
 	ret := _m.Called(productID)
@@ -43,6 +75,36 @@ func (_m *ProductServicer) GetProductByID(productID int32) (*models.Product, err
 	return r0, r1
 }
 
+// GetProductChanges provides a mock function with given fields: since, limit, offset
+func (_m *ProductServicer) GetProductChanges(since time.Time, limit int, offset int) (*models.ProductChangePage, error) {
+	ret := _m.Called(since, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductChanges")
+	}
+
+	var r0 *models.ProductChangePage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int, int) (*models.ProductChangePage, error)); ok {
+		return rf(since, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int, int) *models.ProductChangePage); ok {
+		r0 = rf(since, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProductChangePage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int, int) error); ok {
+		r1 = rf(since, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProducts provides a mock function with no fields
 func (_m *ProductServicer) GetProducts() ([]models.Product, error) {
 	ret := _m.Called()
@@ -73,6 +135,66 @@ func (_m *ProductServicer) GetProducts() ([]models.Product, error) {
 	return r0, r1
 }
 
+// ListProducts provides a mock function with given fields: filter
+func (_m *ProductServicer) ListProducts(filter models.ProductFilter) (*models.ProductPage, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProducts")
+	}
+
+	var r0 *models.ProductPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.ProductFilter) (*models.ProductPage, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.ProductFilter) *models.ProductPage); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProductPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ProductFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchProducts provides a mock function with given fields: search
+func (_m *ProductServicer) SearchProducts(search models.ProductSearch) (*models.ProductSearchPage, error) {
+	ret := _m.Called(search)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchProducts")
+	}
+
+	var r0 *models.ProductSearchPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.ProductSearch) (*models.ProductSearchPage, error)); ok {
+		return rf(search)
+	}
+	if rf, ok := ret.Get(0).(func(models.ProductSearch) *models.ProductSearchPage); ok {
+		r0 = rf(search)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProductSearchPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ProductSearch) error); ok {
+		r1 = rf(search)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewProductServicer creates a new instance of ProductServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewProductServicer(t interface {
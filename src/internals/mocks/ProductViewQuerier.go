@@ -0,0 +1,86 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ProductViewQuerier is an autogenerated mock type for the ProductViewQuerier type
+type ProductViewQuerier struct {
+	mock.Mock
+}
+
+// ListByCustomer provides a mock function with given fields: customerID, limit
+func (_m *ProductViewQuerier) ListByCustomer(customerID string, limit int) ([]models.ProductView, error) {
+	ret := _m.Called(customerID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByCustomer")
+	}
+
+	var r0 []models.ProductView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]models.ProductView, error)); ok {
+		return rf(customerID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []models.ProductView); ok {
+		r0 = rf(customerID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ProductView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(customerID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordViews provides a mock function with given fields: views, maxPerCustomer
+func (_m *ProductViewQuerier) RecordViews(views []models.ProductView, maxPerCustomer int) (int, error) {
+	ret := _m.Called(views, maxPerCustomer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordViews")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.ProductView, int) (int, error)); ok {
+		return rf(views, maxPerCustomer)
+	}
+	if rf, ok := ret.Get(0).(func([]models.ProductView, int) int); ok {
+		r0 = rf(views, maxPerCustomer)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.ProductView, int) error); ok {
+		r1 = rf(views, maxPerCustomer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewProductViewQuerier creates a new instance of ProductViewQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProductViewQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProductViewQuerier {
+	mock := &ProductViewQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,37 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RecentlyViewedHandler is an autogenerated mock type for the RecentlyViewedHandler type
+type RecentlyViewedHandler struct {
+	mock.Mock
+}
+
+// List provides a mock function with given fields: c
+func (_m *RecentlyViewedHandler) List(c *gin.Context) {
+	_m.Called(c)
+}
+
+// RecordView provides a mock function with given fields: c
+func (_m *RecentlyViewedHandler) RecordView(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewRecentlyViewedHandler creates a new instance of RecentlyViewedHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRecentlyViewedHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RecentlyViewedHandler {
+	mock := &RecentlyViewedHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
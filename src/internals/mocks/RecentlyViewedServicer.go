@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RecentlyViewedServicer is an autogenerated mock type for the RecentlyViewedServicer type
+type RecentlyViewedServicer struct {
+	mock.Mock
+}
+
+// Flush provides a mock function with no fields
+func (_m *RecentlyViewedServicer) Flush() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Flush")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListRecentlyViewed provides a mock function with given fields: customerID, limit
+func (_m *RecentlyViewedServicer) ListRecentlyViewed(customerID string, limit int) ([]models.ProductView, error) {
+	ret := _m.Called(customerID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRecentlyViewed")
+	}
+
+	var r0 []models.ProductView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]models.ProductView, error)); ok {
+		return rf(customerID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []models.ProductView); ok {
+		r0 = rf(customerID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ProductView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(customerID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordView provides a mock function with given fields: customerID, productID, viewedAt
+func (_m *RecentlyViewedServicer) RecordView(customerID string, productID int32, viewedAt time.Time) error {
+	ret := _m.Called(customerID, productID, viewedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32, time.Time) error); ok {
+		r0 = rf(customerID, productID, viewedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRecentlyViewedServicer creates a new instance of RecentlyViewedServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRecentlyViewedServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RecentlyViewedServicer {
+	mock := &RecentlyViewedServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
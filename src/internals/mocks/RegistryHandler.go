@@ -0,0 +1,67 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RegistryHandler is an autogenerated mock type for the RegistryHandler type
+type RegistryHandler struct {
+	mock.Mock
+}
+
+// CancelReservation provides a mock function with given fields: c
+func (_m *RegistryHandler) CancelReservation(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ConfirmReservation provides a mock function with given fields: c
+func (_m *RegistryHandler) ConfirmReservation(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Disable provides a mock function with given fields: c
+func (_m *RegistryHandler) Disable(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Enable provides a mock function with given fields: c
+func (_m *RegistryHandler) Enable(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Get provides a mock function with given fields: c
+func (_m *RegistryHandler) Get(c *gin.Context) {
+	_m.Called(c)
+}
+
+// GuestView provides a mock function with given fields: c
+func (_m *RegistryHandler) GuestView(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Reserve provides a mock function with given fields: c
+func (_m *RegistryHandler) Reserve(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SetItemQuantity provides a mock function with given fields: c
+func (_m *RegistryHandler) SetItemQuantity(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewRegistryHandler creates a new instance of RegistryHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRegistryHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RegistryHandler {
+	mock := &RegistryHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
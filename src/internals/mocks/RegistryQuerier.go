@@ -0,0 +1,278 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RegistryQuerier is an autogenerated mock type for the RegistryQuerier type
+type RegistryQuerier struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: customerID
+func (_m *RegistryQuerier) Delete(customerID string) error {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(customerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteExpiredReservations provides a mock function with given fields: now
+func (_m *RegistryQuerier) DeleteExpiredReservations(now time.Time) (int64, error) {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredReservations")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (int64, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteReservation provides a mock function with given fields: reservationID
+func (_m *RegistryQuerier) DeleteReservation(reservationID string) error {
+	ret := _m.Called(reservationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(reservationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByCustomer provides a mock function with given fields: customerID
+func (_m *RegistryQuerier) GetByCustomer(customerID string) (*models.WishlistRegistry, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCustomer")
+	}
+
+	var r0 *models.WishlistRegistry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.WishlistRegistry, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.WishlistRegistry); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WishlistRegistry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByToken provides a mock function with given fields: token
+func (_m *RegistryQuerier) GetByToken(token string) (*models.WishlistRegistry, error) {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByToken")
+	}
+
+	var r0 *models.WishlistRegistry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.WishlistRegistry, error)); ok {
+		return rf(token)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.WishlistRegistry); ok {
+		r0 = rf(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WishlistRegistry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReservation provides a mock function with given fields: customerID, reservationID
+func (_m *RegistryQuerier) GetReservation(customerID string, reservationID string) (*models.RegistryReservation, error) {
+	ret := _m.Called(customerID, reservationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReservation")
+	}
+
+	var r0 *models.RegistryReservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*models.RegistryReservation, error)); ok {
+		return rf(customerID, reservationID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *models.RegistryReservation); ok {
+		r0 = rf(customerID, reservationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RegistryReservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(customerID, reservationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reserve provides a mock function with given fields: reservation, now
+func (_m *RegistryQuerier) Reserve(reservation *models.RegistryReservation, now time.Time) (bool, error) {
+	ret := _m.Called(reservation, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.RegistryReservation, time.Time) (bool, error)); ok {
+		return rf(reservation, now)
+	}
+	if rf, ok := ret.Get(0).(func(*models.RegistryReservation, time.Time) bool); ok {
+		r0 = rf(reservation, now)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.RegistryReservation, time.Time) error); ok {
+		r1 = rf(reservation, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReservedQuantities provides a mock function with given fields: customerID, now
+func (_m *RegistryQuerier) ReservedQuantities(customerID string, now time.Time) (map[int32]int, error) {
+	ret := _m.Called(customerID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReservedQuantities")
+	}
+
+	var r0 map[int32]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (map[int32]int, error)); ok {
+		return rf(customerID, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) map[int32]int); ok {
+		r0 = rf(customerID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int32]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(customerID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: registry
+func (_m *RegistryQuerier) Save(registry *models.WishlistRegistry) error {
+	ret := _m.Called(registry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.WishlistRegistry) error); ok {
+		r0 = rf(registry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveReservation provides a mock function with given fields: reservation
+func (_m *RegistryQuerier) SaveReservation(reservation *models.RegistryReservation) error {
+	ret := _m.Called(reservation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.RegistryReservation) error); ok {
+		r0 = rf(reservation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRegistryQuerier creates a new instance of RegistryQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRegistryQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RegistryQuerier {
+	mock := &RegistryQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,232 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RegistryServicer is an autogenerated mock type for the RegistryServicer type
+type RegistryServicer struct {
+	mock.Mock
+}
+
+// CancelReservation provides a mock function with given fields: token, reservationID
+func (_m *RegistryServicer) CancelReservation(token string, reservationID string) error {
+	ret := _m.Called(token, reservationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(token, reservationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConfirmReservation provides a mock function with given fields: token, reservationID
+func (_m *RegistryServicer) ConfirmReservation(token string, reservationID string) (*models.RegistryReservation, error) {
+	ret := _m.Called(token, reservationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmReservation")
+	}
+
+	var r0 *models.RegistryReservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*models.RegistryReservation, error)); ok {
+		return rf(token, reservationID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *models.RegistryReservation); ok {
+		r0 = rf(token, reservationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RegistryReservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(token, reservationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableRegistry provides a mock function with given fields: customerID
+func (_m *RegistryServicer) DisableRegistry(customerID string) error {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableRegistry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(customerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnableRegistry provides a mock function with given fields: customerID, title
+func (_m *RegistryServicer) EnableRegistry(customerID string, title string) (*models.WishlistRegistry, error) {
+	ret := _m.Called(customerID, title)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableRegistry")
+	}
+
+	var r0 *models.WishlistRegistry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*models.WishlistRegistry, error)); ok {
+		return rf(customerID, title)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *models.WishlistRegistry); ok {
+		r0 = rf(customerID, title)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WishlistRegistry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(customerID, title)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGuestRegistry provides a mock function with given fields: token
+func (_m *RegistryServicer) GetGuestRegistry(token string) (*models.Registry, error) {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuestRegistry")
+	}
+
+	var r0 *models.Registry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.Registry, error)); ok {
+		return rf(token)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.Registry); ok {
+		r0 = rf(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Registry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOwnerRegistry provides a mock function with given fields: customerID
+func (_m *RegistryServicer) GetOwnerRegistry(customerID string) (*models.Registry, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOwnerRegistry")
+	}
+
+	var r0 *models.Registry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.Registry, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.Registry); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Registry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reserve provides a mock function with given fields: token, request
+func (_m *RegistryServicer) Reserve(token string, request models.ReservationRequest) (*models.RegistryReservation, error) {
+	ret := _m.Called(token, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 *models.RegistryReservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, models.ReservationRequest) (*models.RegistryReservation, error)); ok {
+		return rf(token, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, models.ReservationRequest) *models.RegistryReservation); ok {
+		r0 = rf(token, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RegistryReservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, models.ReservationRequest) error); ok {
+		r1 = rf(token, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetItemQuantity provides a mock function with given fields: customerID, productID, quantity
+func (_m *RegistryServicer) SetItemQuantity(customerID string, productID int32, quantity int) error {
+	ret := _m.Called(customerID, productID, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetItemQuantity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32, int) error); ok {
+		r0 = rf(customerID, productID, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRegistryServicer creates a new instance of RegistryServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRegistryServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RegistryServicer {
+	mock := &RegistryServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,52 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReminderHandler is an autogenerated mock type for the ReminderHandler type
+type ReminderHandler struct {
+	mock.Mock
+}
+
+// GetPreferences provides a mock function with given fields: c
+func (_m *ReminderHandler) GetPreferences(c *gin.Context) {
+	_m.Called(c)
+}
+
+// List provides a mock function with given fields: c
+func (_m *ReminderHandler) List(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ListPending provides a mock function with given fields: c
+func (_m *ReminderHandler) ListPending(c *gin.Context) {
+	_m.Called(c)
+}
+
+// MarkDelivered provides a mock function with given fields: c
+func (_m *ReminderHandler) MarkDelivered(c *gin.Context) {
+	_m.Called(c)
+}
+
+// UpdatePreferences provides a mock function with given fields: c
+func (_m *ReminderHandler) UpdatePreferences(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewReminderHandler creates a new instance of ReminderHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReminderHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReminderHandler {
+	mock := &ReminderHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
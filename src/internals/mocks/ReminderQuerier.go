@@ -0,0 +1,194 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ReminderQuerier is an autogenerated mock type for the ReminderQuerier type
+type ReminderQuerier struct {
+	mock.Mock
+}
+
+// CreateStaleReminders provides a mock function with given fields: addedBefore, limit
+func (_m *ReminderQuerier) CreateStaleReminders(addedBefore time.Time, limit int) (int64, error) {
+	ret := _m.Called(addedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStaleReminders")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int) (int64, error)); ok {
+		return rf(addedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int) int64); ok {
+		r0 = rf(addedBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int) error); ok {
+		r1 = rf(addedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPreference provides a mock function with given fields: customerID
+func (_m *ReminderQuerier) GetPreference(customerID string) (*models.ReminderPreference, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreference")
+	}
+
+	var r0 *models.ReminderPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.ReminderPreference, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.ReminderPreference); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ReminderPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByCustomer provides a mock function with given fields: customerID
+func (_m *ReminderQuerier) ListByCustomer(customerID string) ([]models.WishlistReminder, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByCustomer")
+	}
+
+	var r0 []models.WishlistReminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.WishlistReminder, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.WishlistReminder); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistReminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPending provides a mock function with given fields: limit
+func (_m *ReminderQuerier) ListPending(limit int) ([]models.WishlistReminder, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPending")
+	}
+
+	var r0 []models.WishlistReminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.WishlistReminder, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.WishlistReminder); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistReminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkDelivered provides a mock function with given fields: reminderID, deliveredAt
+func (_m *ReminderQuerier) MarkDelivered(reminderID string, deliveredAt time.Time) (bool, error) {
+	ret := _m.Called(reminderID, deliveredAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDelivered")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (bool, error)); ok {
+		return rf(reminderID, deliveredAt)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) bool); ok {
+		r0 = rf(reminderID, deliveredAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(reminderID, deliveredAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavePreference provides a mock function with given fields: preference
+func (_m *ReminderQuerier) SavePreference(preference *models.ReminderPreference) error {
+	ret := _m.Called(preference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePreference")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ReminderPreference) error); ok {
+		r0 = rf(preference)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewReminderQuerier creates a new instance of ReminderQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReminderQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReminderQuerier {
+	mock := &ReminderQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,166 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReminderServicer is an autogenerated mock type for the ReminderServicer type
+type ReminderServicer struct {
+	mock.Mock
+}
+
+// GetPreference provides a mock function with given fields: customerID
+func (_m *ReminderServicer) GetPreference(customerID string) (*models.ReminderPreference, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreference")
+	}
+
+	var r0 *models.ReminderPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.ReminderPreference, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.ReminderPreference); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ReminderPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPending provides a mock function with given fields: limit
+func (_m *ReminderServicer) ListPending(limit int) ([]models.WishlistReminder, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPending")
+	}
+
+	var r0 []models.WishlistReminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.WishlistReminder, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.WishlistReminder); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistReminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListReminders provides a mock function with given fields: customerID
+func (_m *ReminderServicer) ListReminders(customerID string) ([]models.WishlistReminder, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReminders")
+	}
+
+	var r0 []models.WishlistReminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.WishlistReminder, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.WishlistReminder); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistReminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkDelivered provides a mock function with given fields: reminderID
+func (_m *ReminderServicer) MarkDelivered(reminderID string) error {
+	ret := _m.Called(reminderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDelivered")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(reminderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePreference provides a mock function with given fields: customerID, staleItemsOptOut
+func (_m *ReminderServicer) UpdatePreference(customerID string, staleItemsOptOut bool) (*models.ReminderPreference, error) {
+	ret := _m.Called(customerID, staleItemsOptOut)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreference")
+	}
+
+	var r0 *models.ReminderPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool) (*models.ReminderPreference, error)); ok {
+		return rf(customerID, staleItemsOptOut)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool) *models.ReminderPreference); ok {
+		r0 = rf(customerID, staleItemsOptOut)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ReminderPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(customerID, staleItemsOptOut)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewReminderServicer creates a new instance of ReminderServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReminderServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReminderServicer {
+	mock := &ReminderServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
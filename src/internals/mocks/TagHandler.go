@@ -0,0 +1,47 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagHandler is an autogenerated mock type for the TagHandler type
+type TagHandler struct {
+	mock.Mock
+}
+
+// Attach provides a mock function with given fields: c
+func (_m *TagHandler) Attach(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Delete provides a mock function with given fields: c
+func (_m *TagHandler) Delete(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Detach provides a mock function with given fields: c
+func (_m *TagHandler) Detach(c *gin.Context) {
+	_m.Called(c)
+}
+
+// List provides a mock function with given fields: c
+func (_m *TagHandler) List(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewTagHandler creates a new instance of TagHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagHandler {
+	mock := &TagHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
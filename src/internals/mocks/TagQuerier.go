@@ -0,0 +1,192 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagQuerier is an autogenerated mock type for the TagQuerier type
+type TagQuerier struct {
+	mock.Mock
+}
+
+// Attach provides a mock function with given fields: customerID, productID, names
+func (_m *TagQuerier) Attach(customerID string, productID int32, names []string) error {
+	ret := _m.Called(customerID, productID, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attach")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32, []string) error); ok {
+		r0 = rf(customerID, productID, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: customerID, name
+func (_m *TagQuerier) Delete(customerID string, name string) (bool, error) {
+	ret := _m.Called(customerID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (bool, error)); ok {
+		return rf(customerID, name)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(customerID, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(customerID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Detach provides a mock function with given fields: customerID, productID, name
+func (_m *TagQuerier) Detach(customerID string, productID int32, name string) (bool, error) {
+	ret := _m.Called(customerID, productID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Detach")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int32, string) (bool, error)); ok {
+		return rf(customerID, productID, name)
+	}
+	if rf, ok := ret.Get(0).(func(string, int32, string) bool); ok {
+		r0 = rf(customerID, productID, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int32, string) error); ok {
+		r1 = rf(customerID, productID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsage provides a mock function with given fields: customerID
+func (_m *TagQuerier) ListUsage(customerID string) ([]models.TagUsage, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsage")
+	}
+
+	var r0 []models.TagUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.TagUsage, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.TagUsage); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TagUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductIDsByTags provides a mock function with given fields: customerID, names, matchAll
+func (_m *TagQuerier) ProductIDsByTags(customerID string, names []string, matchAll bool) ([]int32, error) {
+	ret := _m.Called(customerID, names, matchAll)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductIDsByTags")
+	}
+
+	var r0 []int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string, bool) ([]int32, error)); ok {
+		return rf(customerID, names, matchAll)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string, bool) []int32); ok {
+		r0 = rf(customerID, names, matchAll)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string, bool) error); ok {
+		r1 = rf(customerID, names, matchAll)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagsByProduct provides a mock function with given fields: customerID
+func (_m *TagQuerier) TagsByProduct(customerID string) (map[int32][]string, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagsByProduct")
+	}
+
+	var r0 map[int32][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[int32][]string, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[int32][]string); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int32][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTagQuerier creates a new instance of TagQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagQuerier {
+	mock := &TagQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
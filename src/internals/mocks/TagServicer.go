@@ -0,0 +1,124 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagServicer is an autogenerated mock type for the TagServicer type
+type TagServicer struct {
+	mock.Mock
+}
+
+// AttachTags provides a mock function with given fields: customerID, productID, names
+func (_m *TagServicer) AttachTags(customerID string, productID int32, names []string) ([]string, error) {
+	ret := _m.Called(customerID, productID, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int32, []string) ([]string, error)); ok {
+		return rf(customerID, productID, names)
+	}
+	if rf, ok := ret.Get(0).(func(string, int32, []string) []string); ok {
+		r0 = rf(customerID, productID, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int32, []string) error); ok {
+		r1 = rf(customerID, productID, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTag provides a mock function with given fields: customerID, name
+func (_m *TagServicer) DeleteTag(customerID string, name string) error {
+	ret := _m.Called(customerID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(customerID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DetachTag provides a mock function with given fields: customerID, productID, name
+func (_m *TagServicer) DetachTag(customerID string, productID int32, name string) error {
+	ret := _m.Called(customerID, productID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32, string) error); ok {
+		r0 = rf(customerID, productID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTags provides a mock function with given fields: customerID
+func (_m *TagServicer) ListTags(customerID string) ([]models.TagUsage, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTags")
+	}
+
+	var r0 []models.TagUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.TagUsage, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.TagUsage); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TagUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTagServicer creates a new instance of TagServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagServicer {
+	mock := &TagServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
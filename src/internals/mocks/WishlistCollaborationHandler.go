@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WishlistCollaborationHandler is an autogenerated mock type for the WishlistCollaborationHandler type
+type WishlistCollaborationHandler struct {
+	mock.Mock
+}
+
+// AcceptInvitation provides a mock function with given fields: c
+func (_m *WishlistCollaborationHandler) AcceptInvitation(c *gin.Context) {
+	_m.Called(c)
+}
+
+// DeclineInvitation provides a mock function with given fields: c
+func (_m *WishlistCollaborationHandler) DeclineInvitation(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Invite provides a mock function with given fields: c
+func (_m *WishlistCollaborationHandler) Invite(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ListCollaborators provides a mock function with given fields: c
+func (_m *WishlistCollaborationHandler) ListCollaborators(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ListInvitations provides a mock function with given fields: c
+func (_m *WishlistCollaborationHandler) ListInvitations(c *gin.Context) {
+	_m.Called(c)
+}
+
+// RemoveCollaborator provides a mock function with given fields: c
+func (_m *WishlistCollaborationHandler) RemoveCollaborator(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewWishlistCollaborationHandler creates a new instance of WishlistCollaborationHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWishlistCollaborationHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WishlistCollaborationHandler {
+	mock := &WishlistCollaborationHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
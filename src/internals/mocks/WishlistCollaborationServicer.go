@@ -0,0 +1,166 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WishlistCollaborationServicer is an autogenerated mock type for the WishlistCollaborationServicer type
+type WishlistCollaborationServicer struct {
+	mock.Mock
+}
+
+// Invite provides a mock function with given fields: ownerID, actorID, invite
+func (_m *WishlistCollaborationServicer) Invite(ownerID string, actorID string, invite models.WishlistInvite) (*models.WishlistCollaborator, error) {
+	ret := _m.Called(ownerID, actorID, invite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invite")
+	}
+
+	var r0 *models.WishlistCollaborator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, models.WishlistInvite) (*models.WishlistCollaborator, error)); ok {
+		return rf(ownerID, actorID, invite)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, models.WishlistInvite) *models.WishlistCollaborator); ok {
+		r0 = rf(ownerID, actorID, invite)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WishlistCollaborator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, models.WishlistInvite) error); ok {
+		r1 = rf(ownerID, actorID, invite)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCollaborators provides a mock function with given fields: ownerID
+func (_m *WishlistCollaborationServicer) ListCollaborators(ownerID string) ([]models.WishlistCollaborator, error) {
+	ret := _m.Called(ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCollaborators")
+	}
+
+	var r0 []models.WishlistCollaborator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.WishlistCollaborator, error)); ok {
+		return rf(ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.WishlistCollaborator); ok {
+		r0 = rf(ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistCollaborator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListInvitations provides a mock function with given fields: customerID
+func (_m *WishlistCollaborationServicer) ListInvitations(customerID string) ([]models.WishlistCollaborator, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInvitations")
+	}
+
+	var r0 []models.WishlistCollaborator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.WishlistCollaborator, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.WishlistCollaborator); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistCollaborator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveCollaborator provides a mock function with given fields: ownerID, actorID, collaboratorID
+func (_m *WishlistCollaborationServicer) RemoveCollaborator(ownerID string, actorID string, collaboratorID string) error {
+	ret := _m.Called(ownerID, actorID, collaboratorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCollaborator")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(ownerID, actorID, collaboratorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RespondInvitation provides a mock function with given fields: customerID, actorID, ownerID, accept
+func (_m *WishlistCollaborationServicer) RespondInvitation(customerID string, actorID string, ownerID string, accept bool) (*models.WishlistCollaborator, error) {
+	ret := _m.Called(customerID, actorID, ownerID, accept)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RespondInvitation")
+	}
+
+	var r0 *models.WishlistCollaborator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, bool) (*models.WishlistCollaborator, error)); ok {
+		return rf(customerID, actorID, ownerID, accept)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, bool) *models.WishlistCollaborator); ok {
+		r0 = rf(customerID, actorID, ownerID, accept)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WishlistCollaborator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, bool) error); ok {
+		r1 = rf(customerID, actorID, ownerID, accept)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWishlistCollaborationServicer creates a new instance of WishlistCollaborationServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWishlistCollaborationServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WishlistCollaborationServicer {
+	mock := &WishlistCollaborationServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
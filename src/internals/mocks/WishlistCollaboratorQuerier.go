@@ -0,0 +1,154 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WishlistCollaboratorQuerier is an autogenerated mock type for the WishlistCollaboratorQuerier type
+type WishlistCollaboratorQuerier struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ownerID, collaboratorID
+func (_m *WishlistCollaboratorQuerier) Get(ownerID string, collaboratorID string) (*models.WishlistCollaborator, error) {
+	ret := _m.Called(ownerID, collaboratorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *models.WishlistCollaborator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*models.WishlistCollaborator, error)); ok {
+		return rf(ownerID, collaboratorID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *models.WishlistCollaborator); ok {
+		r0 = rf(ownerID, collaboratorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WishlistCollaborator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(ownerID, collaboratorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByCollaborator provides a mock function with given fields: collaboratorID
+func (_m *WishlistCollaboratorQuerier) ListByCollaborator(collaboratorID string) ([]models.WishlistCollaborator, error) {
+	ret := _m.Called(collaboratorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByCollaborator")
+	}
+
+	var r0 []models.WishlistCollaborator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.WishlistCollaborator, error)); ok {
+		return rf(collaboratorID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.WishlistCollaborator); ok {
+		r0 = rf(collaboratorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistCollaborator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(collaboratorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByOwner provides a mock function with given fields: ownerID
+func (_m *WishlistCollaboratorQuerier) ListByOwner(ownerID string) ([]models.WishlistCollaborator, error) {
+	ret := _m.Called(ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByOwner")
+	}
+
+	var r0 []models.WishlistCollaborator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.WishlistCollaborator, error)); ok {
+		return rf(ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.WishlistCollaborator); ok {
+		r0 = rf(ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistCollaborator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Remove provides a mock function with given fields: ownerID, collaboratorID
+func (_m *WishlistCollaboratorQuerier) Remove(ownerID string, collaboratorID string) error {
+	ret := _m.Called(ownerID, collaboratorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(ownerID, collaboratorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Save provides a mock function with given fields: collaborator
+func (_m *WishlistCollaboratorQuerier) Save(collaborator *models.WishlistCollaborator) error {
+	ret := _m.Called(collaborator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.WishlistCollaborator) error); ok {
+		r0 = rf(collaborator)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewWishlistCollaboratorQuerier creates a new instance of WishlistCollaboratorQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWishlistCollaboratorQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WishlistCollaboratorQuerier {
+	mock := &WishlistCollaboratorQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,226 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WishlistQuerier is an autogenerated mock type for the WishlistQuerier type
+type WishlistQuerier struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: item
+func (_m *WishlistQuerier) Add(item *models.WishlistItem) error {
+	ret := _m.Called(item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.WishlistItem) error); ok {
+		r0 = rf(item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListByCustomer provides a mock function with given fields: customerID
+func (_m *WishlistQuerier) ListByCustomer(customerID string) ([]models.WishlistItem, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByCustomer")
+	}
+
+	var r0 []models.WishlistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.WishlistItem, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.WishlistItem); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByStatus provides a mock function with given fields: status, limit
+func (_m *WishlistQuerier) ListByStatus(status string, limit int) ([]models.WishlistItem, error) {
+	ret := _m.Called(status, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByStatus")
+	}
+
+	var r0 []models.WishlistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]models.WishlistItem, error)); ok {
+		return rf(status, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []models.WishlistItem); ok {
+		r0 = rf(status, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(status, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMemberships provides a mock function with given fields: customerID, productIDs
+func (_m *WishlistQuerier) ListMemberships(customerID string, productIDs []int32) (map[int32][]string, error) {
+	ret := _m.Called(customerID, productIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMemberships")
+	}
+
+	var r0 map[int32][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []int32) (map[int32][]string, error)); ok {
+		return rf(customerID, productIDs)
+	}
+	if rf, ok := ret.Get(0).(func(string, []int32) map[int32][]string); ok {
+		r0 = rf(customerID, productIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int32][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []int32) error); ok {
+		r1 = rf(customerID, productIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Remove provides a mock function with given fields: customerID, productID
+func (_m *WishlistQuerier) Remove(customerID string, productID int32) error {
+	ret := _m.Called(customerID, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32) error); ok {
+		r0 = rf(customerID, productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAddedPrice provides a mock function with given fields: customerID, productID, price
+func (_m *WishlistQuerier) UpdateAddedPrice(customerID string, productID int32, price models.Money) error {
+	ret := _m.Called(customerID, productID, price)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAddedPrice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32, models.Money) error); ok {
+		r0 = rf(customerID, productID, price)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePositions provides a mock function with given fields: customerID, positions
+func (_m *WishlistQuerier) UpdatePositions(customerID string, positions map[int32]string) error {
+	ret := _m.Called(customerID, positions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePositions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, map[int32]string) error); ok {
+		r0 = rf(customerID, positions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateQuantity provides a mock function with given fields: customerID, productID, quantity
+func (_m *WishlistQuerier) UpdateQuantity(customerID string, productID int32, quantity int) error {
+	ret := _m.Called(customerID, productID, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateQuantity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32, int) error); ok {
+		r0 = rf(customerID, productID, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateStatus provides a mock function with given fields: customerID, productID, status
+func (_m *WishlistQuerier) UpdateStatus(customerID string, productID int32, status string) error {
+	ret := _m.Called(customerID, productID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32, string) error); ok {
+		r0 = rf(customerID, productID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewWishlistQuerier creates a new instance of WishlistQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWishlistQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WishlistQuerier {
+	mock := &WishlistQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,42 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WishlistRuleHandler is an autogenerated mock type for the WishlistRuleHandler type
+type WishlistRuleHandler struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: c
+func (_m *WishlistRuleHandler) Delete(c *gin.Context) {
+	_m.Called(c)
+}
+
+// List provides a mock function with given fields: c
+func (_m *WishlistRuleHandler) List(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Save provides a mock function with given fields: c
+func (_m *WishlistRuleHandler) Save(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewWishlistRuleHandler creates a new instance of WishlistRuleHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWishlistRuleHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WishlistRuleHandler {
+	mock := &WishlistRuleHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
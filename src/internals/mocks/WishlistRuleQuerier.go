@@ -0,0 +1,134 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WishlistRuleQuerier is an autogenerated mock type for the WishlistRuleQuerier type
+type WishlistRuleQuerier struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: name
+func (_m *WishlistRuleQuerier) Delete(name string) (bool, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with no fields
+func (_m *WishlistRuleQuerier) List() ([]models.WishlistRule, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []models.WishlistRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.WishlistRule, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.WishlistRule); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEnabled provides a mock function with no fields
+func (_m *WishlistRuleQuerier) ListEnabled() ([]models.WishlistRule, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEnabled")
+	}
+
+	var r0 []models.WishlistRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.WishlistRule, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.WishlistRule); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: rule
+func (_m *WishlistRuleQuerier) Save(rule *models.WishlistRule) error {
+	ret := _m.Called(rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.WishlistRule) error); ok {
+		r0 = rf(rule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewWishlistRuleQuerier creates a new instance of WishlistRuleQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWishlistRuleQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WishlistRuleQuerier {
+	mock := &WishlistRuleQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,24 +2,186 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	io "io"
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // WishlistServicer is an autogenerated mock type for the WishlistServicer type
 type WishlistServicer struct {
 	mock.Mock
 }
 
-// RemoveProductFromWishlist provides a mock function with given fields: customerID, productID
-func (_m *WishlistServicer) RemoveProductFromWishlist(customerID string, productID int32) error {
-	ret := _m.Called(customerID, productID)
+// CompareProducts provides a mock function with given fields: customerID, actorID, productIDs
+func (_m *WishlistServicer) CompareProducts(customerID string, actorID string, productIDs []int32) (*models.ProductComparison, error) {
+	ret := _m.Called(customerID, actorID, productIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveProductFromWishlist")
+		panic("no return value specified for CompareProducts")
+	}
+
+	var r0 *models.ProductComparison
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, []int32) (*models.ProductComparison, error)); ok {
+		return rf(customerID, actorID, productIDs)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, []int32) *models.ProductComparison); ok {
+		r0 = rf(customerID, actorID, productIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProductComparison)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, []int32) error); ok {
+		r1 = rf(customerID, actorID, productIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportWishlist provides a mock function with given fields: customerID, actorID
+func (_m *WishlistServicer) ExportWishlist(customerID string, actorID string) ([]models.WishlistExportItem, error) {
+	ret := _m.Called(customerID, actorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportWishlist")
+	}
+
+	var r0 []models.WishlistExportItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.WishlistExportItem, error)); ok {
+		return rf(customerID, actorID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []models.WishlistExportItem); ok {
+		r0 = rf(customerID, actorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistExportItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(customerID, actorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWishlist provides a mock function with given fields: customerID, actorID, filter
+func (_m *WishlistServicer) GetWishlist(customerID string, actorID string, filter models.WishlistFilter) (*models.Wishlist, error) {
+	ret := _m.Called(customerID, actorID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWishlist")
+	}
+
+	var r0 *models.Wishlist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, models.WishlistFilter) (*models.Wishlist, error)); ok {
+		return rf(customerID, actorID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, models.WishlistFilter) *models.Wishlist); ok {
+		r0 = rf(customerID, actorID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Wishlist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, models.WishlistFilter) error); ok {
+		r1 = rf(customerID, actorID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWishlistSummary provides a mock function with given fields: customerID, actorID
+func (_m *WishlistServicer) GetWishlistSummary(customerID string, actorID string) (*models.WishlistSummary, error) {
+	ret := _m.Called(customerID, actorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWishlistSummary")
+	}
+
+	var r0 *models.WishlistSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*models.WishlistSummary, error)); ok {
+		return rf(customerID, actorID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *models.WishlistSummary); ok {
+		r0 = rf(customerID, actorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WishlistSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(customerID, actorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportWishlist provides a mock function with given fields: customerID, actorID, format, file, dryRun, partnerKey
+func (_m *WishlistServicer) ImportWishlist(customerID string, actorID string, format string, file io.Reader, dryRun bool, partnerKey string) (*models.WishlistImportReport, error) {
+	ret := _m.Called(customerID, actorID, format, file, dryRun, partnerKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportWishlist")
+	}
+
+	var r0 *models.WishlistImportReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, io.Reader, bool, string) (*models.WishlistImportReport, error)); ok {
+		return rf(customerID, actorID, format, file, dryRun, partnerKey)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, io.Reader, bool, string) *models.WishlistImportReport); ok {
+		r0 = rf(customerID, actorID, format, file, dryRun, partnerKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WishlistImportReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, io.Reader, bool, string) error); ok {
+		r1 = rf(customerID, actorID, format, file, dryRun, partnerKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkWishlisted provides a mock function with given fields: customerID, products
+func (_m *WishlistServicer) MarkWishlisted(customerID string, products ...*models.Product) error {
+	_va := make([]interface{}, len(products))
+	for _i := range products {
+		_va[_i] = products[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, customerID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkWishlisted")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, int32) error); ok {
-		r0 = rf(customerID, productID)
+	if rf, ok := ret.Get(0).(func(string, ...*models.Product) error); ok {
+		r0 = rf(customerID, products...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -27,17 +189,47 @@ func (_m *WishlistServicer) RemoveProductFromWishlist(customerID string, product
 	return r0
 }
 
-// WishlistProduct provides a mock function with given fields: productID, customerID
-func (_m *WishlistServicer) WishlistProduct(productID int32, customerID string) error {
-	ret := _m.Called(productID, customerID)
+// MoveItem provides a mock function with given fields: customerID, productID, position, actorID
+func (_m *WishlistServicer) MoveItem(customerID string, productID int32, position int, actorID string) (*models.Wishlist, error) {
+	ret := _m.Called(customerID, productID, position, actorID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for WishlistProduct")
+		panic("no return value specified for MoveItem")
+	}
+
+	var r0 *models.Wishlist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int32, int, string) (*models.Wishlist, error)); ok {
+		return rf(customerID, productID, position, actorID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int32, int, string) *models.Wishlist); ok {
+		r0 = rf(customerID, productID, position, actorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Wishlist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int32, int, string) error); ok {
+		r1 = rf(customerID, productID, position, actorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveProductFromWishlist provides a mock function with given fields: customerID, productID, actorID, partnerKey
+func (_m *WishlistServicer) RemoveProductFromWishlist(customerID string, productID int32, actorID string, partnerKey string) error {
+	ret := _m.Called(customerID, productID, actorID, partnerKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveProductFromWishlist")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int32, string) error); ok {
-		r0 = rf(productID, customerID)
+	if rf, ok := ret.Get(0).(func(string, int32, string, string) error); ok {
+		r0 = rf(customerID, productID, actorID, partnerKey)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -45,6 +237,66 @@ func (_m *WishlistServicer) WishlistProduct(productID int32, customerID string)
 	return r0
 }
 
+// ReorderItems provides a mock function with given fields: customerID, productIDs, actorID
+func (_m *WishlistServicer) ReorderItems(customerID string, productIDs []int32, actorID string) (*models.Wishlist, error) {
+	ret := _m.Called(customerID, productIDs, actorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderItems")
+	}
+
+	var r0 *models.Wishlist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []int32, string) (*models.Wishlist, error)); ok {
+		return rf(customerID, productIDs, actorID)
+	}
+	if rf, ok := ret.Get(0).(func(string, []int32, string) *models.Wishlist); ok {
+		r0 = rf(customerID, productIDs, actorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Wishlist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []int32, string) error); ok {
+		r1 = rf(customerID, productIDs, actorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WishlistProduct provides a mock function with given fields: productID, customerID, actorID, partnerKey
+func (_m *WishlistServicer) WishlistProduct(productID int32, customerID string, actorID string, partnerKey string) (*models.WishlistItem, error) {
+	ret := _m.Called(productID, customerID, actorID, partnerKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WishlistProduct")
+	}
+
+	var r0 *models.WishlistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32, string, string, string) (*models.WishlistItem, error)); ok {
+		return rf(productID, customerID, actorID, partnerKey)
+	}
+	if rf, ok := ret.Get(0).(func(int32, string, string, string) *models.WishlistItem); ok {
+		r0 = rf(productID, customerID, actorID, partnerKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WishlistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32, string, string, string) error); ok {
+		r1 = rf(productID, customerID, actorID, partnerKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewWishlistServicer creates a new instance of WishlistServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWishlistServicer(t interface {
@@ -0,0 +1,42 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WishlistTrashHandler is an autogenerated mock type for the WishlistTrashHandler type
+type WishlistTrashHandler struct {
+	mock.Mock
+}
+
+// Empty provides a mock function with given fields: c
+func (_m *WishlistTrashHandler) Empty(c *gin.Context) {
+	_m.Called(c)
+}
+
+// List provides a mock function with given fields: c
+func (_m *WishlistTrashHandler) List(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Restore provides a mock function with given fields: c
+func (_m *WishlistTrashHandler) Restore(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewWishlistTrashHandler creates a new instance of WishlistTrashHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWishlistTrashHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WishlistTrashHandler {
+	mock := &WishlistTrashHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
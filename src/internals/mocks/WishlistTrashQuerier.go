@@ -0,0 +1,184 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// WishlistTrashQuerier is an autogenerated mock type for the WishlistTrashQuerier type
+type WishlistTrashQuerier struct {
+	mock.Mock
+}
+
+// DeleteExpired provides a mock function with given fields: now
+func (_m *WishlistTrashQuerier) DeleteExpired(now time.Time) (int64, error) {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpired")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (int64, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Empty provides a mock function with given fields: customerID
+func (_m *WishlistTrashQuerier) Empty(customerID string) (int64, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Empty")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(customerID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: customerID, productID, now
+func (_m *WishlistTrashQuerier) Get(customerID string, productID int32, now time.Time) (*models.TrashedWishlistItem, error) {
+	ret := _m.Called(customerID, productID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *models.TrashedWishlistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int32, time.Time) (*models.TrashedWishlistItem, error)); ok {
+		return rf(customerID, productID, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, int32, time.Time) *models.TrashedWishlistItem); ok {
+		r0 = rf(customerID, productID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TrashedWishlistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int32, time.Time) error); ok {
+		r1 = rf(customerID, productID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByCustomer provides a mock function with given fields: customerID, now
+func (_m *WishlistTrashQuerier) ListByCustomer(customerID string, now time.Time) ([]models.TrashedWishlistItem, error) {
+	ret := _m.Called(customerID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByCustomer")
+	}
+
+	var r0 []models.TrashedWishlistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) ([]models.TrashedWishlistItem, error)); ok {
+		return rf(customerID, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) []models.TrashedWishlistItem); ok {
+		r0 = rf(customerID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TrashedWishlistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(customerID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: entry
+func (_m *WishlistTrashQuerier) Restore(entry *models.TrashedWishlistItem) error {
+	ret := _m.Called(entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.TrashedWishlistItem) error); ok {
+		r0 = rf(entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Trash provides a mock function with given fields: customerID, productID, removedBy, expiresAt
+func (_m *WishlistTrashQuerier) Trash(customerID string, productID int32, removedBy *uuid.UUID, expiresAt time.Time) error {
+	ret := _m.Called(customerID, productID, removedBy, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Trash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32, *uuid.UUID, time.Time) error); ok {
+		r0 = rf(customerID, productID, removedBy, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewWishlistTrashQuerier creates a new instance of WishlistTrashQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWishlistTrashQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WishlistTrashQuerier {
+	mock := &WishlistTrashQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
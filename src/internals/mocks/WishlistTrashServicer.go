@@ -0,0 +1,106 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "produtos-favoritos/src/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WishlistTrashServicer is an autogenerated mock type for the WishlistTrashServicer type
+type WishlistTrashServicer struct {
+	mock.Mock
+}
+
+// EmptyTrash provides a mock function with given fields: customerID, actorID
+func (_m *WishlistTrashServicer) EmptyTrash(customerID string, actorID string) error {
+	ret := _m.Called(customerID, actorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmptyTrash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(customerID, actorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTrash provides a mock function with given fields: customerID, actorID
+func (_m *WishlistTrashServicer) ListTrash(customerID string, actorID string) ([]models.TrashedWishlistItem, error) {
+	ret := _m.Called(customerID, actorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrash")
+	}
+
+	var r0 []models.TrashedWishlistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.TrashedWishlistItem, error)); ok {
+		return rf(customerID, actorID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []models.TrashedWishlistItem); ok {
+		r0 = rf(customerID, actorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TrashedWishlistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(customerID, actorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreItem provides a mock function with given fields: customerID, productID, actorID, partnerKey
+func (_m *WishlistTrashServicer) RestoreItem(customerID string, productID int32, actorID string, partnerKey string) (*models.WishlistItem, error) {
+	ret := _m.Called(customerID, productID, actorID, partnerKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreItem")
+	}
+
+	var r0 *models.WishlistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int32, string, string) (*models.WishlistItem, error)); ok {
+		return rf(customerID, productID, actorID, partnerKey)
+	}
+	if rf, ok := ret.Get(0).(func(string, int32, string, string) *models.WishlistItem); ok {
+		r0 = rf(customerID, productID, actorID, partnerKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WishlistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int32, string, string) error); ok {
+		r1 = rf(customerID, productID, actorID, partnerKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWishlistTrashServicer creates a new instance of WishlistTrashServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWishlistTrashServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WishlistTrashServicer {
+	mock := &WishlistTrashServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
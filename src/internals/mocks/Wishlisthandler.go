@@ -12,11 +12,46 @@ type WishlistHandler struct {
 	mock.Mock
 }
 
+// Compare provides a mock function with given fields: c
+func (_m *WishlistHandler) Compare(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Export provides a mock function with given fields: c
+func (_m *WishlistHandler) Export(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Import provides a mock function with given fields: c
+func (_m *WishlistHandler) Import(c *gin.Context) {
+	_m.Called(c)
+}
+
+// List provides a mock function with given fields: c
+func (_m *WishlistHandler) List(c *gin.Context) {
+	_m.Called(c)
+}
+
+// MoveItem provides a mock function with given fields: c
+func (_m *WishlistHandler) MoveItem(c *gin.Context) {
+	_m.Called(c)
+}
+
 // RemoveFromWishlist provides a mock function with given fields: c
 func (_m *WishlistHandler) RemoveFromWishlist(c *gin.Context) {
 	_m.Called(c)
 }
 
+// Reorder provides a mock function with given fields: c
+func (_m *WishlistHandler) Reorder(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Summary provides a mock function with given fields: c
+func (_m *WishlistHandler) Summary(c *gin.Context) {
+	_m.Called(c)
+}
+
 // WishlistProduct provides a mock function with given fields: c
 func (_m *WishlistHandler) WishlistProduct(c *gin.Context) {
 	_m.Called(c)
@@ -0,0 +1,76 @@
+// Package rules compiles the conditions used by the wishlist policies with
+// CEL (https://github.com/google/cel-go). An expression is a condition that
+// has to hold:
+//
+//	customer.wishlist_count < 100
+//	product.price >= 5 || action == "remove"
+//	!(product.category == "electronics" && request.partner_key in ["acme", "globex"])
+//
+// Expressions are type checked against the declared variables when they are
+// compiled, ints and doubles can be compared with each other.
+package rules
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+)
+
+// Variables declares the names an expression can read, dotted names are
+// read as a whole (customer.wishlist_count)
+type Variables map[string]*cel.Type
+
+// Compiler type checks expressions against the variables
+type Compiler struct {
+	env *cel.Env
+}
+
+func NewCompiler(variables Variables) (*Compiler, error) {
+	options := []cel.EnvOption{cel.CrossTypeNumericComparisons(true)}
+	for name, variableType := range variables {
+		options = append(options, cel.Variable(name, variableType))
+	}
+	env, err := cel.NewEnv(options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Compiler{env: env}, nil
+}
+
+type Expression struct {
+	source  string
+	program cel.Program
+}
+
+// Compile parses and checks the source, which has to be a condition
+func (c *Compiler) Compile(source string) (*Expression, error) {
+	ast, issues := c.env.Compile(source)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if !ast.OutputType().IsExactType(cel.BoolType) {
+		return nil, fmt.Errorf("expression is a %s, not a condition", ast.OutputType())
+	}
+	program, err := c.env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	return &Expression{source: source, program: program}, nil
+}
+
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Eval tells whether the condition holds for the values of the variables
+func (e *Expression) Eval(values map[string]any) (bool, error) {
+	out, _, err := e.program.Eval(values)
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression is not a condition")
+	}
+	return result, nil
+}
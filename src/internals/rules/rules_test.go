@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/stretchr/testify/assert"
+)
+
+var testVariables = Variables{
+	"action":                  cel.StringType,
+	"customer.wishlist_count": cel.IntType,
+	"product.category":        cel.StringType,
+	"product.price":           cel.DoubleType,
+	"product.discontinued":    cel.BoolType,
+	"request.partner_key":     cel.StringType,
+}
+
+var testValues = map[string]any{
+	"action":                  "add",
+	"customer.wishlist_count": int64(99),
+	"product.category":        "electronics",
+	"product.price":           4.5,
+	"product.discontinued":    false,
+	"request.partner_key":     "acme",
+}
+
+func newTestCompiler(t *testing.T) *Compiler {
+	compiler, err := NewCompiler(testVariables)
+	assert.NoError(t, err)
+	return compiler
+}
+
+func TestEval(t *testing.T) {
+	compiler := newTestCompiler(t)
+	cases := map[string]bool{
+		`customer.wishlist_count < 100`:                                                     true,
+		`customer.wishlist_count >= 100`:                                                    false,
+		`product.price >= 5 || action == "remove"`:                                          false,
+		`!(product.category == "electronics" && request.partner_key in ["acme", "globex"])`: false,
+		`!(product.category in ['electronics', 'jewelery'])`:                                false,
+		`!product.discontinued && product.price > -1`:                                       true,
+		`product.category != "books" && (action == "add" || action == "remove")`:            true,
+		`"b" > "a"`: true,
+	}
+	for source, expected := range cases {
+		expression, err := compiler.Compile(source)
+		assert.NoError(t, err, source)
+
+		result, err := expression.Eval(testValues)
+		assert.NoError(t, err, source)
+		assert.Equal(t, expected, result, source)
+	}
+}
+
+func TestCompile_Errors(t *testing.T) {
+	compiler := newTestCompiler(t)
+	for _, source := range []string{
+		``,
+		`customer.wishlist_count <`,
+		`customer.wishlist_count = 100`,
+		`(action == "add"`,
+		`product.title == "unterminated`,
+		`customer.age > 18`,
+		`product.price == "cheap"`,
+		`customer.wishlist_count`,
+		`!product.price`,
+		`action == "add" && product.price`,
+	} {
+		_, err := compiler.Compile(source)
+		assert.Error(t, err, source)
+	}
+}
+
+func TestEval_ShortCircuits(t *testing.T) {
+	expression, err := newTestCompiler(t).Compile(`action == "remove" || product.price > 1.0`)
+	assert.NoError(t, err)
+
+	_, err = expression.Eval(map[string]any{"action": "add"})
+	assert.Error(t, err)
+
+	result, err := expression.Eval(map[string]any{"action": "remove"})
+	assert.NoError(t, err)
+	assert.True(t, result)
+}
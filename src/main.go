@@ -1,18 +1,28 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"produtos-favoritos/src/api/cli"
 	di "produtos-favoritos/src/api/container"
 	"produtos-favoritos/src/api/router"
-	handlers "produtos-favoritos/src/domain/interfaces/controllers"
+	servicers "produtos-favoritos/src/domain/interfaces/services"
 	"produtos-favoritos/src/infrastructure/config"
 	"produtos-favoritos/src/infrastructure/database/migrations"
+	"produtos-favoritos/src/infrastructure/scheduler"
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
 	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 )
 
 // @title Ecommerce Aiqfome Api
@@ -21,6 +31,9 @@ import (
 // @securityDefinitions.apikey ApiKeyAuth
 // @in header
 // @name X-Api-Key
+// @securityDefinitions.apikey AdminKeyAuth
+// @in header
+// @name X-Admin-Key
 func main() {
 	err := godotenv.Load()
 	if err != nil {
@@ -29,6 +42,24 @@ func main() {
 
 	// Wire dependency injection
 	container := di.BuildContainer()
+
+	// export-customers writes the export to stdout and exits, without
+	// migrating or serving
+	if len(os.Args) > 1 && os.Args[1] == "export-customers" {
+		err = container.Invoke(func(db *gorm.DB, exportService servicers.CustomerExportServicer) error {
+			// keep the sql log out of the export
+			db.Logger = logger.New(log.New(os.Stderr, "\r\n", log.LstdFlags), logger.Config{
+				SlowThreshold: 200 * time.Millisecond,
+				LogLevel:      logger.Warn,
+			})
+			return cli.ExportCustomers(exportService, os.Args[2:], os.Stdout)
+		})
+		if err != nil {
+			log.Fatalf("export-customers: %v", err)
+		}
+		return
+	}
+
 	// Run migrations
 	// Run migrations using the *gorm.DB from the container
 	err = container.Invoke(func(db *gorm.DB) {
@@ -37,20 +68,45 @@ func main() {
 	if err != nil {
 		log.Fatalf("failed to run migrations: %v", err)
 	}
-	err = container.Invoke(func(engine *gin.Engine,
-		customerHandler handlers.CustomerHandler,
-		productHandler handlers.ProductHandler,
-		wishlisthandler handlers.WishlistHandler) {
+	// Start background jobs
+	err = container.Invoke(func(jobScheduler *scheduler.Scheduler) {
+		jobScheduler.Start()
+	})
+	if err != nil {
+		log.Fatalf("failed to start background jobs: %v", err)
+	}
+	err = container.Invoke(func(engine *gin.Engine, routeHandlers router.Handlers,
+		jobScheduler *scheduler.Scheduler, recentlyViewed servicers.RecentlyViewedServicer) {
 		// Setup Gin router
-		router.SetupRouter(engine,
-			customerHandler,
-			productHandler,
-			wishlisthandler)
+		router.SetupRouter(engine, routeHandlers)
 
 		// run server
-		fmt.Printf("Server running at http://localhost:%s", config.APP_PORT)
-		if err := engine.Run(fmt.Sprintf(":%s", config.APP_PORT)); err != nil {
-			log.Fatalf("could not start server: %v", err)
+		server := &http.Server{
+			Addr:    fmt.Sprintf(":%s", config.APP_PORT),
+			Handler: engine,
+		}
+		go func() {
+			fmt.Printf("Server running at http://localhost:%s", config.APP_PORT)
+			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalf("could not start server: %v", err)
+			}
+		}()
+
+		// On a stop signal the requests in flight finish first, then the jobs,
+		// and the views still buffered are written last
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+		<-quit
+		log.Printf("shutting down")
+
+		ctx, cancel := context.WithTimeout(context.Background(), config.SHUTDOWN_TIMEOUT)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			log.Printf("could not stop the server gracefully: %v", err)
+		}
+		jobScheduler.Stop()
+		if err := recentlyViewed.Flush(); err != nil {
+			log.Printf("could not write the buffered product views: %v", err)
 		}
 	})
 	if err != nil {